@@ -2,24 +2,20 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 
-	"github.com/GSVillas/movie-pass-api/config"
 	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
-	"github.com/samber/do"
 )
 
-type CloudFlareService interface {
-	UploadImage(imageBytes []byte, filename string) (*UploadImageResponse, error)
-	DeleteImage(cloudFlareID uuid.UUID) error
-}
-
-type cloudFlareService struct {
-	i *do.Injector
+type cloudFlareStorage struct {
+	accountAPI string
+	apiKey     string
+	httpClient *http.Client
 }
 
 type cloudflareError struct {
@@ -41,18 +37,11 @@ type cloudflareResponse struct {
 	Errors   []cloudflareError `json:"errors"`
 }
 
-type UploadImageResponse struct {
-	ID  uuid.UUID
-	URL string
-}
-
-func NewCloudFlareService(i *do.Injector) (CloudFlareService, error) {
-	return &cloudFlareService{
-		i: i,
-	}, nil
+func (c *cloudFlareStorage) Name() string {
+	return ImageStorageCloudFlare
 }
 
-func (c *cloudFlareService) UploadImage(imageBytes []byte, filename string) (*UploadImageResponse, error) {
+func (c *cloudFlareStorage) Upload(ctx context.Context, imageBytes []byte, filename string) (*UploadImageResponse, error) {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
@@ -69,16 +58,15 @@ func (c *cloudFlareService) UploadImage(imageBytes []byte, filename string) (*Up
 		return nil, fmt.Errorf("error closing writer: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", config.Env.CloudFlareAccountAPI, body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.accountAPI, body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", config.Env.CloudFlareApiKey))
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
@@ -116,24 +104,24 @@ func (c *cloudFlareService) UploadImage(imageBytes []byte, filename string) (*Up
 	}, nil
 }
 
-func (c *cloudFlareService) DeleteImage(cloudFlareID uuid.UUID) error {
-	deleteURL := fmt.Sprintf("%s/%s", config.Env.CloudFlareAccountAPI, cloudFlareID.String())
+func (c *cloudFlareStorage) Delete(ctx context.Context, storageID uuid.UUID) error {
+	deleteURL := fmt.Sprintf("%s/%s", c.accountAPI, storageID.String())
 
-	req, err := http.NewRequest("DELETE", deleteURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, deleteURL, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", config.Env.CloudFlareApiKey))
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	// A missing image was already deleted by a previous attempt.
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
 		return fmt.Errorf("error deleting image with status code: %d", resp.StatusCode)
 	}
 
@@ -0,0 +1,118 @@
+package client
+
+//go:generate mockgen -source=image_storage.go -destination=../mock/image_storage_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+const (
+	ImageStorageCloudFlare = "cloudflare"
+	ImageStorageLocal      = "local"
+	ImageStorageS3         = "s3"
+
+	// LocalStoragePath is where the API serves the local storage directory.
+	LocalStoragePath = "/images"
+)
+
+type UploadImageResponse struct {
+	ID  uuid.UUID
+	URL string
+}
+
+// ImageStorage stores the processed movie images. Switching the backend does
+// not move images already stored, they keep pointing to the old one, so
+// images record the Name of the backend that holds them.
+type ImageStorage interface {
+	Name() string
+	Upload(ctx context.Context, imageBytes []byte, filename string) (*UploadImageResponse, error)
+	Delete(ctx context.Context, storageID uuid.UUID) error
+}
+
+// localStorage keeps images on disk for development and self-hosting. The
+// API and the image workers must share the directory.
+type localStorage struct {
+	dir     string
+	baseURL string
+}
+
+func NewImageStorage(i *do.Injector) (ImageStorage, error) {
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+
+	switch config.Env.ImageStorage {
+	case ImageStorageCloudFlare:
+		return &cloudFlareStorage{
+			accountAPI: config.Env.CloudFlareAccountAPI,
+			apiKey:     config.Env.CloudFlareApiKey,
+			httpClient: httpClient,
+		}, nil
+	case ImageStorageLocal:
+		return &localStorage{
+			dir:     config.Env.LocalStorageDir,
+			baseURL: strings.TrimSuffix(config.Env.LocalStorageURL, "/"),
+		}, nil
+	case ImageStorageS3:
+		if config.Env.S3Endpoint == "" || config.Env.S3Bucket == "" {
+			return nil, errors.New("S3_ENDPOINT and S3_BUCKET are required by the s3 image storage")
+		}
+
+		return &s3Storage{
+			endpoint:   strings.TrimSuffix(config.Env.S3Endpoint, "/"),
+			region:     config.Env.S3Region,
+			bucket:     config.Env.S3Bucket,
+			accessKey:  config.Env.S3AccessKey,
+			secretKey:  config.Env.S3SecretKey,
+			publicURL:  strings.TrimSuffix(config.Env.S3PublicURL, "/"),
+			pathStyle:  config.Env.S3PathStyle,
+			httpClient: httpClient,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported image storage: %s", config.Env.ImageStorage)
+	}
+}
+
+// imageObjectKey names stored files after their ID alone, so they can be
+// deleted knowing only the ID. Processed images are always JPEG.
+func imageObjectKey(storageID uuid.UUID) string {
+	return storageID.String() + ".jpg"
+}
+
+func (l *localStorage) Name() string {
+	return ImageStorageLocal
+}
+
+func (l *localStorage) Upload(ctx context.Context, imageBytes []byte, filename string) (*UploadImageResponse, error) {
+	if err := os.MkdirAll(l.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating image directory: %w", err)
+	}
+
+	storageID := uuid.New()
+	if err := os.WriteFile(filepath.Join(l.dir, imageObjectKey(storageID)), imageBytes, 0o644); err != nil {
+		return nil, fmt.Errorf("error writing image %s: %w", filename, err)
+	}
+
+	return &UploadImageResponse{
+		ID:  storageID,
+		URL: fmt.Sprintf("%s/%s", l.baseURL, imageObjectKey(storageID)),
+	}, nil
+}
+
+func (l *localStorage) Delete(ctx context.Context, storageID uuid.UUID) error {
+	// A missing file was already deleted by a previous attempt.
+	if err := os.Remove(filepath.Join(l.dir, imageObjectKey(storageID))); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error deleting image: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package client
+
+//go:generate mockgen -source=mail.go -destination=../mock/mail_mock.go -package=mock
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+const (
+	MailDriverFile = "file"
+	MailDriverSMTP = "smtp"
+)
+
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type MailSender interface {
+	Send(ctx context.Context, message MailMessage) error
+}
+
+type fileMailSender struct {
+	i *do.Injector
+}
+
+type smtpMailSender struct {
+	i *do.Injector
+}
+
+func NewMailSender(i *do.Injector) (MailSender, error) {
+	switch config.Env.MailDriver {
+	case MailDriverFile:
+		return &fileMailSender{i: i}, nil
+	case MailDriverSMTP:
+		return &smtpMailSender{i: i}, nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver: %s", config.Env.MailDriver)
+	}
+}
+
+func (f *fileMailSender) Send(ctx context.Context, message MailMessage) error {
+	if err := os.MkdirAll(config.Env.MailDir, 0o750); err != nil {
+		return fmt.Errorf("error creating mail directory: %w", err)
+	}
+
+	filename := fmt.Sprintf("%s_%s.eml", time.Now().UTC().Format("20060102T150405"), uuid.NewString())
+	if err := os.WriteFile(filepath.Join(config.Env.MailDir, filename), buildMailMessage(message), 0o600); err != nil {
+		return fmt.Errorf("error writing mail file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *smtpMailSender) Send(ctx context.Context, message MailMessage) error {
+	address := net.JoinHostPort(config.Env.SMTPHost, config.Env.SMTPPort)
+
+	var auth smtp.Auth
+	if config.Env.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", config.Env.SMTPUsername, config.Env.SMTPPassword, config.Env.SMTPHost)
+	}
+
+	if err := smtp.SendMail(address, auth, config.Env.MailFrom, []string{message.To}, buildMailMessage(message)); err != nil {
+		return fmt.Errorf("error sending mail: %w", err)
+	}
+
+	return nil
+}
+
+func buildMailMessage(message MailMessage) []byte {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "From: %s\r\n", config.Env.MailFrom)
+	fmt.Fprintf(&buffer, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buffer, "Subject: %s\r\n", message.Subject)
+	fmt.Fprintf(&buffer, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n\r\n")
+	buffer.WriteString(message.Body)
+	return buffer.Bytes()
+}
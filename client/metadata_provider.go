@@ -0,0 +1,415 @@
+package client
+
+//go:generate mockgen -source=metadata_provider.go -destination=../mock/metadata_provider_mock.go -package=mock
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/samber/do"
+)
+
+const (
+	MetadataProviderTMDB    = "tmdb"
+	MetadataProviderFixture = "fixture"
+
+	maxMetadataImageSize = 10 * 1024 * 1024
+	maxMetadataResults   = 20
+)
+
+var ErrMetadataMovieNotFound = errors.New("movie not found in the metadata provider")
+
+// tmdbGenres maps TMDB genre ids to the names seeded in the Genre table, so
+// genres match whatever language the rest of the metadata is fetched in.
+var tmdbGenres = map[int]string{
+	28:    "Action",
+	12:    "Adventure",
+	16:    "Animation",
+	35:    "Comedy",
+	80:    "Crime",
+	99:    "Documentary",
+	18:    "Drama",
+	10751: "Family",
+	14:    "Fantasy",
+	27:    "Horror",
+	10402: "Musical",
+	9648:  "Mystery",
+	10749: "Romance",
+	878:   "Science Fiction",
+	53:    "Thriller",
+	10752: "War",
+	37:    "Western",
+}
+
+//go:embed fixtures/metadata_movies.json
+var metadataFixtures []byte
+
+type MetadataMovieSummary struct {
+	ExternalID    string     `json:"externalId"`
+	Title         string     `json:"title"`
+	OriginalTitle string     `json:"originalTitle"`
+	ReleaseDate   *time.Time `json:"releaseDate"`
+	PosterURL     string     `json:"posterUrl"`
+}
+
+type MetadataCastMember struct {
+	Name      string `json:"name"`
+	Character string `json:"character"`
+}
+
+// MetadataMovie is a movie as described by the provider. Certification is
+// the Brazilian rating (L, 10, 12, 14, 16 or 18) when the provider has one.
+type MetadataMovie struct {
+	ExternalID       string               `json:"externalId"`
+	Title            string               `json:"title"`
+	OriginalTitle    string               `json:"originalTitle"`
+	Synopsis         string               `json:"synopsis"`
+	Runtime          int                  `json:"runtime"`
+	ReleaseDate      *time.Time           `json:"releaseDate"`
+	Country          string               `json:"country"`
+	OriginalLanguage string               `json:"originalLanguage"`
+	Genres           []string             `json:"genres"`
+	Directors        []string             `json:"directors"`
+	Cast             []MetadataCastMember `json:"cast"`
+	Certification    string               `json:"certification"`
+	TrailerURL       string               `json:"trailerUrl"`
+	PosterURL        string               `json:"posterUrl"`
+	BackdropURL      string               `json:"backdropUrl"`
+}
+
+type MetadataProvider interface {
+	Name() string
+	SearchMovies(ctx context.Context, query string) ([]*MetadataMovieSummary, error)
+	GetMovie(ctx context.Context, externalID string) (*MetadataMovie, error)
+	DownloadImage(ctx context.Context, imageURL string) ([]byte, error)
+}
+
+type tmdbMetadataProvider struct {
+	i          *do.Injector
+	httpClient *http.Client
+}
+
+// fixtureMetadataProvider serves the embedded fixtures, for development and
+// tests without a TMDB account. Images are generated on the fly.
+type fixtureMetadataProvider struct {
+	i      *do.Injector
+	movies []*MetadataMovie
+}
+
+func NewMetadataProvider(i *do.Injector) (MetadataProvider, error) {
+	switch config.Env.MetadataProvider {
+	case MetadataProviderTMDB:
+		return &tmdbMetadataProvider{
+			i:          i,
+			httpClient: &http.Client{Timeout: 10 * time.Second},
+		}, nil
+	case MetadataProviderFixture:
+		var movies []*MetadataMovie
+		if err := jsoniter.Unmarshal(metadataFixtures, &movies); err != nil {
+			return nil, fmt.Errorf("error decoding metadata fixtures: %w", err)
+		}
+
+		return &fixtureMetadataProvider{i: i, movies: movies}, nil
+	default:
+		return nil, fmt.Errorf("unsupported metadata provider: %s", config.Env.MetadataProvider)
+	}
+}
+
+type tmdbDate string
+
+func (d tmdbDate) Time() *time.Time {
+	parsed, err := time.Parse(time.DateOnly, string(d))
+	if err != nil {
+		return nil
+	}
+
+	return &parsed
+}
+
+type tmdbSearchResponse struct {
+	Results []struct {
+		ID            int      `json:"id"`
+		Title         string   `json:"title"`
+		OriginalTitle string   `json:"original_title"`
+		ReleaseDate   tmdbDate `json:"release_date"`
+		PosterPath    string   `json:"poster_path"`
+	} `json:"results"`
+}
+
+type tmdbMovieResponse struct {
+	ID                  int      `json:"id"`
+	Title               string   `json:"title"`
+	OriginalTitle       string   `json:"original_title"`
+	Overview            string   `json:"overview"`
+	Runtime             int      `json:"runtime"`
+	ReleaseDate         tmdbDate `json:"release_date"`
+	OriginalLanguage    string   `json:"original_language"`
+	PosterPath          string   `json:"poster_path"`
+	BackdropPath        string   `json:"backdrop_path"`
+	ProductionCountries []struct {
+		ISO31661 string `json:"iso_3166_1"`
+	} `json:"production_countries"`
+	Genres []struct {
+		ID int `json:"id"`
+	} `json:"genres"`
+	Credits struct {
+		Cast []struct {
+			Name      string `json:"name"`
+			Character string `json:"character"`
+		} `json:"cast"`
+		Crew []struct {
+			Name string `json:"name"`
+			Job  string `json:"job"`
+		} `json:"crew"`
+	} `json:"credits"`
+	ReleaseDates struct {
+		Results []struct {
+			ISO31661     string `json:"iso_3166_1"`
+			ReleaseDates []struct {
+				Certification string `json:"certification"`
+			} `json:"release_dates"`
+		} `json:"results"`
+	} `json:"release_dates"`
+	Videos struct {
+		Results []struct {
+			Key  string `json:"key"`
+			Site string `json:"site"`
+			Type string `json:"type"`
+		} `json:"results"`
+	} `json:"videos"`
+}
+
+func (t *tmdbMetadataProvider) Name() string {
+	return MetadataProviderTMDB
+}
+
+func (t *tmdbMetadataProvider) SearchMovies(ctx context.Context, query string) ([]*MetadataMovieSummary, error) {
+	params := url.Values{}
+	params.Set("query", query)
+
+	var response tmdbSearchResponse
+	if err := t.get(ctx, "/search/movie", params, &response); err != nil {
+		return nil, err
+	}
+
+	var movies []*MetadataMovieSummary
+	for _, result := range response.Results {
+		if len(movies) == maxMetadataResults {
+			break
+		}
+
+		movies = append(movies, &MetadataMovieSummary{
+			ExternalID:    fmt.Sprint(result.ID),
+			Title:         result.Title,
+			OriginalTitle: result.OriginalTitle,
+			ReleaseDate:   result.ReleaseDate.Time(),
+			PosterURL:     t.imageURL("w342", result.PosterPath),
+		})
+	}
+
+	return movies, nil
+}
+
+func (t *tmdbMetadataProvider) GetMovie(ctx context.Context, externalID string) (*MetadataMovie, error) {
+	params := url.Values{}
+	params.Set("append_to_response", "credits,release_dates,videos")
+
+	var response tmdbMovieResponse
+	if err := t.get(ctx, "/movie/"+url.PathEscape(externalID), params, &response); err != nil {
+		return nil, err
+	}
+
+	movie := &MetadataMovie{
+		ExternalID:       fmt.Sprint(response.ID),
+		Title:            response.Title,
+		OriginalTitle:    response.OriginalTitle,
+		Synopsis:         response.Overview,
+		Runtime:          response.Runtime,
+		ReleaseDate:      response.ReleaseDate.Time(),
+		OriginalLanguage: response.OriginalLanguage,
+		PosterURL:        t.imageURL("w780", response.PosterPath),
+		BackdropURL:      t.imageURL("w1280", response.BackdropPath),
+	}
+
+	if len(response.ProductionCountries) > 0 {
+		movie.Country = response.ProductionCountries[0].ISO31661
+	}
+
+	for _, genre := range response.Genres {
+		if name, ok := tmdbGenres[genre.ID]; ok {
+			movie.Genres = append(movie.Genres, name)
+		}
+	}
+
+	for _, crew := range response.Credits.Crew {
+		if crew.Job == "Director" {
+			movie.Directors = append(movie.Directors, crew.Name)
+		}
+	}
+
+	for _, cast := range response.Credits.Cast {
+		movie.Cast = append(movie.Cast, MetadataCastMember{Name: cast.Name, Character: cast.Character})
+	}
+
+	for _, result := range response.ReleaseDates.Results {
+		if result.ISO31661 != config.Env.TMDBRegion {
+			continue
+		}
+
+		for _, releaseDate := range result.ReleaseDates {
+			if releaseDate.Certification != "" {
+				movie.Certification = releaseDate.Certification
+				break
+			}
+		}
+	}
+
+	for _, video := range response.Videos.Results {
+		if video.Site == "YouTube" && video.Type == "Trailer" {
+			movie.TrailerURL = "https://www.youtube.com/watch?v=" + video.Key
+			break
+		}
+	}
+
+	return movie, nil
+}
+
+func (t *tmdbMetadataProvider) DownloadImage(ctx context.Context, imageURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image download error with status code: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading image: %w", err)
+	}
+
+	if len(data) > maxMetadataImageSize {
+		return nil, fmt.Errorf("image is larger than %d bytes", maxMetadataImageSize)
+	}
+
+	return data, nil
+}
+
+func (t *tmdbMetadataProvider) get(ctx context.Context, path string, params url.Values, target any) error {
+	params.Set("language", config.Env.TMDBLanguage)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.Env.TMDBAPIURL+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", config.Env.TMDBAPIKey))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrMetadataMovieNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("metadata provider error with status code: %d", resp.StatusCode)
+	}
+
+	if err := jsoniter.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("error decoding JSON response: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tmdbMetadataProvider) imageURL(size, path string) string {
+	if path == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s%s", config.Env.TMDBImageURL, size, path)
+}
+
+func (f *fixtureMetadataProvider) Name() string {
+	return MetadataProviderFixture
+}
+
+func (f *fixtureMetadataProvider) SearchMovies(ctx context.Context, query string) ([]*MetadataMovieSummary, error) {
+	query = strings.ToLower(query)
+
+	var movies []*MetadataMovieSummary
+	for _, movie := range f.movies {
+		if !strings.Contains(strings.ToLower(movie.Title), query) && !strings.Contains(strings.ToLower(movie.OriginalTitle), query) {
+			continue
+		}
+
+		movies = append(movies, &MetadataMovieSummary{
+			ExternalID:    movie.ExternalID,
+			Title:         movie.Title,
+			OriginalTitle: movie.OriginalTitle,
+			ReleaseDate:   movie.ReleaseDate,
+			PosterURL:     movie.PosterURL,
+		})
+	}
+
+	return movies, nil
+}
+
+func (f *fixtureMetadataProvider) GetMovie(ctx context.Context, externalID string) (*MetadataMovie, error) {
+	for _, movie := range f.movies {
+		if movie.ExternalID == externalID {
+			return movie, nil
+		}
+	}
+
+	return nil, ErrMetadataMovieNotFound
+}
+
+// DownloadImage returns a solid color JPEG derived from the URL, so every
+// fixture image is a distinct, valid picture.
+func (f *fixtureMetadataProvider) DownloadImage(ctx context.Context, imageURL string) ([]byte, error) {
+	hash := fnv.New32a()
+	hash.Write([]byte(imageURL))
+	sum := hash.Sum32()
+
+	img := image.NewRGBA(image.Rect(0, 0, 400, 600))
+	fill := color.RGBA{R: uint8(sum), G: uint8(sum >> 8), B: uint8(sum >> 16), A: 255}
+	for y := 0; y < img.Bounds().Dy(); y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			img.Set(x, y, fill)
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := jpeg.Encode(&buffer, img, nil); err != nil {
+		return nil, fmt.Errorf("error encoding fixture image: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
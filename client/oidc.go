@@ -0,0 +1,452 @@
+package client
+
+//go:generate mockgen -source=oidc.go -destination=../mock/oidc_mock.go -package=mock
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/config/model"
+	"github.com/golang-jwt/jwt"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/samber/do"
+)
+
+const (
+	oidcHTTPTimeout      = 10 * time.Second
+	oidcCacheTTL         = time.Hour
+	oidcJWKSRefreshDelay = time.Minute
+	oidcClockSkew        = time.Minute
+)
+
+var ErrOIDCTokenInvalid = errors.New("invalid OIDC ID token")
+
+type OIDCClaims struct {
+	Issuer        string       `json:"iss"`
+	Subject       string       `json:"sub"`
+	Audience      oidcAudience `json:"aud"`
+	ExpiresAt     int64        `json:"exp"`
+	IssuedAt      int64        `json:"iat"`
+	Nonce         string       `json:"nonce"`
+	Email         string       `json:"email"`
+	EmailVerified oidcBool     `json:"email_verified"`
+	Name          string       `json:"name"`
+	GivenName     string       `json:"given_name"`
+	FamilyName    string       `json:"family_name"`
+}
+
+type OIDCClient interface {
+	AuthorizationURL(ctx context.Context, provider model.OIDCProvider, state, nonce, codeChallenge string) (string, error)
+	ExchangeCode(ctx context.Context, provider model.OIDCProvider, code, codeVerifier string) (string, error)
+	VerifyIDToken(ctx context.Context, provider model.OIDCProvider, idToken, nonce string) (*OIDCClaims, error)
+}
+
+type oidcClient struct {
+	i             *do.Injector
+	httpClient    *http.Client
+	mutex         sync.Mutex
+	discoveries   map[string]*oidcDiscovery
+	keySets       map[string]*oidcKeySet
+	keySetFetches map[string]time.Time
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	fetchedAt             time.Time
+}
+
+type oidcKeySet struct {
+	keys      map[string]any
+	fetchedAt time.Time
+}
+
+type oidcJSONWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type oidcAudience []string
+
+type oidcBool bool
+
+func NewOIDCClient(i *do.Injector) (OIDCClient, error) {
+	return &oidcClient{
+		i:             i,
+		httpClient:    &http.Client{Timeout: oidcHTTPTimeout},
+		discoveries:   make(map[string]*oidcDiscovery),
+		keySets:       make(map[string]*oidcKeySet),
+		keySetFetches: make(map[string]time.Time),
+	}, nil
+}
+
+func (o *oidcClient) AuthorizationURL(ctx context.Context, provider model.OIDCProvider, state, nonce, codeChallenge string) (string, error) {
+	discovery, err := o.getDiscovery(ctx, provider.Issuer)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := provider.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	values := url.Values{}
+	values.Set("response_type", "code")
+	values.Set("client_id", provider.ClientID)
+	values.Set("redirect_uri", provider.RedirectURL)
+	values.Set("scope", strings.Join(scopes, " "))
+	values.Set("state", state)
+	values.Set("nonce", nonce)
+	values.Set("code_challenge", codeChallenge)
+	values.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return discovery.AuthorizationEndpoint + separator + values.Encode(), nil
+}
+
+func (o *oidcClient) ExchangeCode(ctx context.Context, provider model.OIDCProvider, code, codeVerifier string) (string, error) {
+	discovery, err := o.getDiscovery(ctx, provider.Issuer)
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Set("grant_type", "authorization_code")
+	values.Set("code", code)
+	values.Set("redirect_uri", provider.RedirectURL)
+	values.Set("client_id", provider.ClientID)
+	values.Set("code_verifier", codeVerifier)
+	if provider.ClientSecret != "" {
+		values.Set("client_secret", provider.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("error creating token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var tokenResponse oidcTokenResponse
+	statusCode, err := o.doJSON(req, &tokenResponse)
+	if err != nil {
+		return "", fmt.Errorf("error exchanging authorization code: %w", err)
+	}
+
+	if statusCode != http.StatusOK || tokenResponse.IDToken == "" {
+		return "", fmt.Errorf("token endpoint returned status %d: %s %s", statusCode, tokenResponse.Error, tokenResponse.ErrorDescription)
+	}
+
+	return tokenResponse.IDToken, nil
+}
+
+func (o *oidcClient) VerifyIDToken(ctx context.Context, provider model.OIDCProvider, idToken, nonce string) (*OIDCClaims, error) {
+	discovery, err := o.getDiscovery(ctx, provider.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims OIDCClaims
+	token, err := jwt.ParseWithClaims(idToken, &claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := o.getKey(ctx, discovery.JWKSURI, kid)
+		if err != nil {
+			return nil, err
+		}
+
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA:
+			if _, ok := key.(*rsa.PublicKey); ok {
+				return key, nil
+			}
+		case *jwt.SigningMethodECDSA:
+			if _, ok := key.(*ecdsa.PublicKey); ok {
+				return key, nil
+			}
+		}
+
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOIDCTokenInvalid, err.Error())
+	}
+
+	if !token.Valid {
+		return nil, ErrOIDCTokenInvalid
+	}
+
+	if claims.Issuer != discovery.Issuer || !claims.Audience.contains(provider.ClientID) || claims.Subject == "" {
+		return nil, fmt.Errorf("%w: issuer, audience or subject mismatch", ErrOIDCTokenInvalid)
+	}
+
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrOIDCTokenInvalid)
+	}
+
+	return &claims, nil
+}
+
+func (o *oidcClient) getDiscovery(ctx context.Context, issuer string) (*oidcDiscovery, error) {
+	o.mutex.Lock()
+	discovery, ok := o.discoveries[issuer]
+	o.mutex.Unlock()
+
+	if ok && time.Since(discovery.fetchedAt) < oidcCacheTTL {
+		return discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating discovery request: %w", err)
+	}
+
+	discovery = &oidcDiscovery{}
+	statusCode, err := o.doJSON(req, discovery)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching discovery document: %w", err)
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("discovery document returned status %d", statusCode)
+	}
+
+	if discovery.Issuer != issuer {
+		return nil, fmt.Errorf("discovery issuer %q does not match configured issuer %q", discovery.Issuer, issuer)
+	}
+
+	discovery.fetchedAt = time.Now()
+
+	o.mutex.Lock()
+	o.discoveries[issuer] = discovery
+	o.mutex.Unlock()
+
+	return discovery, nil
+}
+
+// getKey refetches the provider JWKS when the kid is unknown, rate limited
+// by oidcJWKSRefreshDelay, so rotated provider keys are picked up.
+func (o *oidcClient) getKey(ctx context.Context, jwksURI, kid string) (any, error) {
+	o.mutex.Lock()
+	keySet, ok := o.keySets[jwksURI]
+	lastFetch := o.keySetFetches[jwksURI]
+	o.mutex.Unlock()
+
+	if ok && time.Since(keySet.fetchedAt) < oidcCacheTTL {
+		if key, found := keySet.keys[kid]; found {
+			return key, nil
+		}
+	}
+
+	if ok && time.Since(lastFetch) < oidcJWKSRefreshDelay {
+		if key, found := keySet.keys[kid]; found {
+			return key, nil
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	keySet, err := o.fetchKeySet(ctx, jwksURI)
+	if err != nil {
+		return nil, err
+	}
+
+	key, found := keySet.keys[kid]
+	if !found {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	return key, nil
+}
+
+func (o *oidcClient) fetchKeySet(ctx context.Context, jwksURI string) (*oidcKeySet, error) {
+	o.mutex.Lock()
+	o.keySetFetches[jwksURI] = time.Now()
+	o.mutex.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating JWKS request: %w", err)
+	}
+
+	var response struct {
+		Keys []oidcJSONWebKey `json:"keys"`
+	}
+
+	statusCode, err := o.doJSON(req, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching JWKS: %w", err)
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS returned status %d", statusCode)
+	}
+
+	keySet := &oidcKeySet{
+		keys:      make(map[string]any),
+		fetchedAt: time.Now(),
+	}
+
+	for _, jwk := range response.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+
+		keySet.keys[jwk.KeyID] = key
+	}
+
+	o.mutex.Lock()
+	o.keySets[jwksURI] = keySet
+	o.mutex.Unlock()
+
+	return keySet, nil
+}
+
+func (o *oidcClient) doJSON(req *http.Request, target any) (int, error) {
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	if err := jsoniter.Unmarshal(body, target); err != nil {
+		return resp.StatusCode, fmt.Errorf("error decoding JSON response: %w", err)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (j oidcJSONWebKey) publicKey() (any, error) {
+	switch j.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(j.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(j.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", j.Curve)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(j.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", j.KeyType)
+	}
+}
+
+func (c *OIDCClaims) Valid() error {
+	now := time.Now()
+	if c.ExpiresAt == 0 || now.Add(-oidcClockSkew).Unix() > c.ExpiresAt {
+		return errors.New("token is expired")
+	}
+
+	if c.IssuedAt > now.Add(oidcClockSkew).Unix() {
+		return errors.New("token used before issued")
+	}
+
+	return nil
+}
+
+func (a oidcAudience) contains(clientID string) bool {
+	for _, audience := range a {
+		if audience == clientID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *oidcAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := jsoniter.Unmarshal(data, &single); err == nil {
+		*a = oidcAudience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := jsoniter.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+
+	*a = multiple
+	return nil
+}
+
+// UnmarshalJSON accepts both booleans and the "true"/"false" strings sent by
+// some providers such as Apple.
+func (b *oidcBool) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	*b = oidcBool(value == "true")
+	return nil
+}
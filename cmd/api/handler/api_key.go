@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type apiKeyHandler struct {
+	i             *do.Injector
+	apiKeyService domain.APIKeyService
+}
+
+func NewAPIKeyHandler(i *do.Injector) (domain.APIKeyHandler, error) {
+	apiKeyService, err := do.Invoke[domain.APIKeyService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKeyHandler{
+		i:             i,
+		apiKeyService: apiKeyService,
+	}, nil
+}
+
+func (a *apiKeyHandler) Create(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "apiKey"),
+		slog.String("func", "Create"),
+	)
+
+	param := ctx.Param("id")
+	organizationID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid organization ID provided", slog.String("organizationId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided organization ID is not a valid UUID.")
+	}
+
+	var payload domain.APIKeyPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := a.apiKeyService.Create(ctx.Request().Context(), organizationID, payload)
+	if err != nil {
+		return a.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (a *apiKeyHandler) GetAll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "apiKey"),
+		slog.String("func", "GetAll"),
+	)
+
+	param := ctx.Param("id")
+	organizationID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid organization ID provided", slog.String("organizationId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided organization ID is not a valid UUID.")
+	}
+
+	response, err := a.apiKeyService.GetAll(ctx.Request().Context(), organizationID)
+	if err != nil {
+		return a.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (a *apiKeyHandler) Revoke(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "apiKey"),
+		slog.String("func", "Revoke"),
+	)
+
+	organizationID, apiKeyID, ok := a.parseParams(ctx, log)
+	if !ok {
+		return nil
+	}
+
+	if err := a.apiKeyService.Revoke(ctx.Request().Context(), organizationID, apiKeyID); err != nil {
+		return a.handleError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (a *apiKeyHandler) Rotate(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "apiKey"),
+		slog.String("func", "Rotate"),
+	)
+
+	organizationID, apiKeyID, ok := a.parseParams(ctx, log)
+	if !ok {
+		return nil
+	}
+
+	response, err := a.apiKeyService.Rotate(ctx.Request().Context(), organizationID, apiKeyID)
+	if err != nil {
+		return a.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (a *apiKeyHandler) parseParams(ctx echo.Context, log *slog.Logger) (uuid.UUID, uuid.UUID, bool) {
+	organizationParam := ctx.Param("id")
+	organizationID, err := uuid.Parse(organizationParam)
+	if err != nil {
+		log.Warn("Invalid organization ID provided", slog.String("organizationId", organizationParam), slog.String("error", err.Error()))
+		_ = domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided organization ID is not a valid UUID.")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	keyParam := ctx.Param("keyId")
+	apiKeyID, err := uuid.Parse(keyParam)
+	if err != nil {
+		log.Warn("Invalid api key ID provided", slog.String("keyId", keyParam), slog.String("error", err.Error()))
+		_ = domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided API key ID is not a valid UUID.")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return organizationID, apiKeyID, true
+}
+
+func (a *apiKeyHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFoundInContext):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return domain.ForbiddenAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrTwoFactorRequired):
+		return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrOrganizationNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Organization Not Found", "The requested organization does not exist.")
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "API Key Not Found", "The requested API key does not belong to this organization.")
+	case errors.Is(err, domain.ErrAPIKeyRevoked):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Conflict", "The API key has already been revoked.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type auditLogHandler struct {
+	i            *do.Injector
+	auditService domain.AuditService
+}
+
+func NewAuditLogHandler(i *do.Injector) (domain.AuditLogHandler, error) {
+	auditService, err := do.Invoke[domain.AuditService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditLogHandler{
+		i:            i,
+		auditService: auditService,
+	}, nil
+}
+
+func (a *auditLogHandler) GetAll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "auditLog"),
+		slog.String("func", "GetAll"),
+	)
+
+	filter, err := a.parseFilter(ctx)
+	if err != nil {
+		log.Warn("Invalid audit log filter", slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Filter", "IDs must be valid UUIDs and dates must use the RFC 3339 format.")
+	}
+
+	pagination := &domain.Pagination{}
+	pagination.SetLimit(ctx.QueryParam("limit"))
+	pagination.SetPage(ctx.QueryParam("page"))
+
+	response, err := a.auditService.GetAll(ctx.Request().Context(), *filter, pagination)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFoundInContext):
+			return domain.AccessDeniedAPIErrorResponse(ctx)
+		case errors.Is(err, domain.ErrPermissionDenied):
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		case errors.Is(err, domain.ErrTwoFactorRequired):
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+		case errors.Is(err, domain.ErrOrganizationNotFound):
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Organization Not Found", "The requested organization does not exist.")
+		default:
+			log.Error(err.Error())
+			return domain.InternalServerAPIErrorResponse(ctx)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (a *auditLogHandler) parseFilter(ctx echo.Context) (*domain.AuditLogFilter, error) {
+	filter := &domain.AuditLogFilter{
+		Action:     domain.AuditAction(ctx.QueryParam("action")),
+		TargetType: ctx.QueryParam("targetType"),
+	}
+
+	var err error
+	if filter.OrganizationID, err = parseOptionalUUID(ctx.QueryParam("organizationId")); err != nil {
+		return nil, err
+	}
+
+	if filter.ActorID, err = parseOptionalUUID(ctx.QueryParam("actorId")); err != nil {
+		return nil, err
+	}
+
+	if filter.TargetID, err = parseOptionalUUID(ctx.QueryParam("targetId")); err != nil {
+		return nil, err
+	}
+
+	if filter.From, err = parseOptionalTime(ctx.QueryParam("from")); err != nil {
+		return nil, err
+	}
+
+	if filter.To, err = parseOptionalTime(ctx.QueryParam("to")); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	t = t.UTC()
+	return &t, nil
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type catalogHandler struct {
+	i              *do.Injector
+	catalogService domain.CatalogService
+}
+
+func NewCatalogHandler(i *do.Injector) (domain.CatalogHandler, error) {
+	catalogService, err := do.Invoke[domain.CatalogService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &catalogHandler{
+		i:              i,
+		catalogService: catalogService,
+	}, nil
+}
+
+func (c *catalogHandler) GetNowShowing(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "catalog"),
+		slog.String("func", "GetNowShowing"),
+	)
+
+	filter, err := c.parseFilter(ctx)
+	if err != nil {
+		log.Warn("Invalid catalog filter", slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Filter", "cinemaId, genreId and indicativeRatingId must be valid UUIDs.")
+	}
+
+	days := 0
+	if daysParam := ctx.QueryParam("days"); daysParam != "" {
+		days, err = strconv.Atoi(daysParam)
+		if err != nil {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Days", "The days parameter must be a number.")
+		}
+	}
+
+	response, err := c.catalogService.GetNowShowing(ctx.Request().Context(), *filter, days, c.parsePagination(ctx))
+	if err != nil {
+		return c.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (c *catalogHandler) GetComingSoon(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "catalog"),
+		slog.String("func", "GetComingSoon"),
+	)
+
+	filter, err := c.parseFilter(ctx)
+	if err != nil {
+		log.Warn("Invalid catalog filter", slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Filter", "cinemaId, genreId and indicativeRatingId must be valid UUIDs.")
+	}
+
+	response, err := c.catalogService.GetComingSoon(ctx.Request().Context(), *filter, c.parsePagination(ctx))
+	if err != nil {
+		return c.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (c *catalogHandler) GetMovieByID(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "catalog"),
+		slog.String("func", "GetMovieByID"),
+	)
+
+	movieIDParam := ctx.Param("id")
+	movieID, err := uuid.Parse(movieIDParam)
+	if err != nil {
+		log.Warn("Invalid Movie ID", slog.String("movieID", movieIDParam))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Movie ID", "The provided movie ID is not a valid UUID.")
+	}
+
+	response, err := c.catalogService.GetMovieByID(ctx.Request().Context(), movieID)
+	if err != nil {
+		return c.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (c *catalogHandler) Search(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "catalog"),
+		slog.String("func", "Search"),
+	)
+
+	payload := domain.MovieSearchPayload{
+		Query: ctx.QueryParam("q"),
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := c.catalogService.Search(ctx.Request().Context(), payload, c.parsePagination(ctx))
+	if err != nil {
+		return c.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (c *catalogHandler) parseFilter(ctx echo.Context) (*domain.CatalogFilter, error) {
+	filter := &domain.CatalogFilter{
+		City: strings.TrimSpace(ctx.QueryParam("city")),
+	}
+
+	var err error
+	if filter.CinemaID, err = parseOptionalUUID(ctx.QueryParam("cinemaId")); err != nil {
+		return nil, err
+	}
+
+	if filter.GenreID, err = parseOptionalUUID(ctx.QueryParam("genreId")); err != nil {
+		return nil, err
+	}
+
+	if filter.IndicativeRatingID, err = parseOptionalUUID(ctx.QueryParam("indicativeRatingId")); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func (c *catalogHandler) parsePagination(ctx echo.Context) *domain.Pagination {
+	pagination := &domain.Pagination{}
+	pagination.SetLimit(ctx.QueryParam("limit"))
+	pagination.SetPage(ctx.QueryParam("page"))
+	pagination.SetSort(ctx.QueryParam("sort"))
+
+	return pagination
+}
+
+func (c *catalogHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidCatalogSort):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Sort", "Sort must be one of title, releaseDate, duration or createdAt, optionally prefixed with - for descending order.")
+	case errors.Is(err, domain.ErrCatalogMoviesNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Movies Not Found", "No movies match the given filters.")
+	case errors.Is(err, domain.ErrMoviesNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Movie Not Found", "The requested movie does not exist.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
@@ -48,6 +48,18 @@ func (c *cinemaHandler) Create(ctx echo.Context) error {
 
 	response, err := c.cinemaService.Create(ctx.Request().Context(), payload)
 	if err != nil {
+		if errors.Is(err, domain.ErrOrganizationNotFound) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Organization Not Found", "The organization you are trying to add the cinema to does not exist.")
+		}
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, domain.ErrTwoFactorRequired) {
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+		}
+
 		log.Error(err.Error())
 		return domain.InternalServerAPIErrorResponse(ctx)
 	}
@@ -61,7 +73,7 @@ func (c *cinemaHandler) GetByID(ctx echo.Context) error {
 		slog.String("func", "GetByID"),
 	)
 
-	param := ctx.Param("cinemaId")
+	param := ctx.Param("id")
 	cinemaID, err := uuid.Parse(param)
 	if err != nil {
 		log.Warn("Invalid cinema ID provided", slog.String("cinemaId", param), slog.String("error", err.Error()))
@@ -74,6 +86,58 @@ func (c *cinemaHandler) GetByID(ctx echo.Context) error {
 			return ctx.NoContent(http.StatusNoContent)
 		}
 
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, domain.ErrTwoFactorRequired) {
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (c *cinemaHandler) UpdatePolicy(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "cinema"),
+		slog.String("func", "UpdatePolicy"),
+	)
+
+	param := ctx.Param("id")
+	cinemaID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid cinema ID provided", slog.String("cinemaId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided cinema ID is not a valid UUID.")
+	}
+
+	var payload domain.CinemaPolicyPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := c.cinemaService.UpdatePolicy(ctx.Request().Context(), cinemaID, payload)
+	if err != nil {
+		if errors.Is(err, domain.ErrCinemaNotFound) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Not Found", "The cinema you are trying to update does not exist.")
+		}
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, domain.ErrTwoFactorRequired) {
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+		}
+
 		log.Error(err.Error())
 		return domain.InternalServerAPIErrorResponse(ctx)
 	}
@@ -99,6 +163,14 @@ func (c *cinemaHandler) Delete(ctx echo.Context) error {
 			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Not Found", "The cinema you are trying to delete does not exist.")
 		}
 
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, domain.ErrTwoFactorRequired) {
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+		}
+
 		log.Error(err.Error())
 		return domain.InternalServerAPIErrorResponse(ctx)
 	}
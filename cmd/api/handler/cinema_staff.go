@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type cinemaStaffHandler struct {
+	i                  *do.Injector
+	cinemaStaffService domain.CinemaStaffService
+}
+
+func NewCinemaStaffHandler(i *do.Injector) (domain.CinemaStaffHandler, error) {
+	cinemaStaffService, err := do.Invoke[domain.CinemaStaffService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cinemaStaffHandler{
+		i:                  i,
+		cinemaStaffService: cinemaStaffService,
+	}, nil
+}
+
+func (c *cinemaStaffHandler) Create(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "cinemaStaff"),
+		slog.String("func", "Create"),
+	)
+
+	param := ctx.Param("id")
+	cinemaID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid cinema ID provided", slog.String("cinemaId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided cinema ID is not a valid UUID.")
+	}
+
+	var payload domain.CinemaStaffPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := c.cinemaStaffService.Create(ctx.Request().Context(), cinemaID, payload)
+	if err != nil {
+		return c.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *cinemaStaffHandler) GetAll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "cinemaStaff"),
+		slog.String("func", "GetAll"),
+	)
+
+	param := ctx.Param("id")
+	cinemaID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid cinema ID provided", slog.String("cinemaId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided cinema ID is not a valid UUID.")
+	}
+
+	response, err := c.cinemaStaffService.GetAll(ctx.Request().Context(), cinemaID)
+	if err != nil {
+		return c.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (c *cinemaStaffHandler) Delete(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "cinemaStaff"),
+		slog.String("func", "Delete"),
+	)
+
+	param := ctx.Param("id")
+	cinemaID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid cinema ID provided", slog.String("cinemaId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided cinema ID is not a valid UUID.")
+	}
+
+	staffParam := ctx.Param("staffId")
+	staffID, err := uuid.Parse(staffParam)
+	if err != nil {
+		log.Warn("Invalid staff ID provided", slog.String("staffId", staffParam), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided staff ID is not a valid UUID.")
+	}
+
+	if err := c.cinemaStaffService.Delete(ctx.Request().Context(), cinemaID, staffID); err != nil {
+		return c.handleError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (c *cinemaStaffHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFoundInContext):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return domain.ForbiddenAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrTwoFactorRequired):
+		return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrCinemaNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Cinema Not Found", "The requested cinema does not exist.")
+	case errors.Is(err, domain.ErrUserNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "User Not Found", "No user is registered with the provided email.")
+	case errors.Is(err, domain.ErrCinemaStaffExists):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Conflict", "The user is already a staff member of this cinema.")
+	case errors.Is(err, domain.ErrCinemaStaffNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Staff Not Found", "The requested staff member does not belong to this cinema.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
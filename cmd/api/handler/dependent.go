@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type dependentHandler struct {
+	i                *do.Injector
+	dependentService domain.DependentService
+}
+
+func NewDependentHandler(i *do.Injector) (domain.DependentHandler, error) {
+	dependentService, err := do.Invoke[domain.DependentService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dependentHandler{
+		i:                i,
+		dependentService: dependentService,
+	}, nil
+}
+
+func (d *dependentHandler) Create(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "dependent"),
+		slog.String("func", "Create"),
+	)
+
+	var payload domain.DependentPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := d.dependentService.Create(ctx.Request().Context(), payload)
+	if err != nil {
+		return d.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (d *dependentHandler) GetAll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "dependent"),
+		slog.String("func", "GetAll"),
+	)
+
+	response, err := d.dependentService.GetAll(ctx.Request().Context())
+	if err != nil {
+		return d.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (d *dependentHandler) Update(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "dependent"),
+		slog.String("func", "Update"),
+	)
+
+	param := ctx.Param("id")
+	dependentID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid dependent ID provided", slog.String("dependentId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided dependent ID is not a valid UUID.")
+	}
+
+	var payload domain.UpdateDependentPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := d.dependentService.Update(ctx.Request().Context(), dependentID, payload)
+	if err != nil {
+		return d.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (d *dependentHandler) Delete(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "dependent"),
+		slog.String("func", "Delete"),
+	)
+
+	param := ctx.Param("id")
+	dependentID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid dependent ID provided", slog.String("dependentId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided dependent ID is not a valid UUID.")
+	}
+
+	if err := d.dependentService.Delete(ctx.Request().Context(), dependentID); err != nil {
+		return d.handleError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (d *dependentHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFoundInContext):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrDependentNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Dependent Not Found", "The requested dependent does not exist.")
+	case errors.Is(err, domain.ErrBirthDateLocked):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Birth Date Locked", "The birth date of a dependent cannot be changed. Please contact support to correct it.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
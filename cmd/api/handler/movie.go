@@ -2,6 +2,7 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -49,6 +50,25 @@ func (m *movieHandler) GetAllIndicativeRatings(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+func (m *movieHandler) GetAllGenres(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "GetAllGenres"),
+	)
+
+	response, err := m.movieService.GetAllGenres(ctx.Request().Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrGenresNotFound) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "No Genres Found", "There are currently no genres available in the system. Please try again later or contact support if you believe this is a mistake.")
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 func (m *movieHandler) Create(ctx echo.Context) error {
 	log := slog.With(
 		slog.String("handler", "movie"),
@@ -71,10 +91,16 @@ func (m *movieHandler) Create(ctx echo.Context) error {
 		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid IndicativeRatingID", "The provided indicative rating ID is not a valid UUID.")
 	}
 
+	organizationID, err := uuid.Parse(ctx.FormValue("organizationId"))
+	if err != nil {
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid OrganizationID", "The provided organization ID is not a valid UUID.")
+	}
+
 	payload := domain.MoviePayload{
 		Title:              ctx.FormValue("title"),
 		Duration:           duration,
 		IndicativeRatingID: indicativeRatingID,
+		OrganizationID:     organizationID,
 		Images:             form.File["images"],
 	}
 
@@ -87,6 +113,12 @@ func (m *movieHandler) Create(ctx echo.Context) error {
 		switch err {
 		case domain.ErrUserNotFoundInContext:
 			return domain.AccessDeniedAPIErrorResponse(ctx)
+		case domain.ErrOrganizationNotFound:
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Organization Not Found", "The organization you are trying to add the movie to does not exist.")
+		case domain.ErrPermissionDenied:
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		case domain.ErrTwoFactorRequired:
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
 		case domain.ErrIndicativeRatingNotFound:
 			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Indicative Rating Not Found", "The specified indicative rating does not exist.")
 		default:
@@ -159,14 +191,22 @@ func (m *movieHandler) Update(ctx echo.Context) error {
 			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Movie Not Found", "The movie you are trying to update does not exist.")
 		}
 
-		if errors.Is(err, domain.ErrMovieNotBelongUser) {
-			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Forbidden", "You are not allowed to update this movie because it does not belong to you.")
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, domain.ErrTwoFactorRequired) {
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
 		}
 
 		if errors.Is(err, domain.ErrIndicativeRatingNotFound) {
 			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Indicative Rating", "The provided indicative rating does not exist.")
 		}
 
+		if errors.Is(err, domain.ErrGenreNotFound) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Genre", "One or more of the provided genres do not exist.")
+		}
+
 		log.Error(err.Error())
 		return domain.InternalServerAPIErrorResponse(ctx)
 	}
@@ -175,5 +215,301 @@ func (m *movieHandler) Update(ctx echo.Context) error {
 }
 
 func (m *movieHandler) Delete(ctx echo.Context) error {
-	panic("unimplemented")
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "Delete"),
+	)
+
+	movieIDParam := ctx.Param("id")
+	movieID, err := uuid.Parse(movieIDParam)
+	if err != nil {
+		log.Warn("Invalid Movie ID", slog.String("movieID", movieIDParam))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Movie ID", "The provided movie ID is not a valid UUID.")
+	}
+
+	force := false
+	if forceParam := ctx.QueryParam("force"); forceParam != "" {
+		force, err = strconv.ParseBool(forceParam)
+		if err != nil {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Force Flag", "The force parameter must be true or false.")
+		}
+	}
+
+	if err := m.movieService.Delete(ctx.Request().Context(), movieID, force); err != nil {
+		if errors.Is(err, domain.ErrUserNotFoundInContext) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Unauthorized", "User is not authenticated or session has expired.")
+		}
+
+		if errors.Is(err, domain.ErrMoviesNotFound) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Movie Not Found", "The movie you are trying to delete does not exist.")
+		}
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, domain.ErrTwoFactorRequired) {
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, domain.ErrMovieHasFutureSessions) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Movie Has Future Sessions", "The movie still has sessions scheduled. Cancel them first or delete with force=true.")
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (m *movieHandler) AddImages(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "AddImages"),
+	)
+
+	movieID, ok := m.parseMovieID(ctx)
+	if !ok {
+		return nil
+	}
+
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		log.Error("Failed to parse multipart form", slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Form Parsing Error", "Failed to parse multipart form data.")
+	}
+
+	payload := domain.MovieImagesPayload{
+		Images:  form.File["images"],
+		AltText: ctx.FormValue("altText"),
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	if err := m.movieService.AddImages(ctx.Request().Context(), movieID, payload); err != nil {
+		return m.handleImageError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+func (m *movieHandler) RemoveImage(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "RemoveImage"),
+	)
+
+	movieID, imageID, ok := m.parseImageParams(ctx)
+	if !ok {
+		return nil
+	}
+
+	if err := m.movieService.RemoveImage(ctx.Request().Context(), movieID, imageID); err != nil {
+		return m.handleImageError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (m *movieHandler) ReorderImages(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "ReorderImages"),
+	)
+
+	movieID, ok := m.parseMovieID(ctx)
+	if !ok {
+		return nil
+	}
+
+	var payload domain.MovieImageOrderPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := m.movieService.ReorderImages(ctx.Request().Context(), movieID, payload)
+	if err != nil {
+		return m.handleImageError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (m *movieHandler) SetCoverImage(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "SetCoverImage"),
+	)
+
+	movieID, imageID, ok := m.parseImageParams(ctx)
+	if !ok {
+		return nil
+	}
+
+	response, err := m.movieService.SetCoverImage(ctx.Request().Context(), movieID, imageID)
+	if err != nil {
+		return m.handleImageError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (m *movieHandler) UpdateImage(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "UpdateImage"),
+	)
+
+	movieID, imageID, ok := m.parseImageParams(ctx)
+	if !ok {
+		return nil
+	}
+
+	var payload domain.MovieImageUpdatePayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := m.movieService.UpdateImage(ctx.Request().Context(), movieID, imageID, payload)
+	if err != nil {
+		return m.handleImageError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (m *movieHandler) SearchMetadata(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "SearchMetadata"),
+	)
+
+	payload := domain.MovieSearchPayload{
+		Query: ctx.QueryParam("q"),
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := m.movieService.SearchMetadata(ctx.Request().Context(), payload)
+	if err != nil {
+		if errors.Is(err, domain.ErrMetadataMovieNotFound) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Movies Not Found", "The metadata provider has no movie matching the search.")
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (m *movieHandler) Import(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "movie"),
+		slog.String("func", "Import"),
+	)
+
+	var payload domain.MovieImportPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := m.movieService.Import(ctx.Request().Context(), payload)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFoundInContext):
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Unauthorized", "User is not authenticated or session has expired.")
+		case errors.Is(err, domain.ErrOrganizationNotFound):
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Organization Not Found", "The organization you are trying to add the movie to does not exist.")
+		case errors.Is(err, domain.ErrPermissionDenied):
+			return domain.ForbiddenAPIErrorResponse(ctx)
+		case errors.Is(err, domain.ErrTwoFactorRequired):
+			return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+		case errors.Is(err, domain.ErrMetadataMovieNotFound):
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Movie Not Found", "The metadata provider has no movie with the given external ID.")
+		case errors.Is(err, domain.ErrMovieAlreadyImported):
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Movie Already Imported", "This movie was already imported from the metadata provider.")
+		case errors.Is(err, domain.ErrIndicativeRatingNotFound):
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Indicative Rating", "The provided indicative rating does not exist.")
+		case errors.Is(err, domain.ErrIndicativeRatingNotMapped):
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, nil, "Indicative Rating Required", "The provider has no certification that maps to an indicative rating. Please send indicativeRatingId.")
+		case errors.Is(err, domain.ErrMovieMetadataIncomplete):
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, nil, "Incomplete Metadata", "The metadata provider has no runtime for this movie, please create it manually.")
+		default:
+			log.Error(err.Error())
+			return domain.InternalServerAPIErrorResponse(ctx)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+// parseMovieID writes the bad request response itself and reports whether
+// the handler can go on.
+func (m *movieHandler) parseMovieID(ctx echo.Context) (uuid.UUID, bool) {
+	movieIDParam := ctx.Param("id")
+	movieID, err := uuid.Parse(movieIDParam)
+	if err != nil {
+		_ = domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Movie ID", "The provided movie ID is not a valid UUID.")
+		return uuid.Nil, false
+	}
+
+	return movieID, true
+}
+
+func (m *movieHandler) parseImageParams(ctx echo.Context) (uuid.UUID, uuid.UUID, bool) {
+	movieID, ok := m.parseMovieID(ctx)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	imageID, err := uuid.Parse(ctx.Param("imageId"))
+	if err != nil {
+		_ = domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid Image ID", "The provided image ID is not a valid UUID.")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return movieID, imageID, true
+}
+
+func (m *movieHandler) handleImageError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFoundInContext):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Unauthorized", "User is not authenticated or session has expired.")
+	case errors.Is(err, domain.ErrMoviesNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Movie Not Found", "The movie you are trying to update does not exist.")
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return domain.ForbiddenAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrTwoFactorRequired):
+		return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrMovieImageNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Image Not Found", "The image does not exist or does not belong to this movie.")
+	case errors.Is(err, domain.ErrMovieImageLimitReached):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Image Limit Reached", fmt.Sprintf("A movie can have at most %d images.", domain.MaxImagesPerMovie))
+	case errors.Is(err, domain.ErrMovieImageOrderMismatch):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, nil, "Invalid Image Order", "The order must list every image of the movie exactly once.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
 }
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/utils"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type oidcHandler struct {
+	i           *do.Injector
+	oidcService domain.OIDCService
+}
+
+func NewOIDCHandler(i *do.Injector) (domain.OIDCHandler, error) {
+	oidcService, err := do.Invoke[domain.OIDCService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oidcHandler{
+		i:           i,
+		oidcService: oidcService,
+	}, nil
+}
+
+func (o *oidcHandler) Authorize(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "oidc"),
+		slog.String("func", "Authorize"),
+	)
+
+	response, err := o.oidcService.Authorize(ctx.Request().Context(), ctx.Param("provider"))
+	if err != nil {
+		return o.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (o *oidcHandler) Callback(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "oidc"),
+		slog.String("func", "Callback"),
+	)
+
+	var payload domain.OIDCCallbackPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	payload.IPAddress = ctx.RealIP()
+	if payload.DeviceName == "" {
+		payload.DeviceName = utils.TruncateString(ctx.Request().UserAgent(), 255)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := o.oidcService.Callback(ctx.Request().Context(), ctx.Param("provider"), payload)
+	if err != nil {
+		return o.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (o *oidcHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOIDCProviderNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Not Found", "The sign in provider is not supported.")
+	case errors.Is(err, domain.ErrOIDCStateInvalid):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid state", "The sign in attempt is invalid or has expired. Please start again.")
+	case errors.Is(err, domain.ErrOIDCEmailNotVerified):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Email not verified", "The provider did not confirm your email address. Verify it with the provider and try again.")
+	case errors.Is(err, domain.ErrOIDCAuthenticationFailed), errors.Is(err, domain.ErrUserNotFound):
+		log.Warn("OIDC authentication failed", slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Unauthorized credentials", "The provider could not authenticate you. Please try again.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type organizationHandler struct {
+	i                   *do.Injector
+	organizationService domain.OrganizationService
+}
+
+func NewOrganizationHandler(i *do.Injector) (domain.OrganizationHandler, error) {
+	organizationService, err := do.Invoke[domain.OrganizationService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationHandler{
+		i:                   i,
+		organizationService: organizationService,
+	}, nil
+}
+
+func (o *organizationHandler) Create(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "organization"),
+		slog.String("func", "Create"),
+	)
+
+	var payload domain.OrganizationPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := o.organizationService.Create(ctx.Request().Context(), payload)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFoundInContext) {
+			return domain.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (o *organizationHandler) GetAll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "organization"),
+		slog.String("func", "GetAll"),
+	)
+
+	response, err := o.organizationService.GetAll(ctx.Request().Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrOrganizationNotFound) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "No Organizations Found", "You are not a member of any organization yet.")
+		}
+
+		if errors.Is(err, domain.ErrUserNotFoundInContext) {
+			return domain.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (o *organizationHandler) UpdateSecurity(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "organization"),
+		slog.String("func", "UpdateSecurity"),
+	)
+
+	param := ctx.Param("id")
+	organizationID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid organization ID provided", slog.String("organizationId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided organization ID is not a valid UUID.")
+	}
+
+	var payload domain.OrganizationSecurityPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := o.organizationService.UpdateSecurity(ctx.Request().Context(), organizationID, payload)
+	if err != nil {
+		return o.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (o *organizationHandler) AddMember(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "organization"),
+		slog.String("func", "AddMember"),
+	)
+
+	param := ctx.Param("id")
+	organizationID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid organization ID provided", slog.String("organizationId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided organization ID is not a valid UUID.")
+	}
+
+	var payload domain.OrganizationMemberPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := o.organizationService.AddMember(ctx.Request().Context(), organizationID, payload)
+	if err != nil {
+		return o.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (o *organizationHandler) GetAllMembers(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "organization"),
+		slog.String("func", "GetAllMembers"),
+	)
+
+	param := ctx.Param("id")
+	organizationID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid organization ID provided", slog.String("organizationId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided organization ID is not a valid UUID.")
+	}
+
+	response, err := o.organizationService.GetAllMembers(ctx.Request().Context(), organizationID)
+	if err != nil {
+		return o.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (o *organizationHandler) RemoveMember(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "organization"),
+		slog.String("func", "RemoveMember"),
+	)
+
+	param := ctx.Param("id")
+	organizationID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid organization ID provided", slog.String("organizationId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided organization ID is not a valid UUID.")
+	}
+
+	memberParam := ctx.Param("memberId")
+	memberID, err := uuid.Parse(memberParam)
+	if err != nil {
+		log.Warn("Invalid member ID provided", slog.String("memberId", memberParam), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided member ID is not a valid UUID.")
+	}
+
+	if err := o.organizationService.RemoveMember(ctx.Request().Context(), organizationID, memberID); err != nil {
+		return o.handleError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (o *organizationHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFoundInContext):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return domain.ForbiddenAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrTwoFactorRequired):
+		return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrOrganizationNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Organization Not Found", "The requested organization does not exist.")
+	case errors.Is(err, domain.ErrUserNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "User Not Found", "No user is registered with the provided email.")
+	case errors.Is(err, domain.ErrOrganizationMemberExists):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Conflict", "The user is already a member of this organization.")
+	case errors.Is(err, domain.ErrOrganizationMemberNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Member Not Found", "The requested member does not belong to this organization.")
+	case errors.Is(err, domain.ErrCannotRemoveOwner):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Conflict", "The organization owner cannot be removed.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type partnerHandler struct {
+	i              *do.Injector
+	partnerService domain.PartnerService
+}
+
+func NewPartnerHandler(i *do.Injector) (domain.PartnerHandler, error) {
+	partnerService, err := do.Invoke[domain.PartnerService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &partnerHandler{
+		i:              i,
+		partnerService: partnerService,
+	}, nil
+}
+
+func (p *partnerHandler) GetShowtimes(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "partner"),
+		slog.String("func", "GetShowtimes"),
+	)
+
+	param := ctx.Param("id")
+	cinemaID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid cinema ID provided", slog.String("cinemaId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided cinema ID is not a valid UUID.")
+	}
+
+	response, err := p.partnerService.GetShowtimes(ctx.Request().Context(), cinemaID)
+	if err != nil {
+		return p.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (p *partnerHandler) CreateTicket(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "partner"),
+		slog.String("func", "CreateTicket"),
+	)
+
+	param := ctx.Param("id")
+	cinemaSessionID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid cinema session ID provided", slog.String("cinemaSessionId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided session ID is not a valid UUID.")
+	}
+
+	var payload domain.PartnerTicketPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := p.partnerService.CreateTicket(ctx.Request().Context(), cinemaSessionID, payload)
+	if err != nil {
+		return p.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (p *partnerHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAPIKeyNotFoundInContext):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrCinemaNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Cinema Not Found", "The requested cinema does not exist.")
+	case errors.Is(err, domain.ErrCinemaSessionNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Session Not Found", "The requested session does not exist.")
+	case errors.Is(err, domain.ErrCinemaSessionStarted):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Session Started", "Tickets can no longer be bought for this session.")
+	case errors.Is(err, domain.ErrSeatNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Seat Not Found", "The selected seat does not exist in the session room.")
+	case errors.Is(err, domain.ErrSeatUnavailable):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Seat Unavailable", "The selected seat is already taken for this session.")
+	case errors.Is(err, domain.ErrBirthDateRequired):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, nil, "Birth Date Required", "This movie has an age rating. Please send the ticket holder's birth date.")
+	case errors.Is(err, domain.ErrAgeRatingNotMet):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Age Rating", "The ticket holder does not meet the minimum age for this movie on the session date.")
+	case errors.Is(err, domain.ErrGuardianRequired):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Guardian Required", "The ticket holder is under the minimum age for this movie and must be accompanied by a parent or legal guardian.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
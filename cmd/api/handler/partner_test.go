@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/middleware"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartnerHandler_CreateTicket_WhenAPIKeyLacksScope_ShouldReturnForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyServiceMock := mock.NewMockAPIKeyService(ctrl)
+	partnerServiceMock := mock.NewMockPartnerService(ctrl)
+
+	apiKey := &domain.APIKey{
+		ID:             uuid.New(),
+		OrganizationID: uuid.New(),
+	}
+	apiKey.SetScopes([]domain.APIKeyScope{domain.APIKeyScopeShowtimesRead})
+
+	apiKeyServiceMock.EXPECT().Authenticate(gomock.Any(), "mp_live_key").Return(apiKey, nil)
+
+	i := do.New()
+	do.ProvideValue[domain.APIKeyService](i, apiKeyServiceMock)
+
+	partnerHandler := &partnerHandler{
+		partnerService: partnerServiceMock,
+	}
+
+	e := echo.New()
+	e.POST("/v1/partners/cinema-sessions/:id/tickets", partnerHandler.CreateTicket, middleware.EnsureAPIKey(i), middleware.EnsureScope(domain.APIKeyScopeOrdersWrite))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/partners/cinema-sessions/"+uuid.NewString()+"/tickets", bytes.NewBufferString(`{"seatId":"`+uuid.NewString()+`","holderName":"Jane Doe"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("X-API-Key", "mp_live_key")
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.JSONEq(t, `{"status":403,"title":"Forbidden","details":"You do not have permission to perform this action on the requested resource."}`, rec.Body.String())
+}
+
+func TestPartnerHandler_CreateTicket_WhenUserSessionIsSent_ShouldReturnUnauthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyServiceMock := mock.NewMockAPIKeyService(ctrl)
+	partnerServiceMock := mock.NewMockPartnerService(ctrl)
+
+	i := do.New()
+	do.ProvideValue[domain.APIKeyService](i, apiKeyServiceMock)
+
+	partnerHandler := &partnerHandler{
+		partnerService: partnerServiceMock,
+	}
+
+	e := echo.New()
+	e.POST("/v1/partners/cinema-sessions/:id/tickets", partnerHandler.CreateTicket, middleware.EnsureAPIKey(i), middleware.EnsureScope(domain.APIKeyScopeOrdersWrite))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/partners/cinema-sessions/"+uuid.NewString()+"/tickets", bytes.NewBufferString(`{}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
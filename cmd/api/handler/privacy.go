@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+const exportDownloadName = "movie-pass-data.zip"
+
+type privacyHandler struct {
+	i              *do.Injector
+	privacyService domain.PrivacyService
+}
+
+func NewPrivacyHandler(i *do.Injector) (domain.PrivacyHandler, error) {
+	privacyService, err := do.Invoke[domain.PrivacyService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &privacyHandler{
+		i:              i,
+		privacyService: privacyService,
+	}, nil
+}
+
+func (p *privacyHandler) RequestExport(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "privacy"),
+		slog.String("func", "RequestExport"),
+	)
+
+	response, err := p.privacyService.RequestExport(ctx.Request().Context())
+	if err != nil {
+		return p.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusAccepted, response)
+}
+
+func (p *privacyHandler) DownloadExport(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "privacy"),
+		slog.String("func", "DownloadExport"),
+	)
+
+	param := ctx.Param("id")
+	requestID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid privacy request ID provided", slog.String("requestId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided export ID is not a valid UUID.")
+	}
+
+	path, err := p.privacyService.GetExportFile(ctx.Request().Context(), requestID)
+	if err != nil {
+		return p.handleError(ctx, log, err)
+	}
+
+	return ctx.Attachment(path, exportDownloadName)
+}
+
+func (p *privacyHandler) RequestDeletion(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "privacy"),
+		slog.String("func", "RequestDeletion"),
+	)
+
+	var payload domain.DeleteAccountPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := p.privacyService.RequestDeletion(ctx.Request().Context(), payload)
+	if err != nil {
+		return p.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusAccepted, response)
+}
+
+func (p *privacyHandler) GetAll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "privacy"),
+		slog.String("func", "GetAll"),
+	)
+
+	response, err := p.privacyService.GetAll(ctx.Request().Context())
+	if err != nil {
+		return p.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (p *privacyHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	var rateLimitError *domain.RateLimitError
+	switch {
+	case errors.As(err, &rateLimitError):
+		return domain.TooManyRequestsAPIErrorResponse(ctx, rateLimitError.RetryAfter)
+	case errors.Is(err, domain.ErrInvalidPassword):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Invalid password", "The current password is incorrect.")
+	case errors.Is(err, domain.ErrPrivacyRequestInProgress):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "conflict", "A request of this type is already being processed.")
+	case errors.Is(err, domain.ErrAccountOwnsOrganization):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "conflict", "Organizations you own must be handed over before deleting your account. Please contact support.")
+	case errors.Is(err, domain.ErrPrivacyRequestNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Not Found", "The requested export does not exist.")
+	case errors.Is(err, domain.ErrExportNotReady):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "conflict", "The export is still being prepared. You will receive an email when it is ready.")
+	case errors.Is(err, domain.ErrExportExpired):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusGone, nil, "Gone", "The export has expired. Please request a new one.")
+	case errors.Is(err, domain.ErrUserNotFoundInContext), errors.Is(err, domain.ErrUserNotFound):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/config"
 	"github.com/GSVillas/movie-pass-api/domain"
 	"github.com/GSVillas/movie-pass-api/middleware"
 	"github.com/labstack/echo/v4"
@@ -8,9 +10,26 @@ import (
 )
 
 func SetupRoutes(e *echo.Echo, i *do.Injector) {
+	setupWellKnownRoutes(e, i)
 	setupUserRoutes(e, i)
+	setupAuthRoutes(e, i)
+	setupOrganizationRoutes(e, i)
 	setupCinemaRoutes(e, i)
 	setupMovieRoutes(e, i)
+	setupTicketRoutes(e, i)
+	setupAuditRoutes(e, i)
+	setupPartnerRoutes(e, i)
+	setupImageRoutes(e)
+}
+
+func setupWellKnownRoutes(e *echo.Echo, i *do.Injector) {
+	sessionHandler, err := do.Invoke[domain.SessionHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	group := e.Group("/.well-known")
+	group.GET("/jwks.json", sessionHandler.GetJWKS)
 }
 
 func setupUserRoutes(e *echo.Echo, i *do.Injector) {
@@ -19,9 +38,98 @@ func setupUserRoutes(e *echo.Echo, i *do.Injector) {
 		panic(err)
 	}
 
+	sessionHandler, err := do.Invoke[domain.SessionHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	twoFactorHandler, err := do.Invoke[domain.TwoFactorHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	privacyHandler, err := do.Invoke[domain.PrivacyHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	dependentHandler, err := do.Invoke[domain.DependentHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
 	group := e.Group("/v1/users")
 	group.POST("", userHandler.Create)
 	group.POST("/sign-in", userHandler.SignIn)
+	group.POST("/sign-in/2fa", twoFactorHandler.VerifySignIn)
+	group.POST("/token/refresh", sessionHandler.Refresh)
+	group.POST("/password/forgot", userHandler.ForgotPassword)
+	group.POST("/password/reset", userHandler.ResetPassword)
+	group.POST("/email/verify", userHandler.VerifyEmail)
+	group.POST("/email/verification", userHandler.ResendVerification, middleware.EnsureAuthenticated(i))
+	group.POST("/email/change/confirm", userHandler.ConfirmEmailChange)
+	group.POST("/sign-out", sessionHandler.SignOut, middleware.EnsureAuthenticated(i))
+	group.POST("/sign-out-all", sessionHandler.DeleteAll, middleware.EnsureAuthenticated(i))
+
+	meGroup := group.Group("/me", middleware.EnsureAuthenticated(i))
+	meGroup.GET("", userHandler.GetMe)
+	meGroup.PATCH("", userHandler.UpdateMe)
+	meGroup.PUT("/password", userHandler.ChangePassword)
+	meGroup.POST("/email", userHandler.ChangeEmail)
+	meGroup.DELETE("", privacyHandler.RequestDeletion)
+	meGroup.GET("/privacy-requests", privacyHandler.GetAll)
+	meGroup.POST("/exports", privacyHandler.RequestExport)
+	meGroup.GET("/exports/:id", privacyHandler.DownloadExport)
+	meGroup.POST("/dependents", dependentHandler.Create)
+	meGroup.GET("/dependents", dependentHandler.GetAll)
+	meGroup.PATCH("/dependents/:id", dependentHandler.Update)
+	meGroup.DELETE("/dependents/:id", dependentHandler.Delete)
+
+	twoFactorGroup := group.Group("/2fa", middleware.EnsureAuthenticated(i))
+	twoFactorGroup.POST("/enroll", twoFactorHandler.Enroll)
+	twoFactorGroup.POST("/confirm", twoFactorHandler.Confirm)
+	twoFactorGroup.POST("/recovery-codes", twoFactorHandler.RegenerateRecoveryCodes)
+	twoFactorGroup.DELETE("", twoFactorHandler.Disable)
+
+	sessionGroup := group.Group("/sessions", middleware.EnsureAuthenticated(i))
+	sessionGroup.GET("", sessionHandler.GetAll)
+	sessionGroup.DELETE("", sessionHandler.DeleteAll)
+	sessionGroup.DELETE("/:id", sessionHandler.Delete)
+}
+
+func setupAuthRoutes(e *echo.Echo, i *do.Injector) {
+	oidcHandler, err := do.Invoke[domain.OIDCHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	group := e.Group("/v1/auth/oidc")
+	group.GET("/:provider/authorize", oidcHandler.Authorize)
+	group.POST("/:provider/callback", oidcHandler.Callback)
+}
+
+func setupOrganizationRoutes(e *echo.Echo, i *do.Injector) {
+	organizationHandler, err := do.Invoke[domain.OrganizationHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	apiKeyHandler, err := do.Invoke[domain.APIKeyHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	group := e.Group("/v1/organizations", middleware.EnsureAuthenticated(i))
+	group.POST("", organizationHandler.Create)
+	group.GET("", organizationHandler.GetAll)
+	group.PUT("/:id/security", organizationHandler.UpdateSecurity)
+	group.POST("/:id/members", organizationHandler.AddMember)
+	group.GET("/:id/members", organizationHandler.GetAllMembers)
+	group.DELETE("/:id/members/:memberId", organizationHandler.RemoveMember)
+	group.POST("/:id/api-keys", apiKeyHandler.Create)
+	group.GET("/:id/api-keys", apiKeyHandler.GetAll)
+	group.DELETE("/:id/api-keys/:keyId", apiKeyHandler.Revoke)
+	group.POST("/:id/api-keys/:keyId/rotate", apiKeyHandler.Rotate)
 }
 
 func setupCinemaRoutes(e *echo.Echo, i *do.Injector) {
@@ -30,11 +138,20 @@ func setupCinemaRoutes(e *echo.Echo, i *do.Injector) {
 		panic(err)
 	}
 
+	cinemaStaffHandler, err := do.Invoke[domain.CinemaStaffHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
 	group := e.Group("/v1/cinemas", middleware.EnsureAuthenticated(i))
 	group.POST("", cinemaHandler.Create)
 	group.GET("", cinemaHandler.GetAll)
 	group.GET("/:id", cinemaHandler.GetByID)
+	group.PUT("/:id/policy", cinemaHandler.UpdatePolicy)
 	group.DELETE("/:id", cinemaHandler.Delete)
+	group.POST("/:id/staff", cinemaStaffHandler.Create)
+	group.GET("/:id/staff", cinemaStaffHandler.GetAll)
+	group.DELETE("/:id/staff/:staffId", cinemaStaffHandler.Delete)
 }
 
 func setupMovieRoutes(e *echo.Echo, i *do.Injector) {
@@ -43,13 +160,77 @@ func setupMovieRoutes(e *echo.Echo, i *do.Injector) {
 		panic(err)
 	}
 
+	catalogHandler, err := do.Invoke[domain.CatalogHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
 	publicGroup := e.Group("/v1/movies")
 	publicGroup.GET("/indicative-rating", movieHandler.GetAllIndicativeRatings)
+	publicGroup.GET("/genres", movieHandler.GetAllGenres)
+	publicGroup.GET("/now-showing", catalogHandler.GetNowShowing)
+	publicGroup.GET("/coming-soon", catalogHandler.GetComingSoon)
+	publicGroup.GET("/search", catalogHandler.Search)
+	publicGroup.GET("/:id", catalogHandler.GetMovieByID)
 
 	adminGroup := e.Group("/v1/admin/movies")
 	adminGroup.POST("", movieHandler.Create, middleware.EnsureAuthenticated(i))
 	adminGroup.GET("", movieHandler.GetAllByUserID, middleware.EnsureAuthenticated(i))
+	adminGroup.GET("/metadata/search", movieHandler.SearchMetadata, middleware.EnsureAuthenticated(i))
+	adminGroup.POST("/import", movieHandler.Import, middleware.EnsureAuthenticated(i))
 	adminGroup.PUT("/:id", movieHandler.Update, middleware.EnsureAuthenticated(i))
-	adminGroup.PUT("/:id", movieHandler.Update, middleware.EnsureAuthenticated(i))
+	adminGroup.DELETE("/:id", movieHandler.Delete, middleware.EnsureAuthenticated(i))
+	adminGroup.POST("/:id/images", movieHandler.AddImages, middleware.EnsureAuthenticated(i))
+	adminGroup.PUT("/:id/images/order", movieHandler.ReorderImages, middleware.EnsureAuthenticated(i))
+	adminGroup.PUT("/:id/images/:imageId/cover", movieHandler.SetCoverImage, middleware.EnsureAuthenticated(i))
+	adminGroup.PATCH("/:id/images/:imageId", movieHandler.UpdateImage, middleware.EnsureAuthenticated(i))
+	adminGroup.DELETE("/:id/images/:imageId", movieHandler.RemoveImage, middleware.EnsureAuthenticated(i))
+
+}
+
+func setupTicketRoutes(e *echo.Echo, i *do.Injector) {
+	ticketHandler, err := do.Invoke[domain.TicketHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	e.POST("/v1/cinema-sessions/:id/tickets", ticketHandler.Create, middleware.EnsureAuthenticated(i), middleware.EnsureEmailVerified(i))
+	e.GET("/v1/users/me/tickets", ticketHandler.GetAllByUser, middleware.EnsureAuthenticated(i))
+
+	group := e.Group("/v1/cinemas/:id/tickets", middleware.EnsureAuthenticated(i))
+	group.GET("/:ticketId", ticketHandler.GetForCheckIn)
+	group.POST("/:ticketId/check-in", ticketHandler.CheckIn)
+}
+
+func setupAuditRoutes(e *echo.Echo, i *do.Injector) {
+	auditLogHandler, err := do.Invoke[domain.AuditLogHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	group := e.Group("/v1/admin/audit-logs", middleware.EnsureAuthenticated(i))
+	group.GET("", auditLogHandler.GetAll)
+}
+
+// setupPartnerRoutes exposes the only routes that accept an API key. Every
+// route must require the scope the partner needs to call it.
+func setupPartnerRoutes(e *echo.Echo, i *do.Injector) {
+	partnerHandler, err := do.Invoke[domain.PartnerHandler](i)
+	if err != nil {
+		panic(err)
+	}
+
+	group := e.Group("/v1/partners", middleware.EnsureAPIKey(i))
+	group.GET("/cinemas/:id/showtimes", partnerHandler.GetShowtimes, middleware.EnsureScope(domain.APIKeyScopeShowtimesRead))
+	group.POST("/cinema-sessions/:id/tickets", partnerHandler.CreateTicket, middleware.EnsureScope(domain.APIKeyScopeOrdersWrite))
+}
+
+// setupImageRoutes serves the images of the local storage backend, the other
+// backends serve their files themselves.
+func setupImageRoutes(e *echo.Echo) {
+	if config.Env.ImageStorage != client.ImageStorageLocal {
+		return
+	}
 
+	e.Static(client.LocalStoragePath, config.Env.LocalStorageDir)
 }
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type sessionHandler struct {
+	i              *do.Injector
+	sessionService domain.SessionService
+}
+
+func NewSessionHandler(i *do.Injector) (domain.SessionHandler, error) {
+	sessionService, err := do.Invoke[domain.SessionService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sessionHandler{
+		i:              i,
+		sessionService: sessionService,
+	}, nil
+}
+
+func (s *sessionHandler) GetJWKS(ctx echo.Context) error {
+	ctx.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return ctx.JSON(http.StatusOK, s.sessionService.GetJWKS())
+}
+
+func (s *sessionHandler) Refresh(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "session"),
+		slog.String("func", "Refresh"),
+	)
+
+	var payload domain.RefreshTokenPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := s.sessionService.Refresh(ctx.Request().Context(), payload.RefreshToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenInvalid) || errors.Is(err, domain.ErrRefreshTokenReused) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Unauthorized", "The refresh token is invalid or has expired. Please sign in again.")
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (s *sessionHandler) SignOut(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "session"),
+		slog.String("func", "SignOut"),
+	)
+
+	if err := s.sessionService.SignOut(ctx.Request().Context()); err != nil {
+		if errors.Is(err, domain.ErrUserNotFoundInContext) {
+			return domain.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (s *sessionHandler) GetAll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "session"),
+		slog.String("func", "GetAll"),
+	)
+
+	response, err := s.sessionService.GetAll(ctx.Request().Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFoundInContext) {
+			return domain.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (s *sessionHandler) Delete(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "session"),
+		slog.String("func", "Delete"),
+	)
+
+	param := ctx.Param("id")
+	sessionID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid session ID provided", slog.String("sessionId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided session ID is not a valid UUID.")
+	}
+
+	if err := s.sessionService.Delete(ctx.Request().Context(), sessionID); err != nil {
+		if errors.Is(err, domain.ErrUserNotFoundInContext) {
+			return domain.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Session Not Found", "The session you are trying to revoke does not exist or has already expired.")
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (s *sessionHandler) DeleteAll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "session"),
+		slog.String("func", "DeleteAll"),
+	)
+
+	if err := s.sessionService.DeleteAll(ctx.Request().Context()); err != nil {
+		if errors.Is(err, domain.ErrUserNotFoundInContext) {
+			return domain.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type ticketHandler struct {
+	i             *do.Injector
+	ticketService domain.TicketService
+}
+
+func NewTicketHandler(i *do.Injector) (domain.TicketHandler, error) {
+	ticketService, err := do.Invoke[domain.TicketService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticketHandler{
+		i:             i,
+		ticketService: ticketService,
+	}, nil
+}
+
+func (t *ticketHandler) Create(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "ticket"),
+		slog.String("func", "Create"),
+	)
+
+	param := ctx.Param("id")
+	cinemaSessionID, err := uuid.Parse(param)
+	if err != nil {
+		log.Warn("Invalid cinema session ID provided", slog.String("cinemaSessionId", param), slog.String("error", err.Error()))
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided session ID is not a valid UUID.")
+	}
+
+	var payload domain.TicketPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := t.ticketService.Create(ctx.Request().Context(), cinemaSessionID, payload)
+	if err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (t *ticketHandler) GetAllByUser(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "ticket"),
+		slog.String("func", "GetAllByUser"),
+	)
+
+	response, err := t.ticketService.GetAllByUser(ctx.Request().Context())
+	if err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (t *ticketHandler) GetForCheckIn(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "ticket"),
+		slog.String("func", "GetForCheckIn"),
+	)
+
+	cinemaID, ticketID, ok := t.parseCinemaTicketParams(ctx, log)
+	if !ok {
+		return nil
+	}
+
+	response, err := t.ticketService.GetForCheckIn(ctx.Request().Context(), cinemaID, ticketID)
+	if err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (t *ticketHandler) CheckIn(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "ticket"),
+		slog.String("func", "CheckIn"),
+	)
+
+	cinemaID, ticketID, ok := t.parseCinemaTicketParams(ctx, log)
+	if !ok {
+		return nil
+	}
+
+	response, err := t.ticketService.CheckIn(ctx.Request().Context(), cinemaID, ticketID)
+	if err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (t *ticketHandler) parseCinemaTicketParams(ctx echo.Context, log *slog.Logger) (uuid.UUID, uuid.UUID, bool) {
+	cinemaParam := ctx.Param("id")
+	cinemaID, err := uuid.Parse(cinemaParam)
+	if err != nil {
+		log.Warn("Invalid cinema ID provided", slog.String("cinemaId", cinemaParam), slog.String("error", err.Error()))
+		_ = domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided cinema ID is not a valid UUID.")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	ticketParam := ctx.Param("ticketId")
+	ticketID, err := uuid.Parse(ticketParam)
+	if err != nil {
+		log.Warn("Invalid ticket ID provided", slog.String("ticketId", ticketParam), slog.String("error", err.Error()))
+		_ = domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid ID", "The provided ticket ID is not a valid UUID.")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return cinemaID, ticketID, true
+}
+
+func (t *ticketHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFoundInContext), errors.Is(err, domain.ErrUserNotFound):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return domain.ForbiddenAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrTwoFactorRequired):
+		return domain.TwoFactorRequiredAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrCinemaNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Cinema Not Found", "The requested cinema does not exist.")
+	case errors.Is(err, domain.ErrCinemaSessionNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Session Not Found", "The requested session does not exist.")
+	case errors.Is(err, domain.ErrCinemaSessionStarted):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Session Started", "Tickets can no longer be bought for this session.")
+	case errors.Is(err, domain.ErrDependentNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Dependent Not Found", "The selected dependent does not exist on your account.")
+	case errors.Is(err, domain.ErrSeatNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Seat Not Found", "The selected seat does not exist in the session room.")
+	case errors.Is(err, domain.ErrSeatUnavailable):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Seat Unavailable", "The selected seat is already taken for this session.")
+	case errors.Is(err, domain.ErrBirthDateRequired):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, nil, "Birth Date Required", "This movie has an age rating. Please add the ticket holder's birth date before buying tickets.")
+	case errors.Is(err, domain.ErrAgeRatingNotMet):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Age Rating", "The ticket holder does not meet the minimum age for this movie on the session date.")
+	case errors.Is(err, domain.ErrGuardianRequired):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Guardian Required", "The ticket holder is under the minimum age for this movie and must be accompanied by a parent or legal guardian.")
+	case errors.Is(err, domain.ErrTicketNotFound):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, nil, "Ticket Not Found", "The requested ticket does not exist in this cinema.")
+	case errors.Is(err, domain.ErrTicketAlreadyCheckedIn):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Already Checked In", "This ticket has already been used.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/utils"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+type twoFactorHandler struct {
+	i                *do.Injector
+	twoFactorService domain.TwoFactorService
+}
+
+func NewTwoFactorHandler(i *do.Injector) (domain.TwoFactorHandler, error) {
+	twoFactorService, err := do.Invoke[domain.TwoFactorService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &twoFactorHandler{
+		i:                i,
+		twoFactorService: twoFactorService,
+	}, nil
+}
+
+func (t *twoFactorHandler) Enroll(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "twoFactor"),
+		slog.String("func", "Enroll"),
+	)
+
+	response, err := t.twoFactorService.Enroll(ctx.Request().Context())
+	if err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (t *twoFactorHandler) Confirm(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "twoFactor"),
+		slog.String("func", "Confirm"),
+	)
+
+	var payload domain.TwoFactorCodePayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := t.twoFactorService.Confirm(ctx.Request().Context(), payload)
+	if err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (t *twoFactorHandler) RegenerateRecoveryCodes(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "twoFactor"),
+		slog.String("func", "RegenerateRecoveryCodes"),
+	)
+
+	var payload domain.TwoFactorCodePayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := t.twoFactorService.RegenerateRecoveryCodes(ctx.Request().Context(), payload)
+	if err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (t *twoFactorHandler) Disable(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "twoFactor"),
+		slog.String("func", "Disable"),
+	)
+
+	var payload domain.TwoFactorCodePayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	if err := t.twoFactorService.Disable(ctx.Request().Context(), payload); err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (t *twoFactorHandler) VerifySignIn(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "twoFactor"),
+		slog.String("func", "VerifySignIn"),
+	)
+
+	var payload domain.TwoFactorSignInPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	payload.IPAddress = ctx.RealIP()
+	if payload.DeviceName == "" {
+		payload.DeviceName = utils.TruncateString(ctx.Request().UserAgent(), 255)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := t.twoFactorService.VerifySignIn(ctx.Request().Context(), payload)
+	if err != nil {
+		return t.handleError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (t *twoFactorHandler) handleError(ctx echo.Context, log *slog.Logger, err error) error {
+	var rateLimitError *domain.RateLimitError
+	switch {
+	case errors.As(err, &rateLimitError):
+		return domain.TooManyRequestsAPIErrorResponse(ctx, rateLimitError.RetryAfter)
+	case errors.Is(err, domain.ErrUserNotFoundInContext), errors.Is(err, domain.ErrUserNotFound):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	case errors.Is(err, domain.ErrTwoFactorTokenInvalid):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Unauthorized credentials", "The sign in attempt expired. Please sign in again.")
+	case errors.Is(err, domain.ErrTwoFactorCodeInvalid):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Invalid code", "The provided two-factor code is invalid or was already used.")
+	case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Conflict", "Two-factor authentication is already enabled.")
+	case errors.Is(err, domain.ErrTwoFactorNotEnrolled):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Conflict", "Start the two-factor enrollment before confirming it.")
+	case errors.Is(err, domain.ErrTwoFactorNotEnabled):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Conflict", "Two-factor authentication is not enabled.")
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/utils"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/labstack/echo/v4"
 	"github.com/samber/do"
@@ -59,7 +60,7 @@ func (u *userHandler) Create(ctx echo.Context) error {
 func (u *userHandler) SignIn(ctx echo.Context) error {
 	log := slog.With(
 		slog.String("handler", "user"),
-		slog.String("func", "Create"),
+		slog.String("func", "SignIn"),
 	)
 
 	var payload domain.SignInPayload
@@ -68,14 +69,24 @@ func (u *userHandler) SignIn(ctx echo.Context) error {
 		return domain.CannotBindPayloadAPIErrorResponse(ctx)
 	}
 
+	payload.IPAddress = ctx.RealIP()
+	if payload.DeviceName == "" {
+		payload.DeviceName = utils.TruncateString(ctx.Request().UserAgent(), 255)
+	}
+
 	if validationErrors := payload.Validate(); validationErrors != nil {
 		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
 	}
 
 	response, err := u.userService.SignIn(ctx.Request().Context(), payload)
 	if err != nil {
-		if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrInvalidPassword) {
-			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Unauthorized", "Invalid email or password. Please check your credentials and try again.")
+		var rateLimitError *domain.RateLimitError
+		if errors.As(err, &rateLimitError) {
+			return domain.TooManyRequestsAPIErrorResponse(ctx, rateLimitError.RetryAfter)
+		}
+
+		if errors.Is(err, domain.ErrInvalidCredentials) || errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrInvalidPassword) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnauthorized, nil, "Unauthorized credentials", "Unauthorized credentials. Review the data sent.")
 		}
 
 		log.Error(err.Error())
@@ -84,3 +95,243 @@ func (u *userHandler) SignIn(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, response)
 }
+
+func (u *userHandler) ForgotPassword(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "ForgotPassword"),
+	)
+
+	var payload domain.ForgotPasswordPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	if err := u.userService.ForgotPassword(ctx.Request().Context(), payload, ctx.RealIP()); err != nil {
+		var rateLimitError *domain.RateLimitError
+		if errors.As(err, &rateLimitError) {
+			return domain.TooManyRequestsAPIErrorResponse(ctx, rateLimitError.RetryAfter)
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+func (u *userHandler) ResetPassword(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "ResetPassword"),
+	)
+
+	var payload domain.ResetPasswordPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	if err := u.userService.ResetPassword(ctx.Request().Context(), payload); err != nil {
+		if errors.Is(err, domain.ErrPasswordResetInvalid) {
+			return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid token", "The password reset link is invalid or has expired. Please request a new one.")
+		}
+
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (u *userHandler) VerifyEmail(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "VerifyEmail"),
+	)
+
+	var payload domain.VerifyEmailPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	if err := u.userService.VerifyEmail(ctx.Request().Context(), payload, ctx.RealIP()); err != nil {
+		return u.handleVerificationError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (u *userHandler) ResendVerification(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "ResendVerification"),
+	)
+
+	if err := u.userService.ResendVerification(ctx.Request().Context()); err != nil {
+		return u.handleVerificationError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+func (u *userHandler) handleVerificationError(ctx echo.Context, log *slog.Logger, err error) error {
+	var rateLimitError *domain.RateLimitError
+	switch {
+	case errors.As(err, &rateLimitError):
+		return domain.TooManyRequestsAPIErrorResponse(ctx, rateLimitError.RetryAfter)
+	case errors.Is(err, domain.ErrVerificationInvalid):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid token", "The verification link is invalid or has expired. Please request a new one.")
+	case errors.Is(err, domain.ErrEmailAlreadyVerified):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "conflict", "The email is already verified.")
+	case errors.Is(err, domain.ErrUserNotFoundInContext), errors.Is(err, domain.ErrUserNotFound):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
+
+func (u *userHandler) GetMe(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "GetMe"),
+	)
+
+	response, err := u.userService.GetMe(ctx.Request().Context())
+	if err != nil {
+		return u.handleProfileError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (u *userHandler) UpdateMe(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "UpdateMe"),
+	)
+
+	var payload domain.UpdateProfilePayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	response, err := u.userService.UpdateMe(ctx.Request().Context(), payload)
+	if err != nil {
+		return u.handleProfileError(ctx, log, err)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (u *userHandler) ChangePassword(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "ChangePassword"),
+	)
+
+	var payload domain.ChangePasswordPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	if err := u.userService.ChangePassword(ctx.Request().Context(), payload); err != nil {
+		return u.handleProfileError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (u *userHandler) ChangeEmail(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "ChangeEmail"),
+	)
+
+	var payload domain.ChangeEmailPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	if err := u.userService.ChangeEmail(ctx.Request().Context(), payload); err != nil {
+		return u.handleProfileError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+func (u *userHandler) ConfirmEmailChange(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "user"),
+		slog.String("func", "ConfirmEmailChange"),
+	)
+
+	var payload domain.ConfirmEmailChangePayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Failed to decode JSON payload", slog.String("error", err.Error()))
+		return domain.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	if validationErrors := payload.Validate(); validationErrors != nil {
+		return domain.NewValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, validationErrors)
+	}
+
+	if err := u.userService.ConfirmEmailChange(ctx.Request().Context(), payload); err != nil {
+		return u.handleProfileError(ctx, log, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (u *userHandler) handleProfileError(ctx echo.Context, log *slog.Logger, err error) error {
+	var rateLimitError *domain.RateLimitError
+	switch {
+	case errors.As(err, &rateLimitError):
+		return domain.TooManyRequestsAPIErrorResponse(ctx, rateLimitError.RetryAfter)
+	case errors.Is(err, domain.ErrInvalidPassword):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Invalid password", "The current password is incorrect.")
+	case errors.Is(err, domain.ErrEmailAlreadyRegister):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "conflict", "The email already registered. Please try again with a different email.")
+	case errors.Is(err, domain.ErrEmailUnchanged):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusUnprocessableEntity, nil, "Validation Error", "The new email must be different from the current one.")
+	case errors.Is(err, domain.ErrBirthDateLocked):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, nil, "Birth Date Locked", "The birth date is already set. Please contact support to correct it.")
+	case errors.Is(err, domain.ErrEmailChangeInvalid):
+		return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, nil, "Invalid token", "The confirmation link is invalid or has expired. Please request the change again.")
+	case errors.Is(err, domain.ErrUserNotFoundInContext), errors.Is(err, domain.ErrUserNotFound):
+		return domain.AccessDeniedAPIErrorResponse(ctx)
+	default:
+		log.Error(err.Error())
+		return domain.InternalServerAPIErrorResponse(ctx)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/GSVillas/movie-pass-api/domain"
 	"github.com/GSVillas/movie-pass-api/mock"
@@ -462,7 +463,9 @@ func TestUserHandler_SignIn_WhenSuccessful_ShouldReturnOK(t *testing.T) {
 	ctx.SetRequest(req.WithContext(context.Background()))
 
 	expectedResponse := &domain.SignInResponse{
-		Token: "valid-token",
+		Token:        "valid-token",
+		RefreshToken: "refresh-token",
+		ExpiresIn:    900,
 	}
 	userServiceMock.EXPECT().SignIn(gomock.Any(), gomock.Any()).Return(expectedResponse, nil)
 
@@ -472,6 +475,237 @@ func TestUserHandler_SignIn_WhenSuccessful_ShouldReturnOK(t *testing.T) {
 
 	if assert.NoError(t, userHandler.SignIn(ctx)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
-		assert.JSONEq(t, `{"token":"valid-token"}`, rec.Body.String())
+		assert.JSONEq(t, `{"token":"valid-token","refreshToken":"refresh-token","expiresIn":900}`, rec.Body.String())
+	}
+}
+
+func TestUserHandler_ForgotPassword_WhenSuccessful_ShouldReturnAccepted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewBufferString(`{
+		"email": " Test@Example.com "
+	}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userServiceMock.EXPECT().ForgotPassword(gomock.Any(), domain.ForgotPasswordPayload{Email: "test@example.com"}, gomock.Any()).Return(nil)
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.ForgotPassword(ctx)) {
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	}
+}
+
+func TestUserHandler_ForgotPassword_WhenForwardedForIsSpoofed_ShouldRateLimitByConnectionIP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+
+	req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewBufferString(`{
+		"email": "test@example.com"
+	}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderXForwardedFor, "198.51.100.1")
+	req.Header.Set(echo.HeaderXRealIP, "198.51.100.2")
+	req.RemoteAddr = "203.0.113.7:52100"
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userServiceMock.EXPECT().ForgotPassword(gomock.Any(), gomock.Any(), "203.0.113.7").Return(nil)
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.ForgotPassword(ctx)) {
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	}
+}
+
+func TestUserHandler_ForgotPassword_WhenRateLimited_ShouldReturnTooManyRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewBufferString(`{
+		"email": "test@example.com"
+	}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userServiceMock.EXPECT().ForgotPassword(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.RateLimitError{RetryAfter: time.Minute})
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.ForgotPassword(ctx)) {
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	}
+}
+
+func TestUserHandler_ResetPassword_WhenTokenIsInvalid_ShouldReturnBadRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(`{
+		"token": "invalid-token",
+		"password": "N3wStr0ngP@ssw0rd!",
+		"confirmPassword": "N3wStr0ngP@ssw0rd!"
+	}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userServiceMock.EXPECT().ResetPassword(gomock.Any(), gomock.Any()).Return(domain.ErrPasswordResetInvalid)
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.ResetPassword(ctx)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"status":400,"title":"Invalid token","details":"The password reset link is invalid or has expired. Please request a new one."}`, rec.Body.String())
+	}
+}
+
+func TestUserHandler_ResetPassword_WhenPasswordIsWeak_ShouldReturnUnprocessableEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(`{
+		"token": "valid-token",
+		"password": "weak",
+		"confirmPassword": "weak"
+	}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.ResetPassword(ctx)) {
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	}
+}
+
+func TestUserHandler_ResendVerification_WhenRateLimited_ShouldReturnTooManyRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodPost, "/email/verification", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userServiceMock.EXPECT().ResendVerification(gomock.Any()).Return(&domain.RateLimitError{RetryAfter: 90 * time.Second})
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.ResendVerification(ctx)) {
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "90", rec.Header().Get("Retry-After"))
+	}
+}
+
+func TestUserHandler_SignIn_WhenAccountIsLocked_ShouldReturnTooManyRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodPost, "/sign-in", bytes.NewBufferString(`{
+		"email": "test@example.com",
+		"password": "WrongP@ssw0rd"
+	}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userServiceMock.EXPECT().SignIn(gomock.Any(), gomock.Any()).Return(nil, &domain.RateLimitError{RetryAfter: 15 * time.Minute})
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.SignIn(ctx)) {
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "900", rec.Header().Get("Retry-After"))
+	}
+}
+
+func TestUserHandler_UpdateMe_WhenBirthDateIsInFuture_ShouldReturnUnprocessableEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+
+	birthDate := time.Now().AddDate(1, 0, 0).Format(time.RFC3339)
+	req := httptest.NewRequest(http.MethodPatch, "/me", bytes.NewBufferString(`{"birthDate": "`+birthDate+`"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.UpdateMe(ctx)) {
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	}
+}
+
+func TestUserHandler_ChangePassword_WhenCurrentPasswordIsWrong_ShouldReturnForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userServiceMock := mock.NewMockUserService(ctrl)
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodPut, "/me/password", bytes.NewBufferString(`{
+		"currentPassword": "WrongP@ssw0rd!",
+		"newPassword": "N3wStr0ngP@ssw0rd!",
+		"confirmNewPassword": "N3wStr0ngP@ssw0rd!"
+	}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	userServiceMock.EXPECT().ChangePassword(gomock.Any(), gomock.Any()).Return(domain.ErrInvalidPassword)
+
+	userHandler := &userHandler{
+		userService: userServiceMock,
+	}
+
+	if assert.NoError(t, userHandler.ChangePassword(ctx)) {
+		assert.Equal(t, http.StatusForbidden, rec.Code)
 	}
 }
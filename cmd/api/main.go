@@ -11,6 +11,7 @@ import (
 	"github.com/GSVillas/movie-pass-api/cmd/api/handler"
 	"github.com/GSVillas/movie-pass-api/config"
 	"github.com/GSVillas/movie-pass-api/config/database"
+	apimiddleware "github.com/GSVillas/movie-pass-api/middleware"
 	"github.com/GSVillas/movie-pass-api/repository"
 	"github.com/GSVillas/movie-pass-api/service"
 	"github.com/go-redis/redis/v8"
@@ -27,6 +28,18 @@ func main() {
 	e := echo.New()
 	i := do.New()
 
+	// The client IP keys rate limits and is stored in the audit log, so
+	// X-Forwarded-For is only read when the request comes from a trusted proxy.
+	if len(config.Env.TrustedProxyRanges) > 0 {
+		trustOptions := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+		for _, ipRange := range config.Env.TrustedProxyRanges {
+			trustOptions = append(trustOptions, echo.TrustIPRange(ipRange))
+		}
+		e.IPExtractor = echo.ExtractIPFromXFFHeader(trustOptions...)
+	} else {
+		e.IPExtractor = echo.ExtractIPDirect()
+	}
+
 	e.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
 		Format: `{"time":"${time_rfc3339_nano}","method":"${method}","uri":"${uri}","status":${status},"latency":"${latency_human}"}`,
 		Output: os.Stdout,
@@ -37,10 +50,13 @@ func main() {
 	}))
 
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{config.Env.FrontURL},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowOrigins:  []string{config.Env.FrontURL},
+		AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, echo.HeaderXRequestID},
+		ExposeHeaders: []string{echo.HeaderXRequestID},
 	}))
 
+	e.Use(apimiddleware.RequestID())
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -62,21 +78,62 @@ func main() {
 		return redisClient, nil
 	})
 
-	do.Provide(i, client.NewCloudFlareService)
+	do.Provide(i, client.NewImageStorage)
+	do.Provide(i, client.NewMetadataProvider)
+	do.Provide(i, client.NewMailSender)
+	do.Provide(i, client.NewOIDCClient)
 
 	do.Provide(i, handler.NewCinemaHandler)
+	do.Provide(i, handler.NewCinemaStaffHandler)
+	do.Provide(i, handler.NewOrganizationHandler)
 	do.Provide(i, handler.NewMovieHandler)
+	do.Provide(i, handler.NewCatalogHandler)
 	do.Provide(i, handler.NewUserHandler)
+	do.Provide(i, handler.NewSessionHandler)
+	do.Provide(i, handler.NewTwoFactorHandler)
+	do.Provide(i, handler.NewOIDCHandler)
+	do.Provide(i, handler.NewPrivacyHandler)
+	do.Provide(i, handler.NewTicketHandler)
+	do.Provide(i, handler.NewDependentHandler)
+	do.Provide(i, handler.NewAPIKeyHandler)
+	do.Provide(i, handler.NewAuditLogHandler)
+	do.Provide(i, handler.NewPartnerHandler)
 
 	do.Provide(i, service.NewCinemaSevice)
+	do.Provide(i, service.NewCinemaStaffService)
+	do.Provide(i, service.NewOrganizationService)
+	do.Provide(i, service.NewAuthorizationService)
 	do.Provide(i, service.NewMovieService)
+	do.Provide(i, service.NewCatalogService)
 	do.Provide(i, service.NewUserService)
 	do.Provide(i, service.NewSessionService)
+	do.Provide(i, service.NewTwoFactorService)
+	do.Provide(i, service.NewOIDCService)
+	do.Provide(i, service.NewPrivacyService)
+	do.Provide(i, service.NewTicketService)
+	do.Provide(i, service.NewDependentService)
+	do.Provide(i, service.NewAPIKeyService)
+	do.Provide(i, service.NewAuditService)
+	do.Provide(i, service.NewPartnerService)
 
 	do.Provide(i, repository.NewCinemaRepository)
+	do.Provide(i, repository.NewCinemaStaffRepository)
+	do.Provide(i, repository.NewOrganizationRepository)
 	do.Provide(i, repository.NewMovieRepository)
+	do.Provide(i, repository.NewCatalogRepository)
 	do.Provide(i, repository.NewUserRepository)
 	do.Provide(i, repository.NewSessionRepository)
+	do.Provide(i, repository.NewRateLimiter)
+	do.Provide(i, repository.NewLoginAttemptRepository)
+	do.Provide(i, repository.NewTwoFactorRepository)
+	do.Provide(i, repository.NewUserIdentityRepository)
+	do.Provide(i, repository.NewPrivacyRepository)
+	do.Provide(i, repository.NewTicketRepository)
+	do.Provide(i, repository.NewSeatRepository)
+	do.Provide(i, repository.NewDependentRepository)
+	do.Provide(i, repository.NewAPIKeyRepository)
+	do.Provide(i, repository.NewAuditLogRepository)
+	do.Provide(i, repository.NewPartnerRepository)
 
 	handler.SetupRoutes(e, i)
 	e.Logger.Fatal(e.Start(fmt.Sprintf(":%s", config.Env.APIPort)))
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
 	"log/slog"
+	"time"
 
 	"github.com/GSVillas/movie-pass-api/client"
 	"github.com/GSVillas/movie-pass-api/config"
@@ -16,6 +18,11 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	idleInterval  = time.Second
+	sweepInterval = time.Hour
+)
+
 func main() {
 	config.ConfigureLogger()
 	config.LoadEnvironments()
@@ -40,11 +47,18 @@ func main() {
 		return redisClient, nil
 	})
 
-	do.Provide(i, client.NewCloudFlareService)
+	do.Provide(i, client.NewImageStorage)
+	do.Provide(i, client.NewMetadataProvider)
 
 	do.Provide(i, service.NewMovieService)
+	do.Provide(i, service.NewAuditService)
+	do.Provide(i, service.NewAuthorizationService)
 
 	do.Provide(i, repository.NewMovieRepository)
+	do.Provide(i, repository.NewAuditLogRepository)
+	do.Provide(i, repository.NewCinemaRepository)
+	do.Provide(i, repository.NewOrganizationRepository)
+	do.Provide(i, repository.NewCinemaStaffRepository)
 
 	movieRepository, err := do.Invoke[domain.MovieRepository](i)
 	if err != nil {
@@ -56,20 +70,34 @@ func main() {
 		panic(err)
 	}
 
+	var lastSweep time.Time
 	for {
+		if time.Since(lastSweep) > sweepInterval {
+			if err := movieService.EnqueueOrphanImageDeletes(context.Background()); err != nil {
+				slog.Error(err.Error())
+			}
+			lastSweep = time.Now()
+		}
+
 		task, err := movieRepository.GetNextDeleteTask(context.Background())
 		if err != nil {
 			slog.Error(err.Error())
+			time.Sleep(idleInterval)
 			continue
 		}
 
 		if task == nil {
+			time.Sleep(idleInterval)
 			continue
 		}
 
 		slog.Info("start delete image in cloud")
 		if err := movieService.ProcessDeleteQueue(context.Background(), *task); err != nil {
 			slog.Error(err.Error())
+			// The task went back onto the queue, wait for the worker of its backend.
+			if errors.Is(err, domain.ErrImageStorageMismatch) {
+				time.Sleep(idleInterval)
+			}
 			continue
 		}
 
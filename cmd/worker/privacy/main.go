@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/config/database"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/repository"
+	"github.com/GSVillas/movie-pass-api/service"
+	"github.com/go-redis/redis/v8"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+const (
+	idleInterval  = time.Second
+	purgeInterval = time.Hour
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	i := do.New()
+
+	db, err := database.NewMysqlConnection(context.Background())
+	if err != nil {
+		log.Fatal("Fail to connect to mysql: ", err)
+	}
+
+	redisClient, err := database.NewRedisConnection(context.Background())
+	if err != nil {
+		log.Fatal("Fail to connect to redis: ", err)
+	}
+
+	do.Provide(i, func(i *do.Injector) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	do.Provide(i, func(i *do.Injector) (*redis.Client, error) {
+		return redisClient, nil
+	})
+
+	do.Provide(i, client.NewMailSender)
+
+	do.Provide(i, service.NewSessionService)
+	do.Provide(i, service.NewPrivacyService)
+	do.Provide(i, service.NewAuditService)
+	do.Provide(i, service.NewAuthorizationService)
+
+	do.Provide(i, repository.NewUserRepository)
+	do.Provide(i, repository.NewUserIdentityRepository)
+	do.Provide(i, repository.NewDependentRepository)
+	do.Provide(i, repository.NewOrganizationRepository)
+	do.Provide(i, repository.NewSessionRepository)
+	do.Provide(i, repository.NewRateLimiter)
+	do.Provide(i, repository.NewPrivacyRepository)
+	do.Provide(i, repository.NewAuditLogRepository)
+	do.Provide(i, repository.NewCinemaRepository)
+	do.Provide(i, repository.NewCinemaStaffRepository)
+
+	privacyRepository, err := do.Invoke[domain.PrivacyRepository](i)
+	if err != nil {
+		panic(err)
+	}
+
+	privacyService, err := do.Invoke[domain.PrivacyService](i)
+	if err != nil {
+		panic(err)
+	}
+
+	auditService, err := do.Invoke[domain.AuditService](i)
+	if err != nil {
+		panic(err)
+	}
+
+	var lastPurge time.Time
+	for {
+		if time.Since(lastPurge) > purgeInterval {
+			if err := privacyService.PurgeExpiredExports(context.Background()); err != nil {
+				slog.Error(err.Error())
+			}
+
+			if err := auditService.PurgeExpired(context.Background()); err != nil {
+				slog.Error(err.Error())
+			}
+			lastPurge = time.Now()
+		}
+
+		task, err := privacyRepository.GetNextTask(context.Background())
+		if err != nil {
+			slog.Error(err.Error())
+			time.Sleep(idleInterval)
+			continue
+		}
+
+		if task == nil {
+			time.Sleep(idleInterval)
+			continue
+		}
+
+		slog.Info("start privacy request", slog.String("type", string(task.Type)), slog.String("requestId", task.RequestID.String()))
+		if err := privacyService.ProcessQueue(context.Background(), *task); err != nil {
+			slog.Error(err.Error())
+			continue
+		}
+
+		slog.Info("privacy request completed", slog.String("requestId", task.RequestID.String()))
+	}
+}
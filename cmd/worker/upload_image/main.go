@@ -40,11 +40,18 @@ func main() {
 		return redisClient, nil
 	})
 
-	do.Provide(i, client.NewCloudFlareService)
+	do.Provide(i, client.NewImageStorage)
+	do.Provide(i, client.NewMetadataProvider)
 
 	do.Provide(i, service.NewMovieService)
+	do.Provide(i, service.NewAuditService)
+	do.Provide(i, service.NewAuthorizationService)
 
 	do.Provide(i, repository.NewMovieRepository)
+	do.Provide(i, repository.NewAuditLogRepository)
+	do.Provide(i, repository.NewCinemaRepository)
+	do.Provide(i, repository.NewOrganizationRepository)
+	do.Provide(i, repository.NewCinemaStaffRepository)
 
 	movieRepository, err := do.Invoke[domain.MovieRepository](i)
 	if err != nil {
@@ -5,12 +5,16 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"log/slog"
+	"net"
 	"os"
+	"strings"
 
 	"github.com/GSVillas/movie-pass-api/config/model"
 	"github.com/Netflix/go-env"
 	"github.com/joho/godotenv"
+	jsoniter "github.com/json-iterator/go"
 )
 
 var Env model.Environment
@@ -26,59 +30,174 @@ func LoadEnvironments() {
 		panic(err)
 	}
 
-	Env.PrivateKey, err = loadPrivateKey()
+	Env.KeyRing, err = loadKeyRing(Env.SigningKeys, Env.ActiveSigningKeyID)
 	if err != nil {
 		panic(err)
 	}
 
-	Env.PublicKey, err = loadPublicKey()
+	Env.OIDCProviders, err = loadOIDCProviders(Env.OIDCProvidersFile)
 	if err != nil {
 		panic(err)
 	}
 
+	Env.TrustedProxyRanges, err = loadTrustedProxies(Env.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
 }
 
-func loadPrivateKey() (*ecdsa.PrivateKey, error) {
-	keyData, err := os.ReadFile("ec_private_key.pem")
-	if err != nil {
-		return nil, err
+// loadTrustedProxies parses TRUSTED_PROXIES, a comma separated list of IPs
+// or CIDR ranges of the proxies allowed to set X-Forwarded-For.
+func loadTrustedProxies(trustedProxies string) ([]*net.IPNet, error) {
+	var ranges []*net.IPNet
+	for _, entry := range strings.Split(trustedProxies, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+			}
+
+			bits := net.IPv6len * 8
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = net.IPv4len * 8
+			}
+
+			ranges = append(ranges, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipRange, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+
+		ranges = append(ranges, ipRange)
 	}
 
-	block, _ := pem.Decode(keyData)
-	if block == nil || block.Type != "EC PRIVATE KEY" {
-		return nil, errors.New("failed to decode PEM block containing private key")
+	return ranges, nil
+}
+
+// loadKeyRing parses JWT_SIGNING_KEYS, a comma separated list of kid:path
+// entries. Each file holds either an EC private key, usable for signing and
+// verification, or a public key of a retired signing key kept for
+// verification only.
+func loadKeyRing(signingKeys, activeKID string) (model.KeyRing, error) {
+	keyRing := model.KeyRing{
+		ActiveKID: activeKID,
 	}
 
-	privateKey, err := x509.ParseECPrivateKey(block.Bytes)
-	if err != nil {
-		return nil, err
+	for _, entry := range strings.Split(signingKeys, ",") {
+		kid, path, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || kid == "" || path == "" {
+			return keyRing, fmt.Errorf("invalid signing key entry %q, expected kid:path", entry)
+		}
+
+		if keyRing.Get(kid) != nil {
+			return keyRing, fmt.Errorf("duplicated signing key id %q", kid)
+		}
+
+		key, err := loadSigningKey(kid, path)
+		if err != nil {
+			return keyRing, fmt.Errorf("failed to load signing key %q: %w", kid, err)
+		}
+
+		keyRing.Keys = append(keyRing.Keys, key)
 	}
 
-	return privateKey, nil
+	active := keyRing.Active()
+	if active == nil {
+		return keyRing, fmt.Errorf("active signing key %q not found in the key ring", activeKID)
+	}
+
+	if active.PrivateKey == nil {
+		return keyRing, fmt.Errorf("active signing key %q has no private key", activeKID)
+	}
+
+	return keyRing, nil
 }
 
-func loadPublicKey() (*ecdsa.PublicKey, error) {
-	keyData, err := os.ReadFile("ec_public_key.pem")
+func loadSigningKey(kid, path string) (*model.SigningKey, error) {
+	keyData, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
 	block, _ := pem.Decode(keyData)
-	if block == nil || block.Type != "PUBLIC KEY" {
-		return nil, errors.New("failed to decode PEM block containing public key")
+	if block == nil {
+		return nil, errors.New("failed to decode PEM block containing the key")
 	}
 
-	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		privateKey, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.SigningKey{KID: kid, PrivateKey: privateKey, PublicKey: &privateKey.PublicKey}, nil
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("not ECDSA private key")
+		}
+
+		return &model.SigningKey{KID: kid, PrivateKey: privateKey, PublicKey: &privateKey.PublicKey}, nil
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, errors.New("not ECDSA public key")
+		}
+
+		return &model.SigningKey{KID: kid, PublicKey: publicKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+func loadOIDCProviders(path string) ([]model.OIDCProvider, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to read OIDC providers file: %w", err)
 	}
 
-	ecdsaPubKey, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, errors.New("not ECDSA public key")
+	var providers []model.OIDCProvider
+	if err := jsoniter.Unmarshal(data, &providers); err != nil {
+		return nil, fmt.Errorf("failed to decode OIDC providers file: %w", err)
+	}
+
+	names := make(map[string]bool)
+	for _, provider := range providers {
+		if provider.Name == "" || provider.Issuer == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			return nil, fmt.Errorf("OIDC provider %q requires name, issuer, clientId and redirectUrl", provider.Name)
+		}
+
+		if names[provider.Name] {
+			return nil, fmt.Errorf("duplicated OIDC provider %q", provider.Name)
+		}
+		names[provider.Name] = true
 	}
 
-	return ecdsaPubKey, nil
+	return providers, nil
 }
 
 func ConfigureLogger() {
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/GSVillas/movie-pass-api/config"
@@ -22,14 +23,33 @@ func main() {
 		log.Fatal("Fail to connect to mysql: ", err)
 	}
 
+	renameMovieImageStorageID(db)
+	assignCinemaOrganizations(db)
+	assignMovieOrganizations(db)
+
+	// Only accounts that existed before email verification are trusted, so
+	// the backfill runs once, when the column is added.
+	emailVerificationAdded := db.Migrator().HasTable(&domain.User{}) && !db.Migrator().HasColumn(&domain.User{}, "emailVerifiedAt")
+
 	if err := db.AutoMigrate(
 		&domain.User{},
+		&domain.UserRecoveryCode{},
+		&domain.UserIdentity{},
+		&domain.PrivacyRequest{},
+		&domain.Organization{},
+		&domain.OrganizationMember{},
+		&domain.APIKey{},
+		&domain.AuditLog{},
 		&domain.Cinema{},
+		&domain.CinemaStaff{},
 		&domain.CinemaSession{},
 		&domain.CinemaRoom{},
 		&domain.IndicativeRating{},
 		&domain.Movie{},
 		&domain.MovieImage{},
+		&domain.Genre{},
+		&domain.MovieCredit{},
+		&domain.Dependent{},
 		&domain.SeatReservation{},
 		&domain.Seat{},
 	); err != nil {
@@ -37,10 +57,160 @@ func main() {
 	}
 
 	populateIndicativeRatings(db)
+	populateGenres(db)
+	backfillMovieCovers(db)
+	backfillMovieSearch(db)
+	if emailVerificationAdded {
+		backfillEmailVerification(db)
+	}
 
 	log.Println("Migration executed successfully")
 }
 
+// renameMovieImageStorageID keeps the IDs of images stored before the
+// storage backends were made pluggable, when the column was cloudFlareId.
+func renameMovieImageStorageID(db *gorm.DB) {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&domain.MovieImage{}) || !migrator.HasColumn(&domain.MovieImage{}, "cloudFlareId") {
+		return
+	}
+
+	if err := migrator.RenameColumn(&domain.MovieImage{}, "cloudFlareId", "storageId"); err != nil {
+		log.Fatal("Fail to rename cloudFlareId column: ", err)
+	}
+	if migrator.HasIndex(&domain.MovieImage{}, "idx_MovieImage_cloud_flare_id") {
+		if err := migrator.RenameIndex(&domain.MovieImage{}, "idx_MovieImage_cloud_flare_id", "idx_MovieImage_storage_id"); err != nil {
+			log.Fatal("Fail to rename cloudFlareId index: ", err)
+		}
+	}
+}
+
+// assignCinemaOrganizations moves the cinemas created before organizations
+// existed into one organization per owner, with the owner as its `owner`
+// member. It runs before AutoMigrate makes Cinema.organizationId required.
+func assignCinemaOrganizations(db *gorm.DB) {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&domain.Cinema{}) {
+		return
+	}
+
+	if err := db.AutoMigrate(&domain.Organization{}, &domain.OrganizationMember{}); err != nil {
+		log.Fatal("Fail to migrate organizations: ", err)
+	}
+
+	if !migrator.HasColumn(&domain.Cinema{}, "organizationId") {
+		if err := db.Exec("ALTER TABLE Cinema ADD COLUMN organizationId char(36) NULL").Error; err != nil {
+			log.Fatal("Fail to add Cinema.organizationId column: ", err)
+		}
+	}
+
+	var owners []domain.User
+	if err := db.Where("id IN (?)", db.Model(&domain.Cinema{}).Select("userId").Where("organizationId IS NULL OR organizationId = ''")).Find(&owners).Error; err != nil {
+		log.Fatal("Fail to retrieve cinema owners: ", err)
+	}
+
+	for _, owner := range owners {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			organizationID, err := createOwnerOrganization(tx, owner)
+			if err != nil {
+				return err
+			}
+
+			return tx.Model(&domain.Cinema{}).
+				Where("userId = ? AND (organizationId IS NULL OR organizationId = '')", owner.ID).
+				Update("organizationId", organizationID).Error
+		})
+		if err != nil {
+			log.Fatal("Fail to assign cinemas of user ", owner.ID, " to an organization: ", err)
+		}
+
+		log.Printf("Moved the cinemas of user %s to a new organization", owner.ID)
+	}
+}
+
+// assignMovieOrganizations moves the movies created before organizations
+// owned them into the oldest organization their creator owns, creating one
+// when the creator has no cinema. It runs after assignCinemaOrganizations so
+// cinema owners keep a single organization.
+func assignMovieOrganizations(db *gorm.DB) {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&domain.Movie{}) {
+		return
+	}
+
+	if err := db.AutoMigrate(&domain.Organization{}, &domain.OrganizationMember{}); err != nil {
+		log.Fatal("Fail to migrate organizations: ", err)
+	}
+
+	if !migrator.HasColumn(&domain.Movie{}, "organizationId") {
+		if err := db.Exec("ALTER TABLE Movie ADD COLUMN organizationId char(36) NULL").Error; err != nil {
+			log.Fatal("Fail to add Movie.organizationId column: ", err)
+		}
+	}
+
+	var owners []domain.User
+	if err := db.Where("id IN (?)", db.Unscoped().Model(&domain.Movie{}).Select("userId").Where("organizationId IS NULL OR organizationId = ''")).Find(&owners).Error; err != nil {
+		log.Fatal("Fail to retrieve movie owners: ", err)
+	}
+
+	for _, owner := range owners {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			var member domain.OrganizationMember
+			result := tx.Where("userId = ? AND role = ?", owner.ID, domain.OrganizationRoleOwner).Order("createdAt").Limit(1).Find(&member)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			organizationID := member.OrganizationID
+			if result.RowsAffected == 0 {
+				var err error
+				if organizationID, err = createOwnerOrganization(tx, owner); err != nil {
+					return err
+				}
+			}
+
+			return tx.Unscoped().Model(&domain.Movie{}).
+				Where("userId = ? AND (organizationId IS NULL OR organizationId = '')", owner.ID).
+				Update("organizationId", organizationID).Error
+		})
+		if err != nil {
+			log.Fatal("Fail to assign movies of user ", owner.ID, " to an organization: ", err)
+		}
+
+		log.Printf("Moved the movies of user %s to an organization", owner.ID)
+	}
+}
+
+// createOwnerOrganization creates an organization named after the user with
+// the user as its `owner` member.
+func createOwnerOrganization(tx *gorm.DB, owner domain.User) (uuid.UUID, error) {
+	now := time.Now().UTC()
+	organization := domain.Organization{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(owner.FirstName + " " + owner.LastName),
+		OwnerID:   owner.ID,
+		CreatedAt: now,
+	}
+
+	if err := tx.Omit("Owner").Create(&organization).Error; err != nil {
+		return uuid.Nil, err
+	}
+
+	member := domain.OrganizationMember{
+		ID:             uuid.New(),
+		OrganizationID: organization.ID,
+		UserID:         owner.ID,
+		Role:           domain.OrganizationRoleOwner,
+		CreatedAt:      now,
+	}
+
+	if err := tx.Omit("Organization", "User").Create(&member).Error; err != nil {
+		return uuid.Nil, err
+	}
+
+	return organization.ID, nil
+}
+
 func populateIndicativeRatings(db *gorm.DB) {
 	indicativeRatings := []domain.IndicativeRating{
 		{
@@ -98,3 +268,113 @@ func populateIndicativeRatings(db *gorm.DB) {
 		}
 	}
 }
+
+func populateGenres(db *gorm.DB) {
+	genres := []domain.Genre{
+		{ID: uuid.MustParse("49b6b34d-d2af-4039-81a2-4f9291ca7a63"), Name: "Action"},
+		{ID: uuid.MustParse("af4db124-adee-4f9f-bb76-d358388963d1"), Name: "Adventure"},
+		{ID: uuid.MustParse("26f297fa-74bd-4916-83b9-0de0a86a22e6"), Name: "Animation"},
+		{ID: uuid.MustParse("893180ab-cd29-4da7-82ef-8476805eab0d"), Name: "Comedy"},
+		{ID: uuid.MustParse("83c4fe5e-bd66-4432-8923-81114e5b1465"), Name: "Crime"},
+		{ID: uuid.MustParse("d57ad03e-8f2c-4815-a84a-44ee74dbb12e"), Name: "Documentary"},
+		{ID: uuid.MustParse("23c44360-89f7-49cb-9f41-e33552576e3c"), Name: "Drama"},
+		{ID: uuid.MustParse("78a54c58-09ee-4f3e-80f9-3f244aee137a"), Name: "Family"},
+		{ID: uuid.MustParse("8c5e1960-aa79-47cf-8b74-d5fa20672c5e"), Name: "Fantasy"},
+		{ID: uuid.MustParse("520e9e07-fd8a-4212-85fd-6112de4cb5ff"), Name: "Horror"},
+		{ID: uuid.MustParse("1c1d5221-591c-44e6-af04-3be98de11e9e"), Name: "Musical"},
+		{ID: uuid.MustParse("faba67ea-270e-4be1-b76f-38ce175dfca3"), Name: "Mystery"},
+		{ID: uuid.MustParse("d9d7f664-b75d-4a95-88d1-d84da40e9390"), Name: "Romance"},
+		{ID: uuid.MustParse("080dd86b-1e19-4ea6-aea8-d3d2e7988a47"), Name: "Science Fiction"},
+		{ID: uuid.MustParse("66274e2e-ec03-4048-9a2b-cdf0eb3683e9"), Name: "Thriller"},
+		{ID: uuid.MustParse("121dcb24-b510-424a-abbc-dd457c62c196"), Name: "War"},
+		{ID: uuid.MustParse("10fbd366-e48a-459c-9d7a-12d3bb67ca02"), Name: "Western"},
+	}
+
+	var existingGenres []domain.Genre
+	if err := db.Find(&existingGenres).Error; err != nil {
+		log.Printf("Error retrieving existing genres: %v", err)
+		return
+	}
+
+	existingGenresMap := make(map[uuid.UUID]bool)
+	for _, genre := range existingGenres {
+		existingGenresMap[genre.ID] = true
+	}
+
+	for _, genre := range genres {
+		if existingGenresMap[genre.ID] {
+			log.Printf("Genre %s already exists, skipping", genre.Name)
+			continue
+		}
+
+		if err := db.Create(&genre).Error; err != nil {
+			log.Printf("Error inserting genre %s: %v", genre.Name, err)
+		} else {
+			log.Printf("Inserted genre %s", genre.Name)
+		}
+	}
+}
+
+// backfillMovieCovers marks the oldest image of movies created before covers
+// existed, so list views keep showing an image for them.
+func backfillMovieCovers(db *gorm.DB) {
+	result := db.Exec(`
+		UPDATE MovieImage SET isCover = true
+		WHERE id IN (
+			SELECT id FROM (
+				SELECT image.id FROM MovieImage image
+				WHERE image.deletedAt IS NULL AND image.parentId IS NULL
+				AND NOT EXISTS (
+					SELECT 1 FROM MovieImage cover
+					WHERE cover.movieId = image.movieId AND cover.isCover = true AND cover.deletedAt IS NULL
+				)
+				AND image.createdAt = (
+					SELECT MIN(oldest.createdAt) FROM MovieImage oldest
+					WHERE oldest.movieId = image.movieId AND oldest.deletedAt IS NULL AND oldest.parentId IS NULL
+				)
+			) AS firstImages
+		)`)
+	if result.Error != nil {
+		log.Printf("Error backfilling movie covers: %v", result.Error)
+		return
+	}
+
+	log.Printf("Marked %d movie images as cover", result.RowsAffected)
+}
+
+// backfillMovieSearch fills the search columns of movies created before the
+// catalog search existed.
+func backfillMovieSearch(db *gorm.DB) {
+	var movies []*domain.Movie
+	result := db.Preload("Credits").Where("searchTitle IS NULL OR searchTitle = ''").FindInBatches(&movies, 100, func(tx *gorm.DB, batch int) error {
+		for _, movie := range movies {
+			movie.RefreshSearchFields()
+			if err := tx.Model(&domain.Movie{}).Where("id = ?", movie.ID).Updates(map[string]any{
+				"searchTitle": movie.SearchTitle,
+				"searchText":  movie.SearchText,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if result.Error != nil {
+		log.Printf("Error backfilling movie search: %v", result.Error)
+		return
+	}
+
+	log.Printf("Indexed %d movies for search", result.RowsAffected)
+}
+
+// backfillEmailVerification marks the users created before email
+// verification existed as verified, so they can keep buying tickets.
+func backfillEmailVerification(db *gorm.DB) {
+	result := db.Exec("UPDATE `User` SET emailVerifiedAt = createdAt WHERE emailVerifiedAt IS NULL AND anonymizedAt IS NULL")
+	if result.Error != nil {
+		log.Printf("Error backfilling email verification: %v", result.Error)
+		return
+	}
+
+	log.Printf("Marked %d users as email verified", result.RowsAffected)
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func NewMysqlConnection(ctx context.Context) (*gorm.DB, error) {
-	db, err := gorm.Open(mysql.Open(config.Env.ConnectionString), &gorm.Config{})
+	db, err := gorm.Open(mysql.Open(config.Env.ConnectionString), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
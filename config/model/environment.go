@@ -1,17 +1,61 @@
 package model
 
-import "crypto/ecdsa"
+import "net"
 
 type Environment struct {
-	ConnectionString     string `env:"CONNECTION_STRING"`
-	RedisAddress         string `env:"REDIS_ADDRESS"`
-	RedisPassword        string `env:"REDIS_PASSWORD"`
-	APIPort              string `env:"API_PORT"`
-	FrontURL             string `env:"FRONT_URL"`
-	CloudFlareAccountAPI string `env:"CLOUD_FLARE_ACCOUNT_API"`
-	CloudFlareApiKey     string `env:"CLOUD_FLARE_API_KEY"`
-	RedisDB              int    `env:"REDIS_DB"`
-	SessionExp           int    `env:"SESSION_EXP"`
-	PrivateKey           *ecdsa.PrivateKey
-	PublicKey            *ecdsa.PublicKey
+	ConnectionString       string `env:"CONNECTION_STRING"`
+	RedisAddress           string `env:"REDIS_ADDRESS"`
+	RedisPassword          string `env:"REDIS_PASSWORD"`
+	APIPort                string `env:"API_PORT"`
+	FrontURL               string `env:"FRONT_URL"`
+	TrustedProxies         string `env:"TRUSTED_PROXIES"`
+	TrustedProxyRanges     []*net.IPNet
+	CloudFlareAccountAPI   string `env:"CLOUD_FLARE_ACCOUNT_API"`
+	CloudFlareApiKey       string `env:"CLOUD_FLARE_API_KEY"`
+	RedisDB                int    `env:"REDIS_DB"`
+	SessionExp             int    `env:"SESSION_EXP"`
+	AccessTokenExp         int    `env:"ACCESS_TOKEN_EXP,default=15"`
+	TokenIssuer            string `env:"TOKEN_ISSUER,default=movie-pass-api"`
+	TokenAudience          string `env:"TOKEN_AUDIENCE,default=movie-pass"`
+	SigningKeys            string `env:"JWT_SIGNING_KEYS,default=default:ec_private_key.pem"`
+	ActiveSigningKeyID     string `env:"JWT_ACTIVE_KID,default=default"`
+	KeyRing                KeyRing
+	PasswordResetExp       int    `env:"PASSWORD_RESET_EXP,default=30"`
+	EmailVerificationExp   int    `env:"EMAIL_VERIFICATION_EXP,default=24"`
+	SignInMaxFailures      int    `env:"SIGN_IN_MAX_FAILURES,default=10"`
+	SignInMaxFailuresPerIP int    `env:"SIGN_IN_MAX_FAILURES_PER_IP,default=50"`
+	SignInLockoutDuration  int    `env:"SIGN_IN_LOCKOUT_DURATION,default=15"`
+	SignInLockoutNotify    bool   `env:"SIGN_IN_LOCKOUT_NOTIFY,default=true"`
+	EncryptionKey          string `env:"ENCRYPTION_KEY"`
+	OIDCProvidersFile      string `env:"OIDC_PROVIDERS_FILE"`
+	OIDCProviders          []OIDCProvider
+	ExportDir              string `env:"EXPORT_DIR,default=exports"`
+	ExportExp              int    `env:"EXPORT_EXP,default=72"`
+	MailDriver             string `env:"MAIL_DRIVER,default=file"`
+	MailDir                string `env:"MAIL_DIR,default=mails"`
+	MailFrom               string `env:"MAIL_FROM,default=no-reply@moviepass.local"`
+	SMTPHost               string `env:"SMTP_HOST"`
+	SMTPPort               string `env:"SMTP_PORT,default=587"`
+	SMTPUsername           string `env:"SMTP_USERNAME"`
+	SMTPPassword           string `env:"SMTP_PASSWORD"`
+	Argon2Memory           int    `env:"ARGON2_MEMORY,default=65536"`
+	Argon2Iterations       int    `env:"ARGON2_ITERATIONS,default=3"`
+	Argon2Parallelism      int    `env:"ARGON2_PARALLELISM,default=2"`
+	AuditLogRetention      int    `env:"AUDIT_LOG_RETENTION,default=365"`
+	MetadataProvider       string `env:"METADATA_PROVIDER,default=fixture"`
+	TMDBAPIURL             string `env:"TMDB_API_URL,default=https://api.themoviedb.org/3"`
+	TMDBAPIKey             string `env:"TMDB_API_KEY"`
+	TMDBImageURL           string `env:"TMDB_IMAGE_URL,default=https://image.tmdb.org/t/p"`
+	TMDBLanguage           string `env:"TMDB_LANGUAGE,default=pt-BR"`
+	TMDBRegion             string `env:"TMDB_REGION,default=BR"`
+	ImageStorage           string `env:"IMAGE_STORAGE,default=cloudflare"`
+	LocalStorageDir        string `env:"LOCAL_STORAGE_DIR,default=images"`
+	LocalStorageURL        string `env:"LOCAL_STORAGE_URL,default=http://localhost:8080/images"`
+	S3Endpoint             string `env:"S3_ENDPOINT"`
+	S3Region               string `env:"S3_REGION,default=us-east-1"`
+	S3Bucket               string `env:"S3_BUCKET"`
+	S3AccessKey            string `env:"S3_ACCESS_KEY"`
+	S3SecretKey            string `env:"S3_SECRET_KEY"`
+	S3PublicURL            string `env:"S3_PUBLIC_URL"`
+	S3PathStyle            bool   `env:"S3_PATH_STYLE,default=true"`
 }
@@ -0,0 +1,28 @@
+package model
+
+import "crypto/ecdsa"
+
+type SigningKey struct {
+	KID        string
+	PrivateKey *ecdsa.PrivateKey
+	PublicKey  *ecdsa.PublicKey
+}
+
+type KeyRing struct {
+	ActiveKID string
+	Keys      []*SigningKey
+}
+
+func (k *KeyRing) Active() *SigningKey {
+	return k.Get(k.ActiveKID)
+}
+
+func (k *KeyRing) Get(kid string) *SigningKey {
+	for _, key := range k.Keys {
+		if key.KID == kid {
+			return key
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,11 @@
 package domain
 
 import (
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -75,6 +79,39 @@ func AccessDeniedAPIErrorResponse(ctx echo.Context) error {
 	return ctx.JSON(http.StatusUnauthorized, errorResponse)
 }
 
+func ForbiddenAPIErrorResponse(ctx echo.Context) error {
+	errorResponse := ErrorResponse{
+		StatusCode: http.StatusForbidden,
+		Title:      "Forbidden",
+		Details:    "You do not have permission to perform this action on the requested resource.",
+		Errors:     nil,
+	}
+	return ctx.JSON(http.StatusForbidden, errorResponse)
+}
+
+func TwoFactorRequiredAPIErrorResponse(ctx echo.Context) error {
+	errorResponse := ErrorResponse{
+		StatusCode: http.StatusForbidden,
+		Title:      "Two-Factor Authentication Required",
+		Details:    "This resource requires a session verified with two-factor authentication. Enable it and sign in again.",
+		Errors:     nil,
+	}
+	return ctx.JSON(http.StatusForbidden, errorResponse)
+}
+
+func TooManyRequestsAPIErrorResponse(ctx echo.Context, retryAfter time.Duration) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	ctx.Response().Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+
+	errorResponse := ErrorResponse{
+		StatusCode: http.StatusTooManyRequests,
+		Title:      "Too Many Requests",
+		Details:    fmt.Sprintf("You have made too many requests. Please try again in %d seconds.", seconds),
+		Errors:     nil,
+	}
+	return ctx.JSON(http.StatusTooManyRequests, errorResponse)
+}
+
 func convertToValidationErrorList(validationErrors ValidationErrors) []ValidationError {
 	errorList := make([]ValidationError, 0, len(validationErrors))
 	for field, message := range validationErrors {
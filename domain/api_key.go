@@ -0,0 +1,147 @@
+package domain
+
+//go:generate mockgen -source=api_key.go -destination=../mock/api_key_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	ErrAPIKeyInvalid  = errors.New("api key is invalid or revoked")
+	ErrAPIKeyRevoked  = errors.New("api key already revoked")
+)
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeShowtimesRead APIKeyScope = "showtimes:read"
+	APIKeyScopeOrdersWrite   APIKeyScope = "orders:write"
+)
+
+type APIKey struct {
+	ID             uuid.UUID    `gorm:"column:id;type:char(36);primaryKey"`
+	OrganizationID uuid.UUID    `gorm:"column:organizationId;type:char(36);not null;index"`
+	Organization   Organization `gorm:"foreignKey:OrganizationID"`
+	CreatedByID    uuid.UUID    `gorm:"column:createdById;type:char(36);not null"`
+	CreatedBy      User         `gorm:"foreignKey:CreatedByID"`
+	Name           string       `gorm:"column:name;type:varchar(100);not null"`
+	Prefix         string       `gorm:"column:prefix;type:varchar(16);not null"`
+	KeyHash        string       `gorm:"column:keyHash;type:char(64);not null;uniqueIndex"`
+	Scopes         string       `gorm:"column:scopes;type:varchar(255);not null"`
+	LastUsedAt     *time.Time   `gorm:"column:lastUsedAt;default:NULL"`
+	RevokedAt      *time.Time   `gorm:"column:revokedAt;default:NULL"`
+	CreatedAt      time.Time    `gorm:"column:createdAt;not null"`
+	UpdatedAt      time.Time    `gorm:"column:updatedAt;default:NULL"`
+}
+
+func (APIKey) TableName() string {
+	return "APIKey"
+}
+
+type APIKeyPayload struct {
+	Name   string        `json:"name" validate:"required,min=1,max=100"`
+	Scopes []APIKeyScope `json:"scopes" validate:"required,min=1,dive,oneof=showtimes:read orders:write"`
+}
+
+type APIKeyResponse struct {
+	ID         uuid.UUID     `json:"id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"`
+	Scopes     []APIKeyScope `json:"scopes"`
+	LastUsedAt *time.Time    `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time    `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time     `json:"createdAt"`
+}
+
+// APIKeySecretResponse is only returned on creation and rotation; the plain
+// key is never stored.
+type APIKeySecretResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+type APIKeyHandler interface {
+	Create(ctx echo.Context) error
+	GetAll(ctx echo.Context) error
+	Revoke(ctx echo.Context) error
+	Rotate(ctx echo.Context) error
+}
+
+type APIKeyService interface {
+	Create(ctx context.Context, organizationID uuid.UUID, payload APIKeyPayload) (*APIKeySecretResponse, error)
+	GetAll(ctx context.Context, organizationID uuid.UUID) ([]*APIKeyResponse, error)
+	Revoke(ctx context.Context, organizationID, apiKeyID uuid.UUID) error
+	Rotate(ctx context.Context, organizationID, apiKeyID uuid.UUID) (*APIKeySecretResponse, error)
+	Authenticate(ctx context.Context, key string) (*APIKey, error)
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, apiKey APIKey) error
+	GetByID(ctx context.Context, apiKeyID uuid.UUID) (*APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	GetAllByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]*APIKey, error)
+	Update(ctx context.Context, apiKey APIKey) error
+	UpdateLastUsed(ctx context.Context, apiKeyID uuid.UUID, lastUsedAt time.Time) error
+}
+
+func (a *APIKeyPayload) trim() {
+	a.Name = strings.TrimSpace(a.Name)
+}
+
+func (a *APIKeyPayload) Validate() ValidationErrors {
+	a.trim()
+	return ValidateStruct(a)
+}
+
+func (a *APIKey) GetScopes() []APIKeyScope {
+	var scopes []APIKeyScope
+	for _, scope := range strings.Split(a.Scopes, ",") {
+		if scope != "" {
+			scopes = append(scopes, APIKeyScope(scope))
+		}
+	}
+
+	return scopes
+}
+
+func (a *APIKey) SetScopes(scopes []APIKeyScope) {
+	values := make([]string, 0, len(scopes))
+	seen := make(map[APIKeyScope]bool)
+	for _, scope := range scopes {
+		if !seen[scope] {
+			seen[scope] = true
+			values = append(values, string(scope))
+		}
+	}
+
+	a.Scopes = strings.Join(values, ",")
+}
+
+func (a *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range a.GetScopes() {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *APIKey) ToAPIKeyResponse() *APIKeyResponse {
+	return &APIKeyResponse{
+		ID:         a.ID,
+		Name:       a.Name,
+		Prefix:     a.Prefix,
+		Scopes:     a.GetScopes(),
+		LastUsedAt: a.LastUsedAt,
+		RevokedAt:  a.RevokedAt,
+		CreatedAt:  a.CreatedAt,
+	}
+}
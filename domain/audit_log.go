@@ -0,0 +1,194 @@
+package domain
+
+//go:generate mockgen -source=audit_log.go -destination=../mock/audit_log_mock.go -package=mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+)
+
+type AuditAction string
+
+const (
+	AuditActionMovieCreate              AuditAction = "movie.create"
+	AuditActionMovieUpdate              AuditAction = "movie.update"
+	AuditActionMovieDelete              AuditAction = "movie.delete"
+	AuditActionCinemaPolicyUpdate       AuditAction = "cinema.policy.update"
+	AuditActionCinemaDelete             AuditAction = "cinema.delete"
+	AuditActionCinemaStaffGrant         AuditAction = "cinema.staff.grant"
+	AuditActionCinemaStaffRevoke        AuditAction = "cinema.staff.revoke"
+	AuditActionOrganizationSecurity     AuditAction = "organization.security.update"
+	AuditActionOrganizationMemberAdd    AuditAction = "organization.member.add"
+	AuditActionOrganizationMemberRemove AuditAction = "organization.member.remove"
+	AuditActionAPIKeyCreate             AuditAction = "apiKey.create"
+	AuditActionAPIKeyRevoke             AuditAction = "apiKey.revoke"
+	AuditActionAPIKeyRotate             AuditAction = "apiKey.rotate"
+	AuditActionUserBirthDateSet         AuditAction = "user.birthDate.set"
+	AuditActionDependentCreate          AuditAction = "dependent.create"
+)
+
+const (
+	AuditTargetMovie              = "movie"
+	AuditTargetCinema             = "cinema"
+	AuditTargetCinemaStaff        = "cinemaStaff"
+	AuditTargetOrganization       = "organization"
+	AuditTargetOrganizationMember = "organizationMember"
+	AuditTargetAPIKey             = "apiKey"
+	AuditTargetUser               = "user"
+	AuditTargetDependent          = "dependent"
+)
+
+// AuditLog rows are append only: the repository exposes no update and rows
+// are only deleted by the retention job.
+type AuditLog struct {
+	ID             uuid.UUID   `gorm:"column:id;type:char(36);primaryKey"`
+	OrganizationID *uuid.UUID  `gorm:"column:organizationId;type:char(36);index"`
+	ActorID        uuid.UUID   `gorm:"column:actorId;type:char(36);not null;index"`
+	Action         AuditAction `gorm:"column:action;type:varchar(100);not null;index"`
+	TargetType     string      `gorm:"column:targetType;type:varchar(50);not null"`
+	TargetID       uuid.UUID   `gorm:"column:targetId;type:char(36);not null;index"`
+	Changes        string      `gorm:"column:changes;type:json"`
+	IPAddress      string      `gorm:"column:ipAddress;type:varchar(45)"`
+	RequestID      string      `gorm:"column:requestId;type:varchar(64)"`
+	CreatedAt      time.Time   `gorm:"column:createdAt;not null;index"`
+}
+
+func (AuditLog) TableName() string {
+	return "AuditLog"
+}
+
+// AuditEntry describes an action to record. Before and After are the
+// response representations of the target so secrets never reach the log.
+type AuditEntry struct {
+	OrganizationID *uuid.UUID
+	Action         AuditAction
+	TargetType     string
+	TargetID       uuid.UUID
+	Before         any
+	After          any
+}
+
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+type AuditLogFilter struct {
+	OrganizationID *uuid.UUID
+	ActorID        *uuid.UUID
+	Action         AuditAction
+	TargetType     string
+	TargetID       *uuid.UUID
+	From           *time.Time
+	To             *time.Time
+}
+
+type AuditLogResponse struct {
+	ID             uuid.UUID              `json:"id"`
+	OrganizationID *uuid.UUID             `json:"organizationId,omitempty"`
+	ActorID        uuid.UUID              `json:"actorId"`
+	Action         AuditAction            `json:"action"`
+	TargetType     string                 `json:"targetType"`
+	TargetID       uuid.UUID              `json:"targetId"`
+	Changes        map[string]AuditChange `json:"changes,omitempty"`
+	IPAddress      string                 `json:"ipAddress,omitempty"`
+	RequestID      string                 `json:"requestId,omitempty"`
+	CreatedAt      time.Time              `json:"createdAt"`
+}
+
+type AuditLogHandler interface {
+	GetAll(ctx echo.Context) error
+}
+
+type AuditService interface {
+	Record(ctx context.Context, entry AuditEntry)
+	GetAll(ctx context.Context, filter AuditLogFilter, pagination *Pagination) (*Pagination, error)
+	PurgeExpired(ctx context.Context) error
+}
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, auditLog AuditLog) error
+	GetAll(ctx context.Context, filter AuditLogFilter, pagination *Pagination) (*Pagination, error)
+	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
+}
+
+// DiffAudit compares the JSON representation of both values and keeps only
+// the fields that changed. A nil side means the target was created or deleted.
+func DiffAudit(before, after any) (map[string]AuditChange, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]AuditChange)
+	for field, value := range beforeFields {
+		if next, ok := afterFields[field]; !ok || !jsonEqual(value, next) {
+			changes[field] = AuditChange{Before: value, After: afterFields[field]}
+		}
+	}
+
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			changes[field] = AuditChange{After: value}
+		}
+	}
+
+	return changes, nil
+}
+
+func auditFields(value any) (map[string]any, error) {
+	fields := make(map[string]any)
+	if value == nil {
+		return fields, nil
+	}
+
+	data, err := jsoniter.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(data) == "null" {
+		return fields, nil
+	}
+
+	if err := jsoniter.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func jsonEqual(a, b any) bool {
+	left, _ := jsoniter.Marshal(a)
+	right, _ := jsoniter.Marshal(b)
+	return string(left) == string(right)
+}
+
+func (a *AuditLog) ToAuditLogResponse() *AuditLogResponse {
+	var changes map[string]AuditChange
+	if a.Changes != "" {
+		_ = jsoniter.UnmarshalFromString(a.Changes, &changes)
+	}
+
+	return &AuditLogResponse{
+		ID:             a.ID,
+		OrganizationID: a.OrganizationID,
+		ActorID:        a.ActorID,
+		Action:         a.Action,
+		TargetType:     a.TargetType,
+		TargetID:       a.TargetID,
+		Changes:        changes,
+		IPAddress:      a.IPAddress,
+		RequestID:      a.RequestID,
+		CreatedAt:      a.CreatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+package domain
+
+//go:generate mockgen -source=authorization.go -destination=../mock/authorization_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPermissionDenied = errors.New("permission denied for the requested resource")
+)
+
+type Permission string
+
+const (
+	PermissionCinemaRead    Permission = "cinema:read"
+	PermissionCinemaUpdate  Permission = "cinema:update"
+	PermissionCinemaDelete  Permission = "cinema:delete"
+	PermissionStaffManage   Permission = "staff:manage"
+	PermissionManagerManage Permission = "manager:manage"
+	PermissionRoomManage    Permission = "room:manage"
+	PermissionSessionManage Permission = "session:manage"
+	PermissionTicketSell    Permission = "ticket:sell"
+	PermissionTicketCheckIn Permission = "ticket:check-in"
+
+	PermissionOrganizationRead     Permission = "organization:read"
+	PermissionOrganizationSecurity Permission = "organization:security"
+	PermissionMemberManage         Permission = "member:manage"
+	PermissionAPIKeyManage         Permission = "api-key:manage"
+	PermissionCinemaCreate         Permission = "cinema:create"
+	PermissionAuditRead            Permission = "audit:read"
+	PermissionMovieManage          Permission = "movie:manage"
+)
+
+type AuthorizationService interface {
+	Authorize(ctx context.Context, cinemaID uuid.UUID, permission Permission) error
+	AuthorizeOrganization(ctx context.Context, organizationID uuid.UUID, permission Permission) error
+}
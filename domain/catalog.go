@@ -0,0 +1,110 @@
+package domain
+
+//go:generate mockgen -source=catalog.go -destination=../mock/catalog_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	DefaultNowShowingDays = 7
+	MaxNowShowingDays     = 30
+	MaxCatalogPageSize    = 50
+)
+
+var (
+	ErrCatalogMoviesNotFound = errors.New("no movies found in the catalog")
+	ErrInvalidCatalogSort    = errors.New("invalid catalog sort")
+)
+
+// CatalogSorts maps the sort values accepted by the public catalog to their
+// ORDER BY clause, so the raw query parameter never reaches the database.
+var CatalogSorts = map[string]string{
+	"title":        "title ASC",
+	"-title":       "title DESC",
+	"releaseDate":  "releaseDate ASC",
+	"-releaseDate": "releaseDate DESC",
+	"duration":     "duration ASC",
+	"-duration":    "duration DESC",
+	"createdAt":    "createdAt ASC",
+	"-createdAt":   "createdAt DESC",
+}
+
+// CatalogFilter narrows the public movie lists. City and CinemaID match the
+// cinemas where the movie has sessions between From and To.
+type CatalogFilter struct {
+	City               string
+	CinemaID           *uuid.UUID
+	GenreID            *uuid.UUID
+	IndicativeRatingID *uuid.UUID
+	From               time.Time
+	To                 time.Time
+}
+
+func (c *CatalogFilter) HasCinemaFilter() bool {
+	return c.City != "" || c.CinemaID != nil
+}
+
+type MovieSearchPayload struct {
+	Query string `json:"q" validate:"required,min=2,max=100"`
+}
+
+// MovieSearchQuery is what the repository runs: Terms is a MySQL boolean
+// mode expression and movies with sessions between NowShowingFrom and
+// NowShowingTo get their relevance multiplied by NowShowingBoost.
+type MovieSearchQuery struct {
+	Terms           string
+	NowShowingFrom  time.Time
+	NowShowingTo    time.Time
+	NowShowingBoost float64
+}
+
+type MovieSearchResult struct {
+	MovieID uuid.UUID `gorm:"column:movieId"`
+	Score   float64   `gorm:"column:score"`
+}
+
+// MovieSearchCandidate is a movie that shares at least one trigram with the
+// query, used to rank typos once the full-text search finds nothing.
+type MovieSearchCandidate struct {
+	MovieID     uuid.UUID `gorm:"column:movieId"`
+	SearchTitle string    `gorm:"column:searchTitle"`
+	NowShowing  bool      `gorm:"column:nowShowing"`
+}
+
+type CatalogHandler interface {
+	GetNowShowing(ctx echo.Context) error
+	GetComingSoon(ctx echo.Context) error
+	GetMovieByID(ctx echo.Context) error
+	Search(ctx echo.Context) error
+}
+
+type CatalogService interface {
+	GetNowShowing(ctx context.Context, filter CatalogFilter, days int, pagination *Pagination) (*Pagination, error)
+	GetComingSoon(ctx context.Context, filter CatalogFilter, pagination *Pagination) (*Pagination, error)
+	GetMovieByID(ctx context.Context, movieID uuid.UUID) (*MovieResponse, error)
+	Search(ctx context.Context, payload MovieSearchPayload, pagination *Pagination) (*Pagination, error)
+}
+
+type CatalogRepository interface {
+	GetNowShowing(ctx context.Context, filter CatalogFilter, pagination *Pagination) (*Pagination, error)
+	GetComingSoon(ctx context.Context, filter CatalogFilter, pagination *Pagination) (*Pagination, error)
+	Search(ctx context.Context, query MovieSearchQuery, pagination *Pagination) ([]*MovieSearchResult, int64, error)
+	GetSearchCandidates(ctx context.Context, query MovieSearchQuery, trigrams []string, limit int) ([]*MovieSearchCandidate, error)
+	GetMoviesByIDs(ctx context.Context, movieIDs []uuid.UUID) ([]*Movie, error)
+}
+
+func (m *MovieSearchPayload) trim() {
+	m.Query = strings.TrimSpace(m.Query)
+}
+
+func (m *MovieSearchPayload) Validate() ValidationErrors {
+	m.trim()
+	return ValidateStruct(m)
+}
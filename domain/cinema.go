@@ -1,5 +1,7 @@
 package domain
 
+//go:generate mockgen -source=cinema.go -destination=../mock/cinema_mock.go -package=mock
+
 import (
 	"context"
 	"errors"
@@ -18,13 +20,19 @@ var (
 )
 
 type Cinema struct {
-	ID        uuid.UUID `gorm:"column:id;type:char(36);primaryKey"`
-	Name      string    `gorm:"column:name;type:varchar(255);not null"`
-	Location  string    `gorm:"column:location;type:varchar(255);not null"`
-	UserID    uuid.UUID `gorm:"column:userId;type:char(36);not null"`
-	User      User      `gorm:"foreignKey:UserID"`
-	CreatedAt time.Time `gorm:"column:createdAt;not null"`
-	UpdatedAt time.Time `gorm:"column:updatedAt;default:NULL"`
+	ID             uuid.UUID    `gorm:"column:id;type:char(36);primaryKey"`
+	Name           string       `gorm:"column:name;type:varchar(255);not null"`
+	Location       string       `gorm:"column:location;type:varchar(255);not null"`
+	City           string       `gorm:"column:city;type:varchar(100);not null;default:'';index"`
+	OrganizationID uuid.UUID    `gorm:"column:organizationId;type:char(36);not null;index"`
+	Organization   Organization `gorm:"foreignKey:OrganizationID"`
+	// AllowAccompaniedMinors lets under-age viewers into sessions rated below
+	// A18 when they are accompanied by a parent or legal guardian.
+	AllowAccompaniedMinors bool      `gorm:"column:allowAccompaniedMinors;not null;default:false"`
+	UserID                 uuid.UUID `gorm:"column:userId;type:char(36);not null"`
+	User                   User      `gorm:"foreignKey:UserID"`
+	CreatedAt              time.Time `gorm:"column:createdAt;not null"`
+	UpdatedAt              time.Time `gorm:"column:updatedAt;default:NULL"`
 }
 
 func (Cinema) TableName() string {
@@ -32,21 +40,32 @@ func (Cinema) TableName() string {
 }
 
 type CinemaPayload struct {
-	Name     string `json:"name" validate:"required,min=1,max=255"`
-	Location string `json:"location" validate:"required,min=1,max=255"`
+	OrganizationID         uuid.UUID `json:"organizationId" validate:"required"`
+	Name                   string    `json:"name" validate:"required,min=1,max=255"`
+	Location               string    `json:"location" validate:"required,min=1,max=255"`
+	City                   string    `json:"city" validate:"required,min=1,max=100"`
+	AllowAccompaniedMinors bool      `json:"allowAccompaniedMinors"`
+}
+
+type CinemaPolicyPayload struct {
+	AllowAccompaniedMinors *bool `json:"allowAccompaniedMinors" validate:"required"`
 }
 
 type CinemaResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	Location  string    `json:"location"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID                     uuid.UUID `json:"id"`
+	OrganizationID         uuid.UUID `json:"organizationId"`
+	Name                   string    `json:"name"`
+	Location               string    `json:"location"`
+	City                   string    `json:"city"`
+	AllowAccompaniedMinors bool      `json:"allowAccompaniedMinors"`
+	CreatedAt              time.Time `json:"createdAt"`
 }
 
 type CinemaHandler interface {
 	Create(ctx echo.Context) error
 	GetByID(ctx echo.Context) error
 	GetAll(ctx echo.Context) error
+	UpdatePolicy(ctx echo.Context) error
 	Delete(ctx echo.Context) error
 }
 
@@ -54,6 +73,7 @@ type CinemaService interface {
 	Create(ctx context.Context, payload CinemaPayload) (*CinemaResponse, error)
 	GetByID(ctx context.Context, cinemaID uuid.UUID) (*CinemaResponse, error)
 	GetAll(ctx context.Context, pagination *Pagination) (*Pagination, error)
+	UpdatePolicy(ctx context.Context, cinemaID uuid.UUID, payload CinemaPolicyPayload) (*CinemaResponse, error)
 	Delete(ctx context.Context, cinemaID uuid.UUID) error
 }
 
@@ -61,12 +81,14 @@ type CinemaRepository interface {
 	Create(ctx context.Context, cinema Cinema) error
 	GetByID(ctx context.Context, cinemaID uuid.UUID) (*Cinema, error)
 	GetAll(ctx context.Context, userID uuid.UUID, pagination *Pagination) (*Pagination, error)
+	Update(ctx context.Context, cinema Cinema) error
 	Delete(ctx context.Context, cinemaID uuid.UUID) error
 }
 
 func (c *CinemaPayload) trim() {
 	c.Name = strings.TrimSpace(c.Name)
 	c.Location = strings.TrimSpace(c.Location)
+	c.City = strings.TrimSpace(c.City)
 }
 
 func (c *CinemaPayload) Validate() ValidationErrors {
@@ -74,21 +96,31 @@ func (c *CinemaPayload) Validate() ValidationErrors {
 	return ValidateStruct(c)
 }
 
+func (c *CinemaPolicyPayload) Validate() ValidationErrors {
+	return ValidateStruct(c)
+}
+
 func (c *CinemaPayload) ToCinema(userID uuid.UUID) *Cinema {
 	return &Cinema{
-		ID:        uuid.New(),
-		Name:      c.Name,
-		Location:  c.Location,
-		UserID:    userID,
-		CreatedAt: time.Now().UTC(),
+		ID:                     uuid.New(),
+		OrganizationID:         c.OrganizationID,
+		Name:                   c.Name,
+		Location:               c.Location,
+		City:                   c.City,
+		AllowAccompaniedMinors: c.AllowAccompaniedMinors,
+		UserID:                 userID,
+		CreatedAt:              time.Now().UTC(),
 	}
 }
 
 func (c *Cinema) ToCinemaResponse() *CinemaResponse {
 	return &CinemaResponse{
-		ID:        c.ID,
-		Name:      c.Name,
-		Location:  c.Location,
-		CreatedAt: c.CreatedAt,
+		ID:                     c.ID,
+		OrganizationID:         c.OrganizationID,
+		Name:                   c.Name,
+		Location:               c.Location,
+		City:                   c.City,
+		AllowAccompaniedMinors: c.AllowAccompaniedMinors,
+		CreatedAt:              c.CreatedAt,
 	}
 }
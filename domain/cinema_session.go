@@ -14,8 +14,8 @@ type CinemaSession struct {
 	Movie        Movie      `gorm:"foreignKey:MovieID"`
 	UserID       uuid.UUID  `gorm:"column:userId;type:char(36);not null"`
 	User         User       `gorm:"foreignKey:UserID"`
-	StartTime    time.Time  `gorm:"column:startTime;type:time;not null"`
-	EndTime      time.Time  `gorm:"column:endTime;type:time;not null"`
+	StartTime    time.Time  `gorm:"column:startTime;type:datetime;not null;index"`
+	EndTime      time.Time  `gorm:"column:endTime;type:datetime;not null"`
 	CreatedAt    time.Time  `gorm:"column:createdAt;not null"`
 	UpdatedAt    time.Time  `gorm:"column:updatedAt;default:NULL"`
 }
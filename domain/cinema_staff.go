@@ -0,0 +1,137 @@
+package domain
+
+//go:generate mockgen -source=cinema_staff.go -destination=../mock/cinema_staff_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrCinemaStaffNotFound = errors.New("cinema staff not found")
+	ErrCinemaStaffExists   = errors.New("user is already a staff member of the cinema")
+)
+
+type CinemaRole string
+
+const (
+	CinemaRoleManager CinemaRole = "manager"
+	CinemaRoleCashier CinemaRole = "cashier"
+	CinemaRoleUsher   CinemaRole = "usher"
+)
+
+var cinemaRolePermissions = map[CinemaRole][]Permission{
+	CinemaRoleManager: {
+		PermissionCinemaRead,
+		PermissionCinemaUpdate,
+		PermissionStaffManage,
+		PermissionRoomManage,
+		PermissionSessionManage,
+		PermissionTicketSell,
+		PermissionTicketCheckIn,
+	},
+	CinemaRoleCashier: {
+		PermissionCinemaRead,
+		PermissionTicketSell,
+	},
+	CinemaRoleUsher: {
+		PermissionCinemaRead,
+		PermissionTicketCheckIn,
+	},
+}
+
+type CinemaStaff struct {
+	ID        uuid.UUID  `gorm:"column:id;type:char(36);primaryKey"`
+	CinemaID  uuid.UUID  `gorm:"column:cinemaId;type:char(36);not null;uniqueIndex:idx_cinema_staff"`
+	Cinema    Cinema     `gorm:"foreignKey:CinemaID"`
+	UserID    uuid.UUID  `gorm:"column:userId;type:char(36);not null;uniqueIndex:idx_cinema_staff"`
+	User      User       `gorm:"foreignKey:UserID"`
+	Role      CinemaRole `gorm:"column:role;type:varchar(20);not null"`
+	CreatedAt time.Time  `gorm:"column:createdAt;not null"`
+	UpdatedAt time.Time  `gorm:"column:updatedAt;default:NULL"`
+}
+
+func (CinemaStaff) TableName() string {
+	return "CinemaStaff"
+}
+
+type CinemaStaffPayload struct {
+	Email string     `json:"email" validate:"required,email,max=255"`
+	Role  CinemaRole `json:"role" validate:"required,oneof=manager cashier usher"`
+}
+
+type CinemaStaffResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"userId"`
+	FirstName string     `json:"firstName"`
+	LastName  string     `json:"lastName"`
+	Email     string     `json:"email"`
+	Role      CinemaRole `json:"role"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type CinemaStaffHandler interface {
+	Create(ctx echo.Context) error
+	GetAll(ctx echo.Context) error
+	Delete(ctx echo.Context) error
+}
+
+type CinemaStaffService interface {
+	Create(ctx context.Context, cinemaID uuid.UUID, payload CinemaStaffPayload) (*CinemaStaffResponse, error)
+	GetAll(ctx context.Context, cinemaID uuid.UUID) ([]*CinemaStaffResponse, error)
+	Delete(ctx context.Context, cinemaID, staffID uuid.UUID) error
+}
+
+type CinemaStaffRepository interface {
+	Create(ctx context.Context, staff CinemaStaff) error
+	GetByID(ctx context.Context, staffID uuid.UUID) (*CinemaStaff, error)
+	GetByCinemaAndUser(ctx context.Context, cinemaID, userID uuid.UUID) (*CinemaStaff, error)
+	GetAllByCinemaID(ctx context.Context, cinemaID uuid.UUID) ([]*CinemaStaff, error)
+	Delete(ctx context.Context, staffID uuid.UUID) error
+}
+
+func (r CinemaRole) HasPermission(permission Permission) bool {
+	for _, p := range cinemaRolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *CinemaStaffPayload) trim() {
+	c.Email = strings.TrimSpace(strings.ToLower(c.Email))
+}
+
+func (c *CinemaStaffPayload) Validate() ValidationErrors {
+	c.trim()
+	return ValidateStruct(c)
+}
+
+func (c *CinemaStaffPayload) ToCinemaStaff(cinemaID, userID uuid.UUID) *CinemaStaff {
+	return &CinemaStaff{
+		ID:        uuid.New(),
+		CinemaID:  cinemaID,
+		UserID:    userID,
+		Role:      c.Role,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+func (c *CinemaStaff) ToCinemaStaffResponse() *CinemaStaffResponse {
+	return &CinemaStaffResponse{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		FirstName: c.User.FirstName,
+		LastName:  c.User.LastName,
+		Email:     c.User.Email,
+		Role:      c.Role,
+		CreatedAt: c.CreatedAt,
+	}
+}
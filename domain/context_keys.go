@@ -3,5 +3,8 @@ package domain
 type ContextKey string
 
 const (
-	SessionKey ContextKey = "session"
+	SessionKey   ContextKey = "session"
+	APIKeyKey    ContextKey = "apiKey"
+	RequestIDKey ContextKey = "requestId"
+	IPAddressKey ContextKey = "ipAddress"
 )
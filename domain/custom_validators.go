@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"errors"
+	"io"
 	"mime/multipart"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"time"
@@ -19,7 +22,10 @@ const (
 	General           = "general"
 	MaxAgeInYears     = 200
 	MaxImagesAllowed  = 3
+	MaxImagesPerMovie = 10
 	MaxImageSize      = 5 * 1024 * 1024
+	MaxImageDimension = 8000
+	MaxImagePixels    = 40_000_000
 )
 
 var AllowedImagesExtensions = map[string]bool{
@@ -28,6 +34,13 @@ var AllowedImagesExtensions = map[string]bool{
 	".jpeg": true,
 }
 
+// AllowedImageContentTypes are checked against the sniffed content, since
+// the extension says nothing about what the file really is.
+var AllowedImageContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+}
+
 func SetupCustomValidations(validator *validator.Validate) error {
 	if err := validator.RegisterValidation(StrongPasswordTag, strongPasswordValidator); err != nil {
 		return err
@@ -100,7 +113,27 @@ func imageFileValidator(fl validator.FieldLevel) bool {
 		if file.Size > MaxImageSize {
 			return false
 		}
+
+		if !isAllowedImageContent(file) {
+			return false
+		}
 	}
 
 	return true
 }
+
+func isAllowedImageContent(file *multipart.FileHeader) bool {
+	content, err := file.Open()
+	if err != nil {
+		return false
+	}
+	defer content.Close()
+
+	header := make([]byte, 512)
+	read, err := io.ReadFull(content, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return false
+	}
+
+	return AllowedImageContentTypes[http.DetectContentType(header[:read])]
+}
@@ -0,0 +1,127 @@
+package domain
+
+//go:generate mockgen -source=dependent.go -destination=../mock/dependent_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrDependentNotFound = errors.New("dependent not found")
+)
+
+// Dependent is a profile managed by a guardian account. Tickets bought for a
+// dependent stay owned by the guardian, but the age checks and the name
+// printed on the ticket come from the dependent.
+type Dependent struct {
+	ID        uuid.UUID      `gorm:"column:id;type:char(36);primaryKey"`
+	UserID    uuid.UUID      `gorm:"column:userId;type:char(36);not null;index"`
+	User      User           `gorm:"foreignKey:UserID"`
+	FirstName string         `gorm:"column:firstName;type:varchar(255);not null"`
+	LastName  string         `gorm:"column:lastName;type:varchar(255);not null"`
+	BirthDate time.Time      `gorm:"column:birthDate;type:date;not null"`
+	CreatedAt time.Time      `gorm:"column:createdAt;not null"`
+	UpdatedAt time.Time      `gorm:"column:updatedAt;default:NULL"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deletedAt;index"`
+}
+
+func (Dependent) TableName() string {
+	return "Dependent"
+}
+
+type DependentPayload struct {
+	FirstName string    `json:"firstName" validate:"required,min=1,max=255"`
+	LastName  string    `json:"lastName" validate:"required,min=1,max=255"`
+	BirthDate time.Time `json:"birthDate" validate:"required,nottooold,notfuturedate"`
+}
+
+type UpdateDependentPayload struct {
+	FirstName *string    `json:"firstName,omitempty" validate:"omitempty,min=1,max=255"`
+	LastName  *string    `json:"lastName,omitempty" validate:"omitempty,min=1,max=255"`
+	BirthDate *time.Time `json:"birthDate,omitempty" validate:"omitempty,nottooold,notfuturedate"`
+}
+
+type DependentResponse struct {
+	ID        uuid.UUID `json:"id"`
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	BirthDate time.Time `json:"birthDate"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type DependentHandler interface {
+	Create(ctx echo.Context) error
+	GetAll(ctx echo.Context) error
+	Update(ctx echo.Context) error
+	Delete(ctx echo.Context) error
+}
+
+type DependentService interface {
+	Create(ctx context.Context, payload DependentPayload) (*DependentResponse, error)
+	GetAll(ctx context.Context) ([]*DependentResponse, error)
+	Update(ctx context.Context, dependentID uuid.UUID, payload UpdateDependentPayload) (*DependentResponse, error)
+	Delete(ctx context.Context, dependentID uuid.UUID) error
+}
+
+type DependentRepository interface {
+	Create(ctx context.Context, dependent Dependent) error
+	GetByID(ctx context.Context, dependentID uuid.UUID) (*Dependent, error)
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*Dependent, error)
+	Update(ctx context.Context, dependent Dependent) error
+	Delete(ctx context.Context, dependentID uuid.UUID) error
+}
+
+func (d *DependentPayload) trim() {
+	d.FirstName = strings.TrimSpace(d.FirstName)
+	d.LastName = strings.TrimSpace(d.LastName)
+}
+
+func (d *DependentPayload) Validate() ValidationErrors {
+	d.trim()
+	return ValidateStruct(d)
+}
+
+func (u *UpdateDependentPayload) trim() {
+	if u.FirstName != nil {
+		firstName := strings.TrimSpace(*u.FirstName)
+		u.FirstName = &firstName
+	}
+
+	if u.LastName != nil {
+		lastName := strings.TrimSpace(*u.LastName)
+		u.LastName = &lastName
+	}
+}
+
+func (u *UpdateDependentPayload) Validate() ValidationErrors {
+	u.trim()
+	return ValidateStruct(u)
+}
+
+func (d *DependentPayload) ToDependent(userID uuid.UUID) *Dependent {
+	return &Dependent{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FirstName: d.FirstName,
+		LastName:  d.LastName,
+		BirthDate: d.BirthDate,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+func (d *Dependent) ToDependentResponse() *DependentResponse {
+	return &DependentResponse{
+		ID:        d.ID,
+		FirstName: d.FirstName,
+		LastName:  d.LastName,
+		BirthDate: d.BirthDate,
+		CreatedAt: d.CreatedAt,
+	}
+}
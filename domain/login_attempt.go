@@ -0,0 +1,15 @@
+package domain
+
+//go:generate mockgen -source=login_attempt.go -destination=../mock/login_attempt_mock.go -package=mock
+
+import (
+	"context"
+	"time"
+)
+
+type LoginAttemptRepository interface {
+	RegisterFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	ResetFailures(ctx context.Context, key string) error
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	GetLockTTL(ctx context.Context, key string) (time.Duration, error)
+}
@@ -1,14 +1,19 @@
 package domain
 
+//go:generate mockgen -source=movie.go -destination=../mock/movie_mock.go -package=mock
+
 import (
 	"context"
 	"errors"
 	"mime/multipart"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/GSVillas/movie-pass-api/utils"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 )
 
 var (
@@ -21,39 +26,143 @@ var (
 	ErrMoviesNotFoundByUserID    = errors.New("no movies found for this user")
 	ErrMoviesNotFound            = errors.New("no movie found")
 	ErrUpdateMovie               = errors.New("error to update a movie")
-	ErrMovieNotBelongUser        = errors.New("the movie does not belong to the user")
+	ErrMovieHasFutureSessions    = errors.New("the movie has sessions scheduled in the future")
+	ErrMovieImageNotFound        = errors.New("movie image not found")
+	ErrMovieImageLimitReached    = errors.New("the movie already has the maximum number of images")
+	ErrMovieImageOrderMismatch   = errors.New("the image order must list every image of the movie exactly once")
+	ErrGenresNotFound            = errors.New("genres not found")
+	ErrGenreNotFound             = errors.New("genre not found")
+	ErrMetadataMovieNotFound     = errors.New("movie not found in the metadata provider")
+	ErrMovieAlreadyImported      = errors.New("the movie was already imported from the metadata provider")
+	ErrIndicativeRatingNotMapped = errors.New("the provider certification does not map to an indicative rating")
+	ErrMovieMetadataIncomplete   = errors.New("the provider has no runtime for the movie")
+	ErrInvalidMovieImage         = errors.New("the file is not a supported image")
+	ErrMovieImageTooLarge        = errors.New("the image exceeds the maximum dimensions")
+	ErrImageStorageMismatch      = errors.New("the image is stored in another storage backend")
+)
+
+type MovieCreditRole string
+
+const (
+	MovieCreditRoleDirector MovieCreditRole = "director"
+	MovieCreditRoleCast     MovieCreditRole = "cast"
+)
+
+type MovieImageVariant string
+
+const (
+	MovieImageVariantPoster    MovieImageVariant = "poster"
+	MovieImageVariantBackdrop  MovieImageVariant = "backdrop"
+	MovieImageVariantThumbnail MovieImageVariant = "thumbnail"
+)
+
+type ImageSize struct {
+	Width  int
+	Height int
+}
+
+// MovieImageVariantSizes are the sizes images are normalized to. Portrait
+// uploads become posters and landscape uploads backdrops, each with a
+// thumbnail of the same aspect ratio.
+var (
+	MovieImageVariantSizes = map[MovieImageVariant]ImageSize{
+		MovieImageVariantPoster:   {Width: 780, Height: 1170},
+		MovieImageVariantBackdrop: {Width: 1280, Height: 720},
+	}
+	MovieImageThumbnailSizes = map[MovieImageVariant]ImageSize{
+		MovieImageVariantPoster:   {Width: 200, Height: 300},
+		MovieImageVariantBackdrop: {Width: 320, Height: 180},
+	}
 )
 
 type Movie struct {
 	ID                 uuid.UUID        `gorm:"column:id;type:char(36);primaryKey"`
 	IndicativeRatingID uuid.UUID        `gorm:"column:indicativeRatingId;type:char(36);not null"`
 	UserID             uuid.UUID        `gorm:"column:userId;type:char(36);not null"`
+	OrganizationID     uuid.UUID        `gorm:"column:organizationId;type:char(36);not null;index"`
 	Title              string           `gorm:"column:title;type:varchar(255);not null;index"`
 	Duration           int              `gorm:"column:duration;type:int;not null"`
+	OriginalTitle      string           `gorm:"column:originalTitle;type:varchar(255)"`
+	Synopsis           string           `gorm:"column:synopsis;type:text"`
+	ReleaseDate        *time.Time       `gorm:"column:releaseDate;type:date;index"`
+	Country            string           `gorm:"column:country;type:char(2)"`
+	OriginalLanguage   string           `gorm:"column:originalLanguage;type:varchar(35)"`
+	TrailerURL         string           `gorm:"column:trailerUrl;type:varchar(255)"`
+	ExternalSource     string           `gorm:"column:externalSource;type:varchar(20);index:idx_movie_external"`
+	ExternalID         string           `gorm:"column:externalId;type:varchar(50);index:idx_movie_external"`
+	SearchTitle        string           `gorm:"column:searchTitle;type:varchar(512);index:idx_movie_search_title,class:FULLTEXT"`
+	SearchText         string           `gorm:"column:searchText;type:text;index:idx_movie_search_text,class:FULLTEXT"`
 	User               User             `gorm:"foreignKey:UserID"`
+	Organization       Organization     `gorm:"foreignKey:OrganizationID"`
 	IndicativeRating   IndicativeRating `gorm:"foreignKey:IndicativeRatingID"`
 	CreatedAt          time.Time        `gorm:"column:createdAt;not null"`
 	UpdatedAt          time.Time        `gorm:"column:updatedAt;default:NULL"`
+	DeletedAt          gorm.DeletedAt   `gorm:"column:deletedAt;index"`
 	Images             []MovieImage     `gorm:"foreignKey:MovieID"`
+	Genres             []Genre          `gorm:"many2many:MovieGenre;joinForeignKey:movieId;joinReferences:genreId"`
+	Credits            []MovieCredit    `gorm:"foreignKey:MovieID"`
 }
 
 func (Movie) TableName() string {
 	return "Movie"
 }
 
+// MovieImage rows are soft deleted when removed and only hard deleted once
+// the stored file is gone, so a failed cleanup can always be retried.
+// Thumbnails are rows of their own pointing to the image through ParentID;
+// only images without a parent are listed, ordered and used as cover.
 type MovieImage struct {
-	ID           uuid.UUID `gorm:"column:id;type:char(36);primaryKey"`
-	MovieID      uuid.UUID `gorm:"column:movieId;type:char(36);not null"`
-	ImageURL     string    `gorm:"column:imageUrl;type:varchar(255);not null"`
-	CloudFlareID uuid.UUID `gorm:"column:cloudFlareId;type:char(36);not null;uniqueIndex"`
-	CreatedAt    time.Time `gorm:"column:createdAt;not null"`
-	UpdatedAt    time.Time `gorm:"column:updatedAt;default:NULL"`
+	ID        uuid.UUID         `gorm:"column:id;type:char(36);primaryKey"`
+	MovieID   uuid.UUID         `gorm:"column:movieId;type:char(36);not null;index:idx_movie_image_position"`
+	ParentID  *uuid.UUID        `gorm:"column:parentId;type:char(36);index"`
+	Variant   MovieImageVariant `gorm:"column:variant;type:varchar(20);not null;default:'poster'"`
+	ImageURL  string            `gorm:"column:imageUrl;type:varchar(255);not null"`
+	StorageID uuid.UUID         `gorm:"column:storageId;type:char(36);not null;uniqueIndex"`
+	// Storage is the backend holding the file. Images stored before the
+	// backends were made pluggable are all on Cloudflare.
+	Storage   string         `gorm:"column:storage;type:varchar(20);not null;default:'cloudflare';index"`
+	Width     int            `gorm:"column:width;not null;default:0"`
+	Height    int            `gorm:"column:height;not null;default:0"`
+	Position  int            `gorm:"column:position;not null;default:0;index:idx_movie_image_position"`
+	IsCover   bool           `gorm:"column:isCover;not null;default:false"`
+	AltText   string         `gorm:"column:altText;type:varchar(255)"`
+	CreatedAt time.Time      `gorm:"column:createdAt;not null"`
+	UpdatedAt time.Time      `gorm:"column:updatedAt;default:NULL"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deletedAt;index"`
+	Variants  []MovieImage   `gorm:"foreignKey:ParentID;constraint:OnDelete:SET NULL"`
 }
 
 func (MovieImage) TableName() string {
 	return "MovieImage"
 }
 
+type Genre struct {
+	ID        uuid.UUID `gorm:"column:id;type:char(36);primaryKey"`
+	Name      string    `gorm:"column:name;type:varchar(50);not null;uniqueIndex"`
+	CreatedAt time.Time `gorm:"column:createdAt;not null"`
+	UpdatedAt time.Time `gorm:"column:updatedAt;default:NULL"`
+}
+
+func (Genre) TableName() string {
+	return "Genre"
+}
+
+// MovieCredit holds both directors and cast members, kept in billing order
+// by Position within each role.
+type MovieCredit struct {
+	ID        uuid.UUID       `gorm:"column:id;type:char(36);primaryKey"`
+	MovieID   uuid.UUID       `gorm:"column:movieId;type:char(36);not null;index"`
+	Role      MovieCreditRole `gorm:"column:role;type:varchar(20);not null"`
+	Name      string          `gorm:"column:name;type:varchar(255);not null"`
+	Character string          `gorm:"column:character;type:varchar(255)"`
+	Position  int             `gorm:"column:position;not null;default:0"`
+	CreatedAt time.Time       `gorm:"column:createdAt;not null"`
+}
+
+func (MovieCredit) TableName() string {
+	return "MovieCredit"
+}
+
 type IndicativeRating struct {
 	ID          uuid.UUID `gorm:"column:id;type:char(36);primaryKey"`
 	Description string    `gorm:"column:description;type:char(4);not null;uniqueIndex"`
@@ -70,23 +179,64 @@ type MovieImageUploadTask struct {
 	MovieID uuid.UUID `json:"movieId"`
 	Image   []byte    `json:"image"`
 	UserID  uuid.UUID `json:"userId"`
+	AltText string    `json:"altText,omitempty"`
 }
 
 type MovieImageDeleteTask struct {
-	CloudFlareID uuid.UUID `json:"cloudFlareId"`
+	StorageID uuid.UUID `json:"storageId"`
+	Storage   string    `json:"storage"`
+	Attempts  int       `json:"attempts"`
 }
 
 type MoviePayload struct {
 	Images             []*multipart.FileHeader `json:"images" validate:"validateImages"`
 	IndicativeRatingID uuid.UUID               `json:"indicativeRatingId" validate:"required,uuid"`
+	OrganizationID     uuid.UUID               `json:"organizationId" validate:"required"`
 	Title              string                  `json:"title" validate:"required,min=1,max=255"`
 	Duration           int                     `json:"duration" validate:"required,gt=0"`
 }
 
+// MovieUpdatePayload only changes the fields that are sent. Lists replace the
+// current ones as a whole and an empty list clears them.
 type MovieUpdatePayload struct {
+	IndicativeRatingID *uuid.UUID          `json:"indicativeRatingId,omitempty" validate:"omitempty,uuid"`
+	Title              *string             `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Duration           *int                `json:"duration,omitempty" validate:"omitempty,gt=0"`
+	OriginalTitle      *string             `json:"originalTitle,omitempty" validate:"omitempty,max=255"`
+	Synopsis           *string             `json:"synopsis,omitempty" validate:"omitempty,max=5000"`
+	ReleaseDate        *time.Time          `json:"releaseDate,omitempty"`
+	Country            *string             `json:"country,omitempty" validate:"omitempty,eq=|iso3166_1_alpha2"`
+	OriginalLanguage   *string             `json:"originalLanguage,omitempty" validate:"omitempty,eq=|bcp47_language_tag"`
+	TrailerURL         *string             `json:"trailerUrl,omitempty" validate:"omitempty,max=255,eq=|http_url"`
+	GenreIDs           *[]uuid.UUID        `json:"genreIds,omitempty" validate:"omitempty,max=10,unique"`
+	Directors          *[]string           `json:"directors,omitempty" validate:"omitempty,max=20,dive,required,max=255"`
+	Cast               *[]MovieCastPayload `json:"cast,omitempty" validate:"omitempty,max=100,dive"`
+}
+
+type MovieCastPayload struct {
+	Name      string `json:"name" validate:"required,max=255"`
+	Character string `json:"character" validate:"max=255"`
+}
+
+type MovieImagesPayload struct {
+	Images  []*multipart.FileHeader `json:"images" validate:"required,min=1,validateImages"`
+	AltText string                  `json:"altText" validate:"max=255"`
+}
+
+type MovieImageOrderPayload struct {
+	ImageIDs []uuid.UUID `json:"imageIds" validate:"required,min=1,unique"`
+}
+
+// MovieImportPayload imports a movie from the metadata provider. The
+// indicative rating is mapped from the provider certification unless given.
+type MovieImportPayload struct {
+	ExternalID         string     `json:"externalId" validate:"required,max=50"`
+	OrganizationID     uuid.UUID  `json:"organizationId" validate:"required"`
 	IndicativeRatingID *uuid.UUID `json:"indicativeRatingId,omitempty" validate:"omitempty,uuid"`
-	Title              *string    `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
-	Duration           *int       `json:"duration,omitempty" validate:"omitempty,gt=0"`
+}
+
+type MovieImageUpdatePayload struct {
+	AltText *string `json:"altText" validate:"required,max=255"`
 }
 
 type IndicativeRatingResponse struct {
@@ -97,38 +247,97 @@ type IndicativeRatingResponse struct {
 
 type MovieResponse struct {
 	ID               uuid.UUID                 `json:"id"`
+	OrganizationID   uuid.UUID                 `json:"organizationId"`
 	Title            string                    `json:"title"`
 	Duration         int                       `json:"duration"`
 	IndicativeRating *IndicativeRatingResponse `json:"indicativeRating,omitempty"`
+	OriginalTitle    string                    `json:"originalTitle,omitempty"`
+	Synopsis         string                    `json:"synopsis,omitempty"`
+	ReleaseDate      *time.Time                `json:"releaseDate,omitempty"`
+	Country          string                    `json:"country,omitempty"`
+	OriginalLanguage string                    `json:"originalLanguage,omitempty"`
+	TrailerURL       string                    `json:"trailerUrl,omitempty"`
+	Genres           []*GenreResponse          `json:"genres,omitempty"`
+	Directors        []string                  `json:"directors,omitempty"`
+	Cast             []*MovieCastResponse      `json:"cast,omitempty"`
 	MovieImages      []*MovieImageResponse     `json:"movieImages"`
 }
 
+type GenreResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type MovieCastResponse struct {
+	Name      string `json:"name"`
+	Character string `json:"character,omitempty"`
+}
+
+type MovieMetadataResponse struct {
+	ExternalID    string     `json:"externalId"`
+	Source        string     `json:"source"`
+	Title         string     `json:"title"`
+	OriginalTitle string     `json:"originalTitle,omitempty"`
+	ReleaseDate   *time.Time `json:"releaseDate,omitempty"`
+	PosterURL     string     `json:"posterUrl,omitempty"`
+}
+
 type MovieImageResponse struct {
-	ID       uuid.UUID `json:"id"`
-	ImageURL string    `json:"imageUrl,omitempty"`
+	ID           uuid.UUID         `json:"id"`
+	ImageURL     string            `json:"imageUrl,omitempty"`
+	Variant      MovieImageVariant `json:"variant"`
+	Width        int               `json:"width,omitempty"`
+	Height       int               `json:"height,omitempty"`
+	ThumbnailURL string            `json:"thumbnailUrl,omitempty"`
+	Position     int               `json:"position"`
+	IsCover      bool              `json:"isCover"`
+	AltText      string            `json:"altText,omitempty"`
 }
 
 type MovieHandler interface {
 	GetAllIndicativeRatings(ctx echo.Context) error
+	GetAllGenres(ctx echo.Context) error
 	Create(ctx echo.Context) error
 	GetAllByUserID(ctx echo.Context) error
 	Update(ctx echo.Context) error
 	Delete(ctx echo.Context) error
+	AddImages(ctx echo.Context) error
+	RemoveImage(ctx echo.Context) error
+	ReorderImages(ctx echo.Context) error
+	SetCoverImage(ctx echo.Context) error
+	UpdateImage(ctx echo.Context) error
+	SearchMetadata(ctx echo.Context) error
+	Import(ctx echo.Context) error
 }
 
 type MovieService interface {
 	GetAllIndicativeRatings(ctx context.Context) ([]*IndicativeRatingResponse, error)
+	GetAllGenres(ctx context.Context) ([]*GenreResponse, error)
 	Create(ctx context.Context, payload MoviePayload) (*MovieResponse, error)
 	ProcessUploadQueue(ctx context.Context, task MovieImageUploadTask) error
 	GetAllByUserID(ctx context.Context, pagination *Pagination) (*Pagination, error)
 	Update(ctx context.Context, ID uuid.UUID, payload MovieUpdatePayload) (*MovieResponse, error)
-	Delete(ctx context.Context, ID uuid.UUID) error
+	Delete(ctx context.Context, ID uuid.UUID, force bool) error
 	ProcessDeleteQueue(ctx context.Context, task MovieImageDeleteTask) error
+	EnqueueOrphanImageDeletes(ctx context.Context) error
+	AddImages(ctx context.Context, movieID uuid.UUID, payload MovieImagesPayload) error
+	RemoveImage(ctx context.Context, movieID, imageID uuid.UUID) error
+	ReorderImages(ctx context.Context, movieID uuid.UUID, payload MovieImageOrderPayload) ([]*MovieImageResponse, error)
+	SetCoverImage(ctx context.Context, movieID, imageID uuid.UUID) ([]*MovieImageResponse, error)
+	UpdateImage(ctx context.Context, movieID, imageID uuid.UUID, payload MovieImageUpdatePayload) (*MovieImageResponse, error)
+	SearchMetadata(ctx context.Context, payload MovieSearchPayload) ([]*MovieMetadataResponse, error)
+	Import(ctx context.Context, payload MovieImportPayload) (*MovieResponse, error)
 }
 
 type MovieRepository interface {
 	GetAllIndicativeRating(ctx context.Context) ([]*IndicativeRating, error)
 	GetIndicativeRatingByID(ctx context.Context, id uuid.UUID) (*IndicativeRating, error)
+	GetIndicativeRatingByDescription(ctx context.Context, description string) (*IndicativeRating, error)
+	GetAllGenres(ctx context.Context) ([]*Genre, error)
+	GetGenresByIDs(ctx context.Context, IDs []uuid.UUID) ([]*Genre, error)
+	GetGenresByNames(ctx context.Context, names []string) ([]*Genre, error)
+	ReplaceGenres(ctx context.Context, movieID uuid.UUID, genres []*Genre) error
+	ReplaceCredits(ctx context.Context, movieID uuid.UUID, role MovieCreditRole, credits []MovieCredit) error
 	Create(ctx context.Context, movie Movie) error
 	CreateMovieImage(ctx context.Context, movieImage MovieImage) error
 	AddUploadTaskToQueue(ctx context.Context, task MovieImageUploadTask) error
@@ -136,7 +345,17 @@ type MovieRepository interface {
 	GetALlByUserID(ctx context.Context, userID uuid.UUID, pagination *Pagination) (*Pagination, error)
 	Update(ctx context.Context, movie Movie) error
 	GetByID(ctx context.Context, ID uuid.UUID, withPreload bool) (*Movie, error)
-	DeleteMovieImage(ctx context.Context, cloudFlareID uuid.UUID) error
+	GetByExternalID(ctx context.Context, source, externalID string) (*Movie, error)
+	Delete(ctx context.Context, ID uuid.UUID) error
+	CountFutureSessions(ctx context.Context, movieID uuid.UUID, from time.Time) (int64, error)
+	GetImagesPendingDeletion(ctx context.Context, storage string, limit int) ([]*MovieImage, error)
+	CountMovieImages(ctx context.Context, movieID uuid.UUID) (int64, error)
+	GetNextImagePosition(ctx context.Context, movieID uuid.UUID) (int, error)
+	UpdateMovieImage(ctx context.Context, movieImage MovieImage) error
+	RemoveMovieImage(ctx context.Context, imageID uuid.UUID) error
+	ReorderMovieImages(ctx context.Context, movieID uuid.UUID, imageIDs []uuid.UUID) error
+	SetMovieCover(ctx context.Context, movieID, imageID uuid.UUID) error
+	DeleteMovieImage(ctx context.Context, storageID uuid.UUID) error
 	AddDeleteTaskToQueue(ctx context.Context, task MovieImageDeleteTask) error
 	GetNextDeleteTask(ctx context.Context) (*MovieImageDeleteTask, error)
 }
@@ -150,16 +369,77 @@ func (m *MoviePayload) Validate() ValidationErrors {
 	return ValidateStruct(m)
 }
 
+func (m *MovieImagesPayload) trim() {
+	m.AltText = strings.TrimSpace(m.AltText)
+}
+
+func (m *MovieImagesPayload) Validate() ValidationErrors {
+	m.trim()
+	return ValidateStruct(m)
+}
+
+func (m *MovieImageOrderPayload) Validate() ValidationErrors {
+	return ValidateStruct(m)
+}
+
+func (m *MovieImportPayload) trim() {
+	m.ExternalID = strings.TrimSpace(m.ExternalID)
+}
+
+func (m *MovieImportPayload) Validate() ValidationErrors {
+	m.trim()
+	return ValidateStruct(m)
+}
+
+func (m *MovieImageUpdatePayload) trim() {
+	if m.AltText != nil {
+		trimmedAltText := strings.TrimSpace(*m.AltText)
+		m.AltText = &trimmedAltText
+	}
+}
+
+func (m *MovieImageUpdatePayload) Validate() ValidationErrors {
+	m.trim()
+	return ValidateStruct(m)
+}
+
 func (m *MovieUpdatePayload) trim() {
-	if m.Title != nil {
-		trimmedTitle := strings.TrimSpace(*m.Title)
-		m.Title = &trimmedTitle
+	for _, field := range []**string{&m.Title, &m.OriginalTitle, &m.Synopsis, &m.Country, &m.OriginalLanguage, &m.TrailerURL} {
+		if *field != nil {
+			trimmed := strings.TrimSpace(**field)
+			*field = &trimmed
+		}
+	}
+
+	if m.Country != nil {
+		country := strings.ToUpper(*m.Country)
+		m.Country = &country
+	}
+
+	if m.Directors != nil {
+		for index, director := range *m.Directors {
+			(*m.Directors)[index] = strings.TrimSpace(director)
+		}
+	}
+
+	if m.Cast != nil {
+		for index := range *m.Cast {
+			(*m.Cast)[index].Name = strings.TrimSpace((*m.Cast)[index].Name)
+			(*m.Cast)[index].Character = strings.TrimSpace((*m.Cast)[index].Character)
+		}
 	}
 }
 
+func (m *MovieUpdatePayload) isEmpty() bool {
+	return m.IndicativeRatingID == nil && m.Title == nil && m.Duration == nil &&
+		m.OriginalTitle == nil && m.Synopsis == nil && m.ReleaseDate == nil &&
+		m.Country == nil && m.OriginalLanguage == nil && m.TrailerURL == nil &&
+		m.GenreIDs == nil && m.Directors == nil && m.Cast == nil
+}
+
 func (m *MovieUpdatePayload) Validate() ValidationErrors {
 	m.trim()
-	if m.IndicativeRatingID == nil && m.Title == nil && m.Duration == nil {
+	if m.isEmpty() {
 		return ValidationErrors{
 			General: ValidationMessages[General],
 		}
@@ -168,6 +448,37 @@ func (m *MovieUpdatePayload) Validate() ValidationErrors {
 	return ValidateStruct(m)
 }
 
+// MinimumAge maps the ClassInd description (AL, A10 ... A18) to the age the
+// viewer must have reached. Unknown descriptions are treated as adults only.
+func (i *IndicativeRating) MinimumAge() int {
+	if i.Description == "AL" {
+		return 0
+	}
+
+	age, err := strconv.Atoi(strings.TrimPrefix(i.Description, "A"))
+	if err != nil {
+		return 18
+	}
+
+	return age
+}
+
+// IndicativeRatingDescription maps a Brazilian certification as published by
+// metadata providers (L, 10 ... 18) to the ClassInd description.
+func IndicativeRatingDescription(certification string) (string, bool) {
+	certification = strings.ToUpper(strings.TrimSpace(certification))
+	switch certification {
+	case "L", "AL", "LIVRE":
+		return "AL", true
+	case "10", "12", "14", "16", "18":
+		return "A" + certification, true
+	case "A10", "A12", "A14", "A16", "A18":
+		return certification, true
+	default:
+		return "", false
+	}
+}
+
 func (i *IndicativeRating) ToIndicativeRatingResponse() *IndicativeRatingResponse {
 	return &IndicativeRatingResponse{
 		ID:          i.ID,
@@ -176,6 +487,27 @@ func (i *IndicativeRating) ToIndicativeRatingResponse() *IndicativeRatingRespons
 	}
 }
 
+// RefreshSearchFields rebuilds the accent-free text used by the catalog
+// search. It must run whenever the titles, synopsis or cast change.
+func (m *Movie) RefreshSearchFields() {
+	m.SearchTitle = utils.TruncateString(utils.NormalizeSearchText(m.Title+" "+m.OriginalTitle), 512)
+
+	parts := []string{m.Title, m.OriginalTitle, m.Synopsis}
+	for _, credit := range m.Credits {
+		if credit.Role == MovieCreditRoleCast {
+			parts = append(parts, credit.Name)
+		}
+	}
+	m.SearchText = utils.NormalizeSearchText(strings.Join(parts, " "))
+}
+
+func (g *Genre) ToGenreResponse() *GenreResponse {
+	return &GenreResponse{
+		ID:   g.ID,
+		Name: g.Name,
+	}
+}
+
 func (m *Movie) ToMovieResponse() *MovieResponse {
 	var MovieImagesResponse []*MovieImageResponse
 
@@ -185,20 +517,71 @@ func (m *Movie) ToMovieResponse() *MovieResponse {
 		}
 	}
 
+	var genresResponse []*GenreResponse
+	for _, genre := range m.Genres {
+		genresResponse = append(genresResponse, genre.ToGenreResponse())
+	}
+
+	var directors []string
+	var cast []*MovieCastResponse
+	for _, credit := range m.Credits {
+		switch credit.Role {
+		case MovieCreditRoleDirector:
+			directors = append(directors, credit.Name)
+		case MovieCreditRoleCast:
+			cast = append(cast, &MovieCastResponse{Name: credit.Name, Character: credit.Character})
+		}
+	}
+
 	return &MovieResponse{
 		ID:               m.ID,
+		OrganizationID:   m.OrganizationID,
 		Title:            m.Title,
 		Duration:         m.Duration,
 		IndicativeRating: m.IndicativeRating.ToIndicativeRatingResponse(),
+		OriginalTitle:    m.OriginalTitle,
+		Synopsis:         m.Synopsis,
+		ReleaseDate:      m.ReleaseDate,
+		Country:          m.Country,
+		OriginalLanguage: m.OriginalLanguage,
+		TrailerURL:       m.TrailerURL,
+		Genres:           genresResponse,
+		Directors:        directors,
+		Cast:             cast,
 		MovieImages:      MovieImagesResponse,
 	}
 }
 
+// DeleteTasks returns the tasks deleting the stored files of the image and
+// of its variants.
+func (m *MovieImage) DeleteTasks() []MovieImageDeleteTask {
+	tasks := []MovieImageDeleteTask{{StorageID: m.StorageID, Storage: m.Storage}}
+	for _, variant := range m.Variants {
+		tasks = append(tasks, MovieImageDeleteTask{StorageID: variant.StorageID, Storage: variant.Storage})
+	}
+
+	return tasks
+}
+
 func (m *MovieImage) ToMovieImageResponse() *MovieImageResponse {
-	return &MovieImageResponse{
+	response := &MovieImageResponse{
 		ID:       m.ID,
 		ImageURL: m.ImageURL,
+		Variant:  m.Variant,
+		Width:    m.Width,
+		Height:   m.Height,
+		Position: m.Position,
+		IsCover:  m.IsCover,
+		AltText:  m.AltText,
+	}
+
+	for _, variant := range m.Variants {
+		if variant.Variant == MovieImageVariantThumbnail {
+			response.ThumbnailURL = variant.ImageURL
+		}
 	}
+
+	return response
 }
 
 func (payload *MoviePayload) ToMovie(userID uuid.UUID) *Movie {
@@ -206,6 +589,7 @@ func (payload *MoviePayload) ToMovie(userID uuid.UUID) *Movie {
 		ID:                 uuid.New(),
 		IndicativeRatingID: payload.IndicativeRatingID,
 		UserID:             userID,
+		OrganizationID:     payload.OrganizationID,
 		Title:              payload.Title,
 		Duration:           payload.Duration,
 		CreatedAt:          time.Now().UTC(),
@@ -0,0 +1,201 @@
+package domain
+
+//go:generate mockgen -source=organization.go -destination=../mock/organization_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrCreateOrganization         = errors.New("error to create a new organization")
+	ErrOrganizationNotFound       = errors.New("organization not found")
+	ErrOrganizationMemberNotFound = errors.New("organization member not found")
+	ErrOrganizationMemberExists   = errors.New("user is already a member of the organization")
+	ErrCannotRemoveOwner          = errors.New("the organization owner cannot be removed")
+)
+
+type OrganizationRole string
+
+const (
+	OrganizationRoleOwner OrganizationRole = "owner"
+	OrganizationRoleAdmin OrganizationRole = "admin"
+)
+
+// Security settings, members and API keys stay with the owner, so an admin
+// cannot lock the owner out or hand out partner access.
+var organizationRolePermissions = map[OrganizationRole][]Permission{
+	OrganizationRoleOwner: {
+		PermissionOrganizationRead,
+		PermissionOrganizationSecurity,
+		PermissionMemberManage,
+		PermissionAPIKeyManage,
+		PermissionCinemaCreate,
+		PermissionAuditRead,
+		PermissionMovieManage,
+	},
+	OrganizationRoleAdmin: {
+		PermissionOrganizationRead,
+		PermissionCinemaCreate,
+		PermissionAuditRead,
+		PermissionMovieManage,
+	},
+}
+
+type Organization struct {
+	ID               uuid.UUID `gorm:"column:id;type:char(36);primaryKey"`
+	Name             string    `gorm:"column:name;type:varchar(255);not null"`
+	OwnerID          uuid.UUID `gorm:"column:ownerId;type:char(36);not null"`
+	Owner            User      `gorm:"foreignKey:OwnerID"`
+	RequireTwoFactor bool      `gorm:"column:requireTwoFactor;not null;default:false"`
+	CreatedAt        time.Time `gorm:"column:createdAt;not null"`
+	UpdatedAt        time.Time `gorm:"column:updatedAt;default:NULL"`
+}
+
+func (Organization) TableName() string {
+	return "Organization"
+}
+
+type OrganizationMember struct {
+	ID             uuid.UUID        `gorm:"column:id;type:char(36);primaryKey"`
+	OrganizationID uuid.UUID        `gorm:"column:organizationId;type:char(36);not null;uniqueIndex:idx_organization_member"`
+	Organization   Organization     `gorm:"foreignKey:OrganizationID"`
+	UserID         uuid.UUID        `gorm:"column:userId;type:char(36);not null;uniqueIndex:idx_organization_member"`
+	User           User             `gorm:"foreignKey:UserID"`
+	Role           OrganizationRole `gorm:"column:role;type:varchar(20);not null"`
+	CreatedAt      time.Time        `gorm:"column:createdAt;not null"`
+	UpdatedAt      time.Time        `gorm:"column:updatedAt;default:NULL"`
+}
+
+func (OrganizationMember) TableName() string {
+	return "OrganizationMember"
+}
+
+func (r OrganizationRole) HasPermission(permission Permission) bool {
+	for _, p := range organizationRolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+type OrganizationPayload struct {
+	Name string `json:"name" validate:"required,min=1,max=255"`
+}
+
+type OrganizationMemberPayload struct {
+	Email string           `json:"email" validate:"required,email,max=255"`
+	Role  OrganizationRole `json:"role" validate:"required,oneof=admin"`
+}
+
+type OrganizationSecurityPayload struct {
+	RequireTwoFactor *bool `json:"requireTwoFactor" validate:"required"`
+}
+
+type OrganizationResponse struct {
+	ID               uuid.UUID        `json:"id"`
+	Name             string           `json:"name"`
+	Role             OrganizationRole `json:"role,omitempty"`
+	RequireTwoFactor bool             `json:"requireTwoFactor"`
+	CreatedAt        time.Time        `json:"createdAt"`
+}
+
+type OrganizationMemberResponse struct {
+	ID        uuid.UUID        `json:"id"`
+	UserID    uuid.UUID        `json:"userId"`
+	FirstName string           `json:"firstName"`
+	LastName  string           `json:"lastName"`
+	Email     string           `json:"email"`
+	Role      OrganizationRole `json:"role"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+type OrganizationHandler interface {
+	Create(ctx echo.Context) error
+	GetAll(ctx echo.Context) error
+	UpdateSecurity(ctx echo.Context) error
+	AddMember(ctx echo.Context) error
+	GetAllMembers(ctx echo.Context) error
+	RemoveMember(ctx echo.Context) error
+}
+
+type OrganizationService interface {
+	Create(ctx context.Context, payload OrganizationPayload) (*OrganizationResponse, error)
+	GetAll(ctx context.Context) ([]*OrganizationResponse, error)
+	UpdateSecurity(ctx context.Context, organizationID uuid.UUID, payload OrganizationSecurityPayload) (*OrganizationResponse, error)
+	AddMember(ctx context.Context, organizationID uuid.UUID, payload OrganizationMemberPayload) (*OrganizationMemberResponse, error)
+	GetAllMembers(ctx context.Context, organizationID uuid.UUID) ([]*OrganizationMemberResponse, error)
+	RemoveMember(ctx context.Context, organizationID, memberID uuid.UUID) error
+}
+
+type OrganizationRepository interface {
+	Create(ctx context.Context, organization Organization) error
+	GetByID(ctx context.Context, organizationID uuid.UUID) (*Organization, error)
+	Update(ctx context.Context, organization Organization) error
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*OrganizationMember, error)
+	CreateMember(ctx context.Context, member OrganizationMember) error
+	GetMember(ctx context.Context, organizationID, userID uuid.UUID) (*OrganizationMember, error)
+	GetMemberByID(ctx context.Context, memberID uuid.UUID) (*OrganizationMember, error)
+	GetAllMembers(ctx context.Context, organizationID uuid.UUID) ([]*OrganizationMember, error)
+	DeleteMember(ctx context.Context, memberID uuid.UUID) error
+}
+
+func (o *OrganizationPayload) trim() {
+	o.Name = strings.TrimSpace(o.Name)
+}
+
+func (o *OrganizationPayload) Validate() ValidationErrors {
+	o.trim()
+	return ValidateStruct(o)
+}
+
+func (o *OrganizationSecurityPayload) Validate() ValidationErrors {
+	return ValidateStruct(o)
+}
+
+func (o *OrganizationMemberPayload) trim() {
+	o.Email = strings.TrimSpace(strings.ToLower(o.Email))
+}
+
+func (o *OrganizationMemberPayload) Validate() ValidationErrors {
+	o.trim()
+	return ValidateStruct(o)
+}
+
+func (o *OrganizationPayload) ToOrganization(ownerID uuid.UUID) *Organization {
+	return &Organization{
+		ID:        uuid.New(),
+		Name:      o.Name,
+		OwnerID:   ownerID,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+func (o *Organization) ToOrganizationResponse(role OrganizationRole) *OrganizationResponse {
+	return &OrganizationResponse{
+		ID:               o.ID,
+		Name:             o.Name,
+		Role:             role,
+		RequireTwoFactor: o.RequireTwoFactor,
+		CreatedAt:        o.CreatedAt,
+	}
+}
+
+func (m *OrganizationMember) ToOrganizationMemberResponse() *OrganizationMemberResponse {
+	return &OrganizationMemberResponse{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		FirstName: m.User.FirstName,
+		LastName:  m.User.LastName,
+		Email:     m.User.Email,
+		Role:      m.Role,
+		CreatedAt: m.CreatedAt,
+	}
+}
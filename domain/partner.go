@@ -0,0 +1,73 @@
+package domain
+
+//go:generate mockgen -source=partner.go -destination=../mock/partner_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrAPIKeyNotFoundInContext = errors.New("api key not found in context")
+)
+
+// PartnerTicketPayload is a ticket sold by a partner. The holder has no
+// account on the platform, so their name and birth date come in the payload.
+type PartnerTicketPayload struct {
+	SeatID                uuid.UUID  `json:"seatId" validate:"required"`
+	HolderName            string     `json:"holderName" validate:"required,min=1,max=255"`
+	HolderBirthDate       *time.Time `json:"holderBirthDate,omitempty" validate:"omitempty,nottooold,notfuturedate"`
+	AccompaniedByGuardian bool       `json:"accompaniedByGuardian"`
+}
+
+type ShowtimeResponse struct {
+	ID               uuid.UUID `json:"id"`
+	CinemaRoomID     uuid.UUID `json:"cinemaRoomId"`
+	MovieID          uuid.UUID `json:"movieId"`
+	MovieTitle       string    `json:"movieTitle"`
+	IndicativeRating string    `json:"indicativeRating"`
+	StartTime        time.Time `json:"startTime"`
+	EndTime          time.Time `json:"endTime"`
+}
+
+type PartnerHandler interface {
+	GetShowtimes(ctx echo.Context) error
+	CreateTicket(ctx echo.Context) error
+}
+
+// PartnerService serves the requests authenticated with an API key. Every
+// call is limited to the cinemas of the organization that owns the key.
+type PartnerService interface {
+	GetShowtimes(ctx context.Context, cinemaID uuid.UUID) ([]*ShowtimeResponse, error)
+	CreateTicket(ctx context.Context, cinemaSessionID uuid.UUID, payload PartnerTicketPayload) (*TicketResponse, error)
+}
+
+type PartnerRepository interface {
+	GetUpcomingSessions(ctx context.Context, cinemaID uuid.UUID, from time.Time) ([]*CinemaSession, error)
+}
+
+func (p *PartnerTicketPayload) trim() {
+	p.HolderName = strings.TrimSpace(p.HolderName)
+}
+
+func (p *PartnerTicketPayload) Validate() ValidationErrors {
+	p.trim()
+	return ValidateStruct(p)
+}
+
+func (c *CinemaSession) ToShowtimeResponse() *ShowtimeResponse {
+	return &ShowtimeResponse{
+		ID:               c.ID,
+		CinemaRoomID:     c.CinemaRoomID,
+		MovieID:          c.MovieID,
+		MovieTitle:       c.Movie.Title,
+		IndicativeRating: c.Movie.IndicativeRating.Description,
+		StartTime:        c.StartTime,
+		EndTime:          c.EndTime,
+	}
+}
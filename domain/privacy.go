@@ -0,0 +1,155 @@
+package domain
+
+//go:generate mockgen -source=privacy.go -destination=../mock/privacy_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrPrivacyRequestNotFound        = errors.New("privacy request not found")
+	ErrPrivacyRequestInProgress      = errors.New("privacy request already in progress")
+	ErrExportNotReady                = errors.New("data export not ready")
+	ErrExportExpired                 = errors.New("data export expired")
+	ErrAccountOwnsOrganization       = errors.New("account owns organizations")
+	ErrUnsupportedPrivacyRequestType = errors.New("unsupported privacy request type")
+)
+
+type PrivacyRequestType string
+
+type PrivacyRequestStatus string
+
+const (
+	PrivacyRequestTypeExport   PrivacyRequestType = "export"
+	PrivacyRequestTypeDeletion PrivacyRequestType = "deletion"
+
+	PrivacyRequestStatusPending    PrivacyRequestStatus = "pending"
+	PrivacyRequestStatusProcessing PrivacyRequestStatus = "processing"
+	PrivacyRequestStatusCompleted  PrivacyRequestStatus = "completed"
+	PrivacyRequestStatusFailed     PrivacyRequestStatus = "failed"
+)
+
+type PrivacyRequest struct {
+	ID          uuid.UUID            `gorm:"column:id;type:char(36);primaryKey"`
+	UserID      uuid.UUID            `gorm:"column:userId;type:char(36);not null;index"`
+	User        User                 `gorm:"foreignKey:UserID"`
+	Type        PrivacyRequestType   `gorm:"column:type;type:varchar(20);not null"`
+	Status      PrivacyRequestStatus `gorm:"column:status;type:varchar(20);not null"`
+	FilePath    string               `gorm:"column:filePath;type:varchar(255);default:NULL"`
+	ExpiresAt   *time.Time           `gorm:"column:expiresAt;default:NULL"`
+	CompletedAt *time.Time           `gorm:"column:completedAt;default:NULL"`
+	CreatedAt   time.Time            `gorm:"column:createdAt;not null"`
+	UpdatedAt   time.Time            `gorm:"column:updatedAt;default:NULL"`
+}
+
+func (PrivacyRequest) TableName() string {
+	return "PrivacyRequest"
+}
+
+type PrivacyTask struct {
+	RequestID uuid.UUID          `json:"requestId"`
+	UserID    uuid.UUID          `json:"userId"`
+	Type      PrivacyRequestType `json:"type"`
+}
+
+type DeleteAccountPayload struct {
+	Password     string `json:"password,omitempty" validate:"max=255"`
+	Confirmation string `json:"confirmation" validate:"required,eq=DELETE"`
+}
+
+type PrivacyRequestResponse struct {
+	ID          uuid.UUID            `json:"id"`
+	Type        PrivacyRequestType   `json:"type"`
+	Status      PrivacyRequestStatus `json:"status"`
+	ExpiresAt   *time.Time           `json:"expiresAt,omitempty"`
+	CompletedAt *time.Time           `json:"completedAt,omitempty"`
+	CreatedAt   time.Time            `json:"createdAt"`
+}
+
+type AccountDataExport struct {
+	GeneratedAt             time.Time                `json:"generatedAt"`
+	Profile                 *UserResponse            `json:"profile"`
+	Identities              []IdentityExport         `json:"identities"`
+	Dependents              []DependentResponse      `json:"dependents"`
+	Tickets                 []TicketExport           `json:"tickets"`
+	Sessions                []SessionExport          `json:"sessions"`
+	OrganizationMemberships []MembershipExport       `json:"organizationMemberships"`
+	PrivacyRequests         []PrivacyRequestResponse `json:"privacyRequests"`
+}
+
+type IdentityExport struct {
+	Provider  string    `json:"provider"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TicketExport struct {
+	ID              uuid.UUID  `json:"id"`
+	CinemaSessionID uuid.UUID  `json:"cinemaSessionId"`
+	SeatID          uuid.UUID  `json:"seatId"`
+	DependentID     *uuid.UUID `json:"dependentId,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+type SessionExport struct {
+	DeviceName string    `json:"deviceName"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+}
+
+type MembershipExport struct {
+	OrganizationID   uuid.UUID        `json:"organizationId"`
+	OrganizationName string           `json:"organizationName"`
+	Role             OrganizationRole `json:"role"`
+	CreatedAt        time.Time        `json:"createdAt"`
+}
+
+type PrivacyHandler interface {
+	RequestExport(ctx echo.Context) error
+	DownloadExport(ctx echo.Context) error
+	RequestDeletion(ctx echo.Context) error
+	GetAll(ctx echo.Context) error
+}
+
+type PrivacyService interface {
+	RequestExport(ctx context.Context) (*PrivacyRequestResponse, error)
+	GetExportFile(ctx context.Context, requestID uuid.UUID) (string, error)
+	RequestDeletion(ctx context.Context, payload DeleteAccountPayload) (*PrivacyRequestResponse, error)
+	GetAll(ctx context.Context) ([]*PrivacyRequestResponse, error)
+	ProcessQueue(ctx context.Context, task PrivacyTask) error
+	PurgeExpiredExports(ctx context.Context) error
+}
+
+type PrivacyRepository interface {
+	Create(ctx context.Context, request PrivacyRequest) error
+	Update(ctx context.Context, request PrivacyRequest) error
+	GetByID(ctx context.Context, requestID uuid.UUID) (*PrivacyRequest, error)
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*PrivacyRequest, error)
+	GetOpenByUserID(ctx context.Context, userID uuid.UUID, requestType PrivacyRequestType) (*PrivacyRequest, error)
+	GetExpiredExports(ctx context.Context, now time.Time) ([]*PrivacyRequest, error)
+	GetTicketsByUserID(ctx context.Context, userID uuid.UUID) ([]*SeatReservation, error)
+	AnonymizeUser(ctx context.Context, userID uuid.UUID, anonymizedAt time.Time) error
+	AddTaskToQueue(ctx context.Context, task PrivacyTask) error
+	GetNextTask(ctx context.Context) (*PrivacyTask, error)
+}
+
+func (d *DeleteAccountPayload) Validate() ValidationErrors {
+	return ValidateStruct(d)
+}
+
+func (p *PrivacyRequest) ToPrivacyRequestResponse() *PrivacyRequestResponse {
+	return &PrivacyRequestResponse{
+		ID:          p.ID,
+		Type:        p.Type,
+		Status:      p.Status,
+		ExpiresAt:   p.ExpiresAt,
+		CompletedAt: p.CompletedAt,
+		CreatedAt:   p.CreatedAt,
+	}
+}
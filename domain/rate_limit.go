@@ -0,0 +1,21 @@
+package domain
+
+//go:generate mockgen -source=rate_limit.go -destination=../mock/rate_limit_mock.go -package=mock
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (r *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %s", r.RetryAfter)
+}
+
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit int64, window time.Duration) error
+}
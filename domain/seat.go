@@ -1,5 +1,7 @@
 package domain
 
+//go:generate mockgen -source=seat.go -destination=../mock/seat_mock.go -package=mock
+
 import (
 	"context"
 	"time"
@@ -8,16 +8,44 @@ import (
 
 type SeatReservation struct {
 	ID              uuid.UUID     `gorm:"column:id;type:char(36);primaryKey"`
-	CinemaSessionID uuid.UUID     `gorm:"column:cinemaSessionId;type:char(36);not null"`
+	CinemaSessionID uuid.UUID     `gorm:"column:cinemaSessionId;type:char(36);not null;uniqueIndex:idx_session_seat"`
 	CinemaSession   CinemaSession `gorm:"foreignKey:CinemaSessionID"`
-	SeatID          uuid.UUID     `gorm:"column:SeatId;type:char(36);not null"`
+	SeatID          uuid.UUID     `gorm:"column:SeatId;type:char(36);not null;uniqueIndex:idx_session_seat"`
 	Seat            Seat          `gorm:"foreignKey:SeatID"`
 	UserID          uuid.UUID     `gorm:"column:UserID;type:char(36);not null"`
-	User            User          `gorm:"foreignKey:SeatID"`
-	CreatedAt       time.Time     `gorm:"column:createdAt;not null"`
-	UpdatedAt       time.Time     `gorm:"column:updatedAt;default:NULL"`
+	User            User          `gorm:"foreignKey:UserID"`
+	DependentID     *uuid.UUID    `gorm:"column:dependentId;type:char(36);default:NULL;index"`
+	Dependent       *Dependent    `gorm:"foreignKey:DependentID"`
+	// APIKeyID is set on tickets sold by a partner. UserID then points to
+	// the member who created the key and the holder comes from HolderName
+	// and HolderBirthDate.
+	APIKeyID         *uuid.UUID `gorm:"column:apiKeyId;type:char(36);default:NULL;index"`
+	APIKey           *APIKey    `gorm:"foreignKey:APIKeyID"`
+	HolderName       string     `gorm:"column:holderName;type:varchar(255);not null;default:''"`
+	HolderBirthDate  *time.Time `gorm:"column:holderBirthDate;type:date;default:NULL"`
+	MinimumAge       int        `gorm:"column:minimumAge;type:int;not null;default:0"`
+	RequiresGuardian bool       `gorm:"column:requiresGuardian;not null;default:false"`
+	CheckedInAt      *time.Time `gorm:"column:checkedInAt;default:NULL"`
+	CheckedInBy      *uuid.UUID `gorm:"column:checkedInBy;type:char(36);default:NULL"`
+	CreatedAt        time.Time  `gorm:"column:createdAt;not null"`
+	UpdatedAt        time.Time  `gorm:"column:updatedAt;default:NULL"`
 }
 
 func (SeatReservation) TableName() string {
 	return "SeatReservation"
 }
+
+// Holder returns the name and birth date of the person attending the
+// session: the partner's customer, the dependent when the ticket was bought
+// for one, otherwise the account holder.
+func (s *SeatReservation) Holder() (string, *time.Time) {
+	if s.APIKeyID != nil {
+		return s.HolderName, s.HolderBirthDate
+	}
+
+	if s.Dependent != nil {
+		return s.Dependent.FirstName + " " + s.Dependent.LastName, &s.Dependent.BirthDate
+	}
+
+	return s.User.FirstName + " " + s.User.LastName, s.User.BirthDate
+}
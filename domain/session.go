@@ -5,8 +5,11 @@ package domain
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
 )
 
 var (
@@ -17,22 +20,119 @@ var (
 	ErrSessionMismatch        = errors.New("session icompatible for user requested")
 	ErrCreateSession          = errors.New("create session fails")
 	ErrCreateToken            = errors.New("create session fails")
+	ErrSessionRevoked         = errors.New("session has been revoked")
+	ErrRefreshTokenInvalid    = errors.New("invalid refresh token")
+	ErrRefreshTokenReused     = errors.New("refresh token reused, session revoked")
+	ErrSigningKeyNotFound     = errors.New("signing key not found in the key ring")
 )
 
 type Session struct {
-	Token     string    `json:"token"`
-	FirstName string    `json:"firstName"`
-	LastName  string    `json:"lastName"`
-	UserID    uuid.UUID `json:"MoviePassId"`
-	Email     string    `json:"email"`
+	ID                uuid.UUID `json:"id"`
+	FirstName         string    `json:"firstName"`
+	LastName          string    `json:"lastName"`
+	UserID            uuid.UUID `json:"MoviePassId"`
+	Email             string    `json:"email"`
+	DeviceName        string    `json:"deviceName"`
+	IPAddress         string    `json:"ipAddress"`
+	CreatedAt         time.Time `json:"createdAt"`
+	LastSeenAt        time.Time `json:"lastSeenAt"`
+	TwoFactorVerified bool      `json:"twoFactorVerified"`
+}
+
+type RefreshToken struct {
+	UserID    uuid.UUID `json:"userId"`
+	SessionID uuid.UUID `json:"sessionId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type SessionTokens struct {
+	AccessToken  string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
+	ExpiresIn    int64  `json:"expiresIn"`
+}
+
+type RefreshTokenPayload struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+type SessionMetadata struct {
+	DeviceName string
+	IPAddress  string
+}
+
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	DeviceName string    `json:"deviceName"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	Current    bool      `json:"current"`
+}
+
+type SessionHandler interface {
+	GetJWKS(ctx echo.Context) error
+	Refresh(ctx echo.Context) error
+	SignOut(ctx echo.Context) error
+	GetAll(ctx echo.Context) error
+	Delete(ctx echo.Context) error
+	DeleteAll(ctx echo.Context) error
 }
 
 type SessionService interface {
-	Create(ctx context.Context, user User) (string, error)
+	Create(ctx context.Context, user User, metadata SessionMetadata) (*SessionTokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*SessionTokens, error)
 	GetSession(ctx context.Context, token string) (*Session, error)
+	GetAll(ctx context.Context) ([]*SessionResponse, error)
+	SignOut(ctx context.Context) error
+	Delete(ctx context.Context, sessionID uuid.UUID) error
+	DeleteAll(ctx context.Context) error
+	RevokeAll(ctx context.Context, userID uuid.UUID) error
+	RevokeOthers(ctx context.Context) error
+	SyncUser(ctx context.Context, user User) error
+	MarkTwoFactorVerified(ctx context.Context) error
+	GetJWKS() *JSONWebKeySet
 }
 
 type SessionRepository interface {
 	Create(ctx context.Context, session Session) error
-	GetSession(ctx context.Context, userID uuid.UUID) (*Session, error)
+	GetSession(ctx context.Context, userID, sessionID uuid.UUID) (*Session, error)
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*Session, error)
+	Update(ctx context.Context, session Session) error
+	Delete(ctx context.Context, userID, sessionID uuid.UUID) error
+	DeleteAll(ctx context.Context, userID uuid.UUID) error
+	SetRevokedBefore(ctx context.Context, userID uuid.UUID, revokedBefore time.Time) error
+	GetRevokedBefore(ctx context.Context, userID uuid.UUID) (*time.Time, error)
+	CreateRefreshToken(ctx context.Context, tokenHash string, refreshToken RefreshToken) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	ConsumeRefreshToken(ctx context.Context, tokenHash string) (bool, error)
+}
+
+func (r *RefreshTokenPayload) Validate() ValidationErrors {
+	r.RefreshToken = strings.TrimSpace(r.RefreshToken)
+	return ValidateStruct(r)
+}
+
+func (s *Session) ToSessionResponse(currentSessionID uuid.UUID) *SessionResponse {
+	return &SessionResponse{
+		ID:         s.ID,
+		DeviceName: s.DeviceName,
+		IPAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		Current:    s.ID == currentSessionID,
+	}
 }
@@ -0,0 +1,134 @@
+package domain
+
+//go:generate mockgen -source=ticket.go -destination=../mock/ticket_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrCinemaSessionNotFound  = errors.New("cinema session not found")
+	ErrCinemaSessionStarted   = errors.New("cinema session has already started")
+	ErrSeatNotFound           = errors.New("seat not found in the session room")
+	ErrSeatUnavailable        = errors.New("seat is already reserved for this session")
+	ErrBirthDateRequired      = errors.New("birth date is required for age rated sessions")
+	ErrAgeRatingNotMet        = errors.New("ticket holder does not meet the indicative rating")
+	ErrGuardianRequired       = errors.New("ticket holder must be accompanied by a guardian")
+	ErrTicketNotFound         = errors.New("ticket not found")
+	ErrTicketAlreadyCheckedIn = errors.New("ticket already checked in")
+)
+
+type TicketPayload struct {
+	SeatID                uuid.UUID  `json:"seatId" validate:"required"`
+	DependentID           *uuid.UUID `json:"dependentId,omitempty"`
+	AccompaniedByGuardian bool       `json:"accompaniedByGuardian"`
+}
+
+type TicketResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	CinemaSessionID  uuid.UUID  `json:"cinemaSessionId"`
+	DependentID      *uuid.UUID `json:"dependentId,omitempty"`
+	HolderName       string     `json:"holderName"`
+	MovieTitle       string     `json:"movieTitle"`
+	IndicativeRating string     `json:"indicativeRating"`
+	MinimumAge       int        `json:"minimumAge"`
+	RequiresGuardian bool       `json:"requiresGuardian"`
+	SeatIdentifier   string     `json:"seatIdentifier"`
+	StartTime        time.Time  `json:"startTime"`
+	CheckedInAt      *time.Time `json:"checkedInAt,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+type TicketCheckInResponse struct {
+	TicketResponse
+	HolderAge      *int   `json:"holderAge,omitempty"`
+	AgeRequirement string `json:"ageRequirement"`
+}
+
+type TicketHandler interface {
+	Create(ctx echo.Context) error
+	GetAllByUser(ctx echo.Context) error
+	GetForCheckIn(ctx echo.Context) error
+	CheckIn(ctx echo.Context) error
+}
+
+type TicketService interface {
+	Create(ctx context.Context, cinemaSessionID uuid.UUID, payload TicketPayload) (*TicketResponse, error)
+	GetAllByUser(ctx context.Context) ([]*TicketResponse, error)
+	GetForCheckIn(ctx context.Context, cinemaID, ticketID uuid.UUID) (*TicketCheckInResponse, error)
+	CheckIn(ctx context.Context, cinemaID, ticketID uuid.UUID) (*TicketCheckInResponse, error)
+}
+
+type TicketRepository interface {
+	GetCinemaSessionByID(ctx context.Context, cinemaSessionID uuid.UUID) (*CinemaSession, error)
+	Create(ctx context.Context, reservation SeatReservation) error
+	GetByID(ctx context.Context, ticketID uuid.UUID) (*SeatReservation, error)
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*SeatReservation, error)
+	CheckIn(ctx context.Context, ticketID, staffUserID uuid.UUID, checkedInAt time.Time) (bool, error)
+}
+
+func (t *TicketPayload) Validate() ValidationErrors {
+	return ValidateStruct(t)
+}
+
+// AgeOn returns the age in full years that someone born on birthDate has on
+// the given date.
+func AgeOn(birthDate, date time.Time) int {
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || (date.Month() == birthDate.Month() && date.Day() < birthDate.Day()) {
+		age--
+	}
+
+	return age
+}
+
+// AgeRequirement describes what the usher has to check at the door.
+func AgeRequirement(minimumAge int, requiresGuardian bool) string {
+	switch {
+	case minimumAge == 0:
+		return "General audiences"
+	case requiresGuardian:
+		return fmt.Sprintf("Minimum age %d - holder is under age and must be accompanied by a guardian", minimumAge)
+	default:
+		return fmt.Sprintf("Minimum age %d", minimumAge)
+	}
+}
+
+func (s *SeatReservation) ToTicketResponse() *TicketResponse {
+	holderName, _ := s.Holder()
+
+	return &TicketResponse{
+		ID:               s.ID,
+		CinemaSessionID:  s.CinemaSessionID,
+		DependentID:      s.DependentID,
+		HolderName:       holderName,
+		MovieTitle:       s.CinemaSession.Movie.Title,
+		IndicativeRating: s.CinemaSession.Movie.IndicativeRating.Description,
+		MinimumAge:       s.MinimumAge,
+		RequiresGuardian: s.RequiresGuardian,
+		SeatIdentifier:   s.Seat.SeatIdentifier,
+		StartTime:        s.CinemaSession.StartTime,
+		CheckedInAt:      s.CheckedInAt,
+		CreatedAt:        s.CreatedAt,
+	}
+}
+
+func (s *SeatReservation) ToTicketCheckInResponse() *TicketCheckInResponse {
+	response := &TicketCheckInResponse{
+		TicketResponse: *s.ToTicketResponse(),
+		AgeRequirement: AgeRequirement(s.MinimumAge, s.RequiresGuardian),
+	}
+
+	if _, birthDate := s.Holder(); birthDate != nil {
+		age := AgeOn(*birthDate, s.CinemaSession.StartTime)
+		response.HolderAge = &age
+	}
+
+	return response
+}
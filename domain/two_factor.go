@@ -0,0 +1,108 @@
+package domain
+
+//go:generate mockgen -source=two_factor.go -destination=../mock/two_factor_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication already enabled")
+	ErrTwoFactorNotEnrolled    = errors.New("two-factor authentication not enrolled")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication not enabled")
+	ErrTwoFactorCodeInvalid    = errors.New("invalid two-factor code")
+	ErrTwoFactorTokenInvalid   = errors.New("invalid or expired two-factor token")
+	ErrTwoFactorRequired       = errors.New("two-factor authentication required")
+)
+
+type UserRecoveryCode struct {
+	ID        uuid.UUID  `gorm:"column:id;type:char(36);primaryKey"`
+	UserID    uuid.UUID  `gorm:"column:userId;type:char(36);not null;index"`
+	User      User       `gorm:"foreignKey:UserID"`
+	CodeHash  string     `gorm:"column:codeHash;type:char(64);not null"`
+	UsedAt    *time.Time `gorm:"column:usedAt;default:NULL"`
+	CreatedAt time.Time  `gorm:"column:createdAt;not null"`
+}
+
+func (UserRecoveryCode) TableName() string {
+	return "UserRecoveryCode"
+}
+
+type TwoFactorCodePayload struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type TwoFactorSignInPayload struct {
+	Token        string `json:"token" validate:"required"`
+	Code         string `json:"code" validate:"required_without=RecoveryCode,omitempty,len=6,numeric"`
+	RecoveryCode string `json:"recoveryCode" validate:"required_without=Code,omitempty,max=32"`
+	DeviceName   string `json:"deviceName,omitempty" validate:"max=255"`
+	IPAddress    string `json:"-"`
+}
+
+type TwoFactorEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauthUri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type TwoFactorHandler interface {
+	Enroll(ctx echo.Context) error
+	Confirm(ctx echo.Context) error
+	RegenerateRecoveryCodes(ctx echo.Context) error
+	Disable(ctx echo.Context) error
+	VerifySignIn(ctx echo.Context) error
+}
+
+type TwoFactorService interface {
+	Enroll(ctx context.Context) (*TwoFactorEnrollmentResponse, error)
+	Confirm(ctx context.Context, payload TwoFactorCodePayload) (*RecoveryCodesResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, payload TwoFactorCodePayload) (*RecoveryCodesResponse, error)
+	Disable(ctx context.Context, payload TwoFactorCodePayload) error
+	CreateSignInToken(user User) (string, error)
+	VerifySignIn(ctx context.Context, payload TwoFactorSignInPayload) (*SignInResponse, error)
+}
+
+type TwoFactorRepository interface {
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, recoveryCodes []UserRecoveryCode) error
+	DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+	MarkCodeStepUsed(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+}
+
+func (t *TwoFactorCodePayload) trim() {
+	t.Code = strings.TrimSpace(t.Code)
+}
+
+func (t *TwoFactorCodePayload) Validate() ValidationErrors {
+	t.trim()
+	return ValidateStruct(t)
+}
+
+func (t *TwoFactorSignInPayload) trim() {
+	t.Token = strings.TrimSpace(t.Token)
+	t.Code = strings.TrimSpace(t.Code)
+	t.RecoveryCode = strings.TrimSpace(t.RecoveryCode)
+	t.DeviceName = strings.TrimSpace(t.DeviceName)
+}
+
+func (t *TwoFactorSignInPayload) Validate() ValidationErrors {
+	t.trim()
+	return ValidateStruct(t)
+}
+
+func (t *TwoFactorSignInPayload) ToSessionMetadata() SessionMetadata {
+	return SessionMetadata{
+		DeviceName: t.DeviceName,
+		IPAddress:  t.IPAddress,
+	}
+}
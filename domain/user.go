@@ -16,19 +16,31 @@ var (
 	ErrUserNotFound          = errors.New("user not found")
 	ErrEmailAlreadyRegister  = errors.New("email already exists")
 	ErrInvalidPassword       = errors.New("invalid password")
+	ErrInvalidCredentials    = errors.New("invalid credentials")
 	ErrUserNotFoundInContext = errors.New("user not found in context")
 	ErrGetUserByEmail        = errors.New("get user by email fail")
+	ErrPasswordResetInvalid  = errors.New("password reset token is invalid or expired")
+	ErrVerificationInvalid   = errors.New("email verification token is invalid or expired")
+	ErrEmailAlreadyVerified  = errors.New("email already verified")
+	ErrEmailNotVerified      = errors.New("email not verified")
+	ErrEmailUnchanged        = errors.New("new email is the same as the current one")
+	ErrEmailChangeInvalid    = errors.New("email change token is invalid or expired")
+	ErrBirthDateLocked       = errors.New("the birth date can only be changed by support once set")
 )
 
 type User struct {
-	ID           uuid.UUID `gorm:"column:id;type:char(36);primaryKey"`
-	FirstName    string    `gorm:"column:firstName;type:varchar(255);not null"`
-	LastName     string    `gorm:"column:lastName;type:varchar(255);not null"`
-	Email        string    `gorm:"column:email;type:varchar(255);uniqueIndex;not null"`
-	BirthDate    time.Time `gorm:"column:birthDate;type:date;not null"`
-	PasswordHash string    `gorm:"column:passwordHash;type:varchar(255);not null"`
-	CreatedAt    time.Time `gorm:"column:createdAt;not null"`
-	UpdatedAt    time.Time `gorm:"column:updatedAt;default:NULL"`
+	ID                 uuid.UUID  `gorm:"column:id;type:char(36);primaryKey"`
+	FirstName          string     `gorm:"column:firstName;type:varchar(255);not null"`
+	LastName           string     `gorm:"column:lastName;type:varchar(255);not null"`
+	Email              string     `gorm:"column:email;type:varchar(255);uniqueIndex;not null"`
+	BirthDate          *time.Time `gorm:"column:birthDate;type:date;default:NULL"`
+	PasswordHash       string     `gorm:"column:passwordHash;type:varchar(255);not null"`
+	EmailVerifiedAt    *time.Time `gorm:"column:emailVerifiedAt;default:NULL"`
+	TwoFactorSecret    string     `gorm:"column:twoFactorSecret;type:varchar(255);default:NULL"`
+	TwoFactorEnabledAt *time.Time `gorm:"column:twoFactorEnabledAt;default:NULL"`
+	AnonymizedAt       *time.Time `gorm:"column:anonymizedAt;default:NULL"`
+	CreatedAt          time.Time  `gorm:"column:createdAt;not null"`
+	UpdatedAt          time.Time  `gorm:"column:updatedAt;default:NULL"`
 }
 
 func (User) TableName() string {
@@ -46,35 +58,105 @@ type UserPayload struct {
 }
 
 type UserResponse struct {
-	ID        string    `json:"id"`
-	FirstName string    `json:"firstName"`
-	LastName  string    `json:"lastName"`
-	Email     string    `json:"email"`
-	BirthDate time.Time `json:"birthDate"`
+	ID               string     `json:"id"`
+	FirstName        string     `json:"firstName"`
+	LastName         string     `json:"lastName"`
+	Email            string     `json:"email"`
+	BirthDate        *time.Time `json:"birthDate,omitempty"`
+	EmailVerified    bool       `json:"emailVerified"`
+	TwoFactorEnabled bool       `json:"twoFactorEnabled"`
+}
+
+type UpdateProfilePayload struct {
+	FirstName *string    `json:"firstName,omitempty" validate:"omitempty,min=1,max=255"`
+	LastName  *string    `json:"lastName,omitempty" validate:"omitempty,min=1,max=255"`
+	BirthDate *time.Time `json:"birthDate,omitempty" validate:"omitempty,nottooold,notfuturedate"`
+}
+
+type ChangePasswordPayload struct {
+	CurrentPassword    string `json:"currentPassword,omitempty" validate:"required,max=255"`
+	NewPassword        string `json:"newPassword,omitempty" validate:"required,max=255,strongpassword"`
+	ConfirmNewPassword string `json:"confirmNewPassword" validate:"required,eqfield=NewPassword"`
+}
+
+type ChangeEmailPayload struct {
+	NewEmail string `json:"newEmail" validate:"required,email,max=255"`
+	Password string `json:"password,omitempty" validate:"required,max=255"`
+}
+
+type ConfirmEmailChangePayload struct {
+	Token string `json:"token" validate:"required"`
 }
 
 type SignInPayload struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password,omitempty" validate:"required"`
+	Email      string `json:"email" validate:"required,email"`
+	Password   string `json:"password,omitempty" validate:"required"`
+	DeviceName string `json:"deviceName,omitempty" validate:"max=255"`
+	IPAddress  string `json:"-"`
 }
 
 type SignInResponse struct {
-	Token string `json:"token"`
+	Token             string `json:"token,omitempty"`
+	RefreshToken      string `json:"refreshToken,omitempty"`
+	ExpiresIn         int64  `json:"expiresIn,omitempty"`
+	TwoFactorRequired bool   `json:"twoFactorRequired,omitempty"`
+	TwoFactorToken    string `json:"twoFactorToken,omitempty"`
+}
+
+type ForgotPasswordPayload struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+}
+
+type ResetPasswordPayload struct {
+	Token           string `json:"token" validate:"required"`
+	Password        string `json:"password,omitempty" validate:"required,max=255,strongpassword"`
+	ConfirmPassword string `json:"confirmPassword" validate:"required,eqfield=Password"`
+}
+
+type VerifyEmailPayload struct {
+	Token string `json:"token" validate:"required"`
 }
 
 type UserHandler interface {
 	Create(ctx echo.Context) error
 	SignIn(ctx echo.Context) error
+	ForgotPassword(ctx echo.Context) error
+	ResetPassword(ctx echo.Context) error
+	VerifyEmail(ctx echo.Context) error
+	ResendVerification(ctx echo.Context) error
+	GetMe(ctx echo.Context) error
+	UpdateMe(ctx echo.Context) error
+	ChangePassword(ctx echo.Context) error
+	ChangeEmail(ctx echo.Context) error
+	ConfirmEmailChange(ctx echo.Context) error
 }
 
 type UserService interface {
 	Create(ctx context.Context, payload UserPayload) error
 	SignIn(ctx context.Context, payload SignInPayload) (*SignInResponse, error)
+	ForgotPassword(ctx context.Context, payload ForgotPasswordPayload, ipAddress string) error
+	ResetPassword(ctx context.Context, payload ResetPasswordPayload) error
+	VerifyEmail(ctx context.Context, payload VerifyEmailPayload, ipAddress string) error
+	ResendVerification(ctx context.Context) error
+	IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+	GetMe(ctx context.Context) (*UserResponse, error)
+	UpdateMe(ctx context.Context, payload UpdateProfilePayload) (*UserResponse, error)
+	ChangePassword(ctx context.Context, payload ChangePasswordPayload) error
+	ChangeEmail(ctx context.Context, payload ChangeEmailPayload) error
+	ConfirmEmailChange(ctx context.Context, payload ConfirmEmailChangePayload) error
 }
 
 type UserRepository interface {
 	Create(ctx context.Context, user User) error
+	GetByID(ctx context.Context, ID uuid.UUID) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
+	UpdatePassword(ctx context.Context, ID uuid.UUID, passwordHash string) error
+	UpdateProfile(ctx context.Context, ID uuid.UUID, firstName, lastName string, birthDate *time.Time) error
+	UpdateEmail(ctx context.Context, ID uuid.UUID, email string, verifiedAt time.Time) error
+	MarkEmailVerified(ctx context.Context, ID uuid.UUID, verifiedAt time.Time) error
+	UpdateTwoFactor(ctx context.Context, ID uuid.UUID, secret string, enabledAt *time.Time) error
+	CreatePasswordResetToken(ctx context.Context, tokenHash string, userID uuid.UUID) error
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (*uuid.UUID, error)
 }
 
 func (u *UserPayload) trim() {
@@ -86,6 +168,7 @@ func (u *UserPayload) trim() {
 
 func (s *SignInPayload) trim() {
 	s.Email = strings.TrimSpace(strings.ToLower(s.Email))
+	s.DeviceName = strings.TrimSpace(s.DeviceName)
 }
 
 func (s *SignInPayload) Validate() ValidationErrors {
@@ -98,6 +181,79 @@ func (u *UserPayload) Validate() ValidationErrors {
 	return ValidateStruct(u)
 }
 
+func (f *ForgotPasswordPayload) trim() {
+	f.Email = strings.TrimSpace(strings.ToLower(f.Email))
+}
+
+func (f *ForgotPasswordPayload) Validate() ValidationErrors {
+	f.trim()
+	return ValidateStruct(f)
+}
+
+func (v *VerifyEmailPayload) trim() {
+	v.Token = strings.TrimSpace(v.Token)
+}
+
+func (v *VerifyEmailPayload) Validate() ValidationErrors {
+	v.trim()
+	return ValidateStruct(v)
+}
+
+func (r *ResetPasswordPayload) trim() {
+	r.Token = strings.TrimSpace(r.Token)
+}
+
+func (r *ResetPasswordPayload) Validate() ValidationErrors {
+	r.trim()
+	return ValidateStruct(r)
+}
+
+func (u *UpdateProfilePayload) trim() {
+	if u.FirstName != nil {
+		firstName := strings.TrimSpace(*u.FirstName)
+		u.FirstName = &firstName
+	}
+
+	if u.LastName != nil {
+		lastName := strings.TrimSpace(*u.LastName)
+		u.LastName = &lastName
+	}
+}
+
+func (u *UpdateProfilePayload) Validate() ValidationErrors {
+	u.trim()
+	return ValidateStruct(u)
+}
+
+func (c *ChangePasswordPayload) Validate() ValidationErrors {
+	return ValidateStruct(c)
+}
+
+func (c *ChangeEmailPayload) trim() {
+	c.NewEmail = strings.TrimSpace(strings.ToLower(c.NewEmail))
+}
+
+func (c *ChangeEmailPayload) Validate() ValidationErrors {
+	c.trim()
+	return ValidateStruct(c)
+}
+
+func (c *ConfirmEmailChangePayload) trim() {
+	c.Token = strings.TrimSpace(c.Token)
+}
+
+func (c *ConfirmEmailChangePayload) Validate() ValidationErrors {
+	c.trim()
+	return ValidateStruct(c)
+}
+
+func (s *SignInPayload) ToSessionMetadata() SessionMetadata {
+	return SessionMetadata{
+		DeviceName: s.DeviceName,
+		IPAddress:  s.IPAddress,
+	}
+}
+
 func (u *UserPayload) ToUser(passwordHash string) *User {
 	return &User{
 		ID:           uuid.New(),
@@ -105,7 +261,19 @@ func (u *UserPayload) ToUser(passwordHash string) *User {
 		LastName:     u.LastName,
 		Email:        u.Email,
 		PasswordHash: passwordHash,
-		BirthDate:    u.BirthDate,
+		BirthDate:    &u.BirthDate,
 		CreatedAt:    time.Now().UTC(),
 	}
 }
+
+func (u *User) ToUserResponse() *UserResponse {
+	return &UserResponse{
+		ID:               u.ID.String(),
+		FirstName:        u.FirstName,
+		LastName:         u.LastName,
+		Email:            u.Email,
+		BirthDate:        u.BirthDate,
+		EmailVerified:    u.EmailVerifiedAt != nil,
+		TwoFactorEnabled: u.TwoFactorEnabledAt != nil,
+	}
+}
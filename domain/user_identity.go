@@ -0,0 +1,88 @@
+package domain
+
+//go:generate mockgen -source=user_identity.go -destination=../mock/user_identity_mock.go -package=mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrOIDCProviderNotFound     = errors.New("OIDC provider not found")
+	ErrOIDCStateInvalid         = errors.New("OIDC state is invalid or expired")
+	ErrOIDCAuthenticationFailed = errors.New("OIDC authentication failed")
+	ErrOIDCEmailNotVerified     = errors.New("OIDC provider email not verified")
+)
+
+type UserIdentity struct {
+	ID        uuid.UUID `gorm:"column:id;type:char(36);primaryKey"`
+	UserID    uuid.UUID `gorm:"column:userId;type:char(36);not null;index"`
+	User      User      `gorm:"foreignKey:UserID"`
+	Provider  string    `gorm:"column:provider;type:varchar(50);not null;uniqueIndex:idx_provider_subject"`
+	Subject   string    `gorm:"column:subject;type:varchar(255);not null;uniqueIndex:idx_provider_subject"`
+	Email     string    `gorm:"column:email;type:varchar(255);not null"`
+	CreatedAt time.Time `gorm:"column:createdAt;not null"`
+}
+
+func (UserIdentity) TableName() string {
+	return "UserIdentity"
+}
+
+type OIDCState struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+}
+
+type OIDCCallbackPayload struct {
+	Code       string `json:"code" validate:"required,max=2048"`
+	State      string `json:"state" validate:"required,max=255"`
+	DeviceName string `json:"deviceName,omitempty" validate:"max=255"`
+	IPAddress  string `json:"-"`
+}
+
+type OIDCAuthorizationResponse struct {
+	AuthorizationURL string `json:"authorizationUrl"`
+}
+
+type OIDCHandler interface {
+	Authorize(ctx echo.Context) error
+	Callback(ctx echo.Context) error
+}
+
+type OIDCService interface {
+	Authorize(ctx context.Context, provider string) (*OIDCAuthorizationResponse, error)
+	Callback(ctx context.Context, provider string, payload OIDCCallbackPayload) (*SignInResponse, error)
+}
+
+type UserIdentityRepository interface {
+	Create(ctx context.Context, identity UserIdentity) error
+	CreateWithUser(ctx context.Context, user User, identity UserIdentity) error
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*UserIdentity, error)
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*UserIdentity, error)
+	CreateState(ctx context.Context, state string, oidcState OIDCState) error
+	ConsumeState(ctx context.Context, state string) (*OIDCState, error)
+}
+
+func (o *OIDCCallbackPayload) trim() {
+	o.Code = strings.TrimSpace(o.Code)
+	o.State = strings.TrimSpace(o.State)
+	o.DeviceName = strings.TrimSpace(o.DeviceName)
+}
+
+func (o *OIDCCallbackPayload) Validate() ValidationErrors {
+	o.trim()
+	return ValidateStruct(o)
+}
+
+func (o *OIDCCallbackPayload) ToSessionMetadata() SessionMetadata {
+	return SessionMetadata{
+		DeviceName: o.DeviceName,
+		IPAddress:  o.IPAddress,
+	}
+}
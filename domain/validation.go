@@ -9,17 +9,26 @@ import (
 type ValidationErrors map[string]string
 
 var ValidationMessages = ValidationErrors{
-	"required":        "This field is required",
-	"email":           "Invalid email format",
-	"min":             "Value is too short",
-	"max":             "Value is too long",
-	"eqfield":         "Fields do not match",
-	"gt":              "The value must be greater than zero",
-	"datetime":        "Invalid birth date",
-	StrongPasswordTag: "Password must be at least 8 characters long, contain an uppercase letter, a number, and a special character",
-	NotTooOldTag:      "The date of birth indicates an age greater than the allowed maximum of 200 years",
-	NotFutureDateTag:  "The date of birth cannot be in the future",
-	General:           "At least one field must be provided for update.",
+	"required":               "This field is required",
+	"email":                  "Invalid email format",
+	"min":                    "Value is too short",
+	"max":                    "Value is too long",
+	"eqfield":                "Fields do not match",
+	"eq":                     "Value does not match the expected value",
+	"gt":                     "The value must be greater than zero",
+	"datetime":               "Invalid birth date",
+	"oneof":                  "Value is not one of the allowed options",
+	"len":                    "Value has an invalid length",
+	"numeric":                "Value must contain only digits",
+	"required_without":       "This field is required when the alternative field is not provided",
+	"unique":                 "Values must not be repeated",
+	"eq=|http_url":           "Must be a valid http or https URL",
+	"eq=|iso3166_1_alpha2":   "Must be a two letter ISO 3166 country code",
+	"eq=|bcp47_language_tag": "Must be a valid language tag such as pt-BR",
+	StrongPasswordTag:        "Password must be at least 8 characters long, contain an uppercase letter, a number, and a special character",
+	NotTooOldTag:             "The date of birth indicates an age greater than the allowed maximum of 200 years",
+	NotFutureDateTag:         "The date of birth cannot be in the future",
+	General:                  "At least one field must be provided for update.",
 }
 
 func ValidateStruct(s any) ValidationErrors {
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+const apiKeyHeader = "X-API-Key"
+
+// EnsureAPIKey authenticates partner requests by the key sent in X-API-Key.
+// It is meant for the partner routes only, each of which must also be
+// guarded by EnsureScope.
+func EnsureAPIKey(i *do.Injector) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			apiKeyService, err := do.Invoke[domain.APIKeyService](i)
+			if err != nil {
+				slog.Error(err.Error())
+				return domain.InternalServerAPIErrorResponse(ctx)
+			}
+
+			key := ctx.Request().Header.Get(apiKeyHeader)
+			if key == "" {
+				return domain.AccessDeniedAPIErrorResponse(ctx)
+			}
+
+			apiKey, err := apiKeyService.Authenticate(ctx.Request().Context(), key)
+			if err != nil {
+				if errors.Is(err, domain.ErrAPIKeyInvalid) {
+					return domain.AccessDeniedAPIErrorResponse(ctx)
+				}
+
+				slog.Error(err.Error())
+				return domain.InternalServerAPIErrorResponse(ctx)
+			}
+
+			newCtx := context.WithValue(ctx.Request().Context(), domain.APIKeyKey, apiKey)
+			ctx.SetRequest(ctx.Request().WithContext(newCtx))
+
+			return next(ctx)
+		}
+	}
+}
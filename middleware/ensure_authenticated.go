@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"strings"
 
@@ -31,7 +32,7 @@ func EnsureAuthenticated(i *do.Injector) echo.MiddlewareFunc {
 
 			session, err := sessionService.GetSession(ctx.Request().Context(), content[1])
 			if err != nil {
-				if err == domain.ErrTokenInvalid || err == domain.ErrSessionMismatch || err == domain.ErrSessionNotFound {
+				if errors.Is(err, domain.ErrTokenInvalid) || errors.Is(err, domain.ErrSessionMismatch) || errors.Is(err, domain.ErrSessionNotFound) || errors.Is(err, domain.ErrSessionRevoked) {
 					return domain.AccessDeniedAPIErrorResponse(ctx)
 				}
 
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func EnsureEmailVerified(i *do.Injector) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			userService, err := do.Invoke[domain.UserService](i)
+			if err != nil {
+				slog.Error(err.Error())
+				return domain.InternalServerAPIErrorResponse(ctx)
+			}
+
+			session, ok := ctx.Request().Context().Value(domain.SessionKey).(*domain.Session)
+			if !ok || session == nil {
+				return domain.AccessDeniedAPIErrorResponse(ctx)
+			}
+
+			verified, err := userService.IsEmailVerified(ctx.Request().Context(), session.UserID)
+			if err != nil {
+				slog.Error(err.Error())
+				return domain.InternalServerAPIErrorResponse(ctx)
+			}
+
+			if !verified {
+				return domain.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, nil, "Email not verified", "You need to confirm your email address before buying tickets.")
+			}
+
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/labstack/echo/v4"
+)
+
+// EnsureScope lets a request through only when the partner key set by
+// EnsureAPIKey was granted the scope.
+func EnsureScope(scope domain.APIKeyScope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			apiKey, ok := ctx.Request().Context().Value(domain.APIKeyKey).(*domain.APIKey)
+			if !ok || apiKey == nil || !apiKey.HasScope(scope) {
+				return domain.ForbiddenAPIErrorResponse(ctx)
+			}
+
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestID keeps the X-Request-ID sent by the client when it is well formed,
+// or generates one, and stores it with the client IP in the request context
+// so services can correlate logs and audit entries. The IP comes from the
+// echo IPExtractor, which only reads X-Forwarded-For from trusted proxies.
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			requestID := ctx.Request().Header.Get(echo.HeaderXRequestID)
+			if !requestIDPattern.MatchString(requestID) {
+				requestID = uuid.NewString()
+			}
+
+			ctx.Response().Header().Set(echo.HeaderXRequestID, requestID)
+
+			newCtx := context.WithValue(ctx.Request().Context(), domain.RequestIDKey, requestID)
+			newCtx = context.WithValue(newCtx, domain.IPAddressKey, ctx.RealIP())
+			ctx.SetRequest(ctx.Request().WithContext(newCtx))
+
+			return next(ctx)
+		}
+	}
+}
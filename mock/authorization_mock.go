@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: authorization.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockAuthorizationService is a mock of AuthorizationService interface.
+type MockAuthorizationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationServiceMockRecorder
+}
+
+// MockAuthorizationServiceMockRecorder is the mock recorder for MockAuthorizationService.
+type MockAuthorizationServiceMockRecorder struct {
+	mock *MockAuthorizationService
+}
+
+// NewMockAuthorizationService creates a new mock instance.
+func NewMockAuthorizationService(ctrl *gomock.Controller) *MockAuthorizationService {
+	mock := &MockAuthorizationService{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationService) EXPECT() *MockAuthorizationServiceMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockAuthorizationService) Authorize(ctx context.Context, cinemaID uuid.UUID, permission domain.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, cinemaID, permission)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockAuthorizationServiceMockRecorder) Authorize(ctx, cinemaID, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthorizationService)(nil).Authorize), ctx, cinemaID, permission)
+}
+
+// AuthorizeOrganization mocks base method.
+func (m *MockAuthorizationService) AuthorizeOrganization(ctx context.Context, organizationID uuid.UUID, permission domain.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeOrganization", ctx, organizationID, permission)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeOrganization indicates an expected call of AuthorizeOrganization.
+func (mr *MockAuthorizationServiceMockRecorder) AuthorizeOrganization(ctx, organizationID, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeOrganization", reflect.TypeOf((*MockAuthorizationService)(nil).AuthorizeOrganization), ctx, organizationID, permission)
+}
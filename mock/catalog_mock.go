@@ -0,0 +1,276 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: catalog.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockCatalogHandler is a mock of CatalogHandler interface.
+type MockCatalogHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogHandlerMockRecorder
+}
+
+// MockCatalogHandlerMockRecorder is the mock recorder for MockCatalogHandler.
+type MockCatalogHandlerMockRecorder struct {
+	mock *MockCatalogHandler
+}
+
+// NewMockCatalogHandler creates a new mock instance.
+func NewMockCatalogHandler(ctrl *gomock.Controller) *MockCatalogHandler {
+	mock := &MockCatalogHandler{ctrl: ctrl}
+	mock.recorder = &MockCatalogHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogHandler) EXPECT() *MockCatalogHandlerMockRecorder {
+	return m.recorder
+}
+
+// GetComingSoon mocks base method.
+func (m *MockCatalogHandler) GetComingSoon(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComingSoon", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetComingSoon indicates an expected call of GetComingSoon.
+func (mr *MockCatalogHandlerMockRecorder) GetComingSoon(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComingSoon", reflect.TypeOf((*MockCatalogHandler)(nil).GetComingSoon), ctx)
+}
+
+// GetMovieByID mocks base method.
+func (m *MockCatalogHandler) GetMovieByID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovieByID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetMovieByID indicates an expected call of GetMovieByID.
+func (mr *MockCatalogHandlerMockRecorder) GetMovieByID(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovieByID", reflect.TypeOf((*MockCatalogHandler)(nil).GetMovieByID), ctx)
+}
+
+// GetNowShowing mocks base method.
+func (m *MockCatalogHandler) GetNowShowing(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNowShowing", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetNowShowing indicates an expected call of GetNowShowing.
+func (mr *MockCatalogHandlerMockRecorder) GetNowShowing(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNowShowing", reflect.TypeOf((*MockCatalogHandler)(nil).GetNowShowing), ctx)
+}
+
+// Search mocks base method.
+func (m *MockCatalogHandler) Search(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockCatalogHandlerMockRecorder) Search(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockCatalogHandler)(nil).Search), ctx)
+}
+
+// MockCatalogService is a mock of CatalogService interface.
+type MockCatalogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogServiceMockRecorder
+}
+
+// MockCatalogServiceMockRecorder is the mock recorder for MockCatalogService.
+type MockCatalogServiceMockRecorder struct {
+	mock *MockCatalogService
+}
+
+// NewMockCatalogService creates a new mock instance.
+func NewMockCatalogService(ctrl *gomock.Controller) *MockCatalogService {
+	mock := &MockCatalogService{ctrl: ctrl}
+	mock.recorder = &MockCatalogServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogService) EXPECT() *MockCatalogServiceMockRecorder {
+	return m.recorder
+}
+
+// GetComingSoon mocks base method.
+func (m *MockCatalogService) GetComingSoon(ctx context.Context, filter domain.CatalogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComingSoon", ctx, filter, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComingSoon indicates an expected call of GetComingSoon.
+func (mr *MockCatalogServiceMockRecorder) GetComingSoon(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComingSoon", reflect.TypeOf((*MockCatalogService)(nil).GetComingSoon), ctx, filter, pagination)
+}
+
+// GetMovieByID mocks base method.
+func (m *MockCatalogService) GetMovieByID(ctx context.Context, movieID uuid.UUID) (*domain.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovieByID", ctx, movieID)
+	ret0, _ := ret[0].(*domain.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovieByID indicates an expected call of GetMovieByID.
+func (mr *MockCatalogServiceMockRecorder) GetMovieByID(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovieByID", reflect.TypeOf((*MockCatalogService)(nil).GetMovieByID), ctx, movieID)
+}
+
+// GetNowShowing mocks base method.
+func (m *MockCatalogService) GetNowShowing(ctx context.Context, filter domain.CatalogFilter, days int, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNowShowing", ctx, filter, days, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNowShowing indicates an expected call of GetNowShowing.
+func (mr *MockCatalogServiceMockRecorder) GetNowShowing(ctx, filter, days, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNowShowing", reflect.TypeOf((*MockCatalogService)(nil).GetNowShowing), ctx, filter, days, pagination)
+}
+
+// Search mocks base method.
+func (m *MockCatalogService) Search(ctx context.Context, payload domain.MovieSearchPayload, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, payload, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockCatalogServiceMockRecorder) Search(ctx, payload, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockCatalogService)(nil).Search), ctx, payload, pagination)
+}
+
+// MockCatalogRepository is a mock of CatalogRepository interface.
+type MockCatalogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogRepositoryMockRecorder
+}
+
+// MockCatalogRepositoryMockRecorder is the mock recorder for MockCatalogRepository.
+type MockCatalogRepositoryMockRecorder struct {
+	mock *MockCatalogRepository
+}
+
+// NewMockCatalogRepository creates a new mock instance.
+func NewMockCatalogRepository(ctrl *gomock.Controller) *MockCatalogRepository {
+	mock := &MockCatalogRepository{ctrl: ctrl}
+	mock.recorder = &MockCatalogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogRepository) EXPECT() *MockCatalogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetComingSoon mocks base method.
+func (m *MockCatalogRepository) GetComingSoon(ctx context.Context, filter domain.CatalogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComingSoon", ctx, filter, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComingSoon indicates an expected call of GetComingSoon.
+func (mr *MockCatalogRepositoryMockRecorder) GetComingSoon(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComingSoon", reflect.TypeOf((*MockCatalogRepository)(nil).GetComingSoon), ctx, filter, pagination)
+}
+
+// GetMoviesByIDs mocks base method.
+func (m *MockCatalogRepository) GetMoviesByIDs(ctx context.Context, movieIDs []uuid.UUID) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMoviesByIDs", ctx, movieIDs)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMoviesByIDs indicates an expected call of GetMoviesByIDs.
+func (mr *MockCatalogRepositoryMockRecorder) GetMoviesByIDs(ctx, movieIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMoviesByIDs", reflect.TypeOf((*MockCatalogRepository)(nil).GetMoviesByIDs), ctx, movieIDs)
+}
+
+// GetNowShowing mocks base method.
+func (m *MockCatalogRepository) GetNowShowing(ctx context.Context, filter domain.CatalogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNowShowing", ctx, filter, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNowShowing indicates an expected call of GetNowShowing.
+func (mr *MockCatalogRepositoryMockRecorder) GetNowShowing(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNowShowing", reflect.TypeOf((*MockCatalogRepository)(nil).GetNowShowing), ctx, filter, pagination)
+}
+
+// GetSearchCandidates mocks base method.
+func (m *MockCatalogRepository) GetSearchCandidates(ctx context.Context, query domain.MovieSearchQuery, trigrams []string, limit int) ([]*domain.MovieSearchCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchCandidates", ctx, query, trigrams, limit)
+	ret0, _ := ret[0].([]*domain.MovieSearchCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchCandidates indicates an expected call of GetSearchCandidates.
+func (mr *MockCatalogRepositoryMockRecorder) GetSearchCandidates(ctx, query, trigrams, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCandidates", reflect.TypeOf((*MockCatalogRepository)(nil).GetSearchCandidates), ctx, query, trigrams, limit)
+}
+
+// Search mocks base method.
+func (m *MockCatalogRepository) Search(ctx context.Context, query domain.MovieSearchQuery, pagination *domain.Pagination) ([]*domain.MovieSearchResult, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, pagination)
+	ret0, _ := ret[0].([]*domain.MovieSearchResult)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockCatalogRepositoryMockRecorder) Search(ctx, query, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockCatalogRepository)(nil).Search), ctx, query, pagination)
+}
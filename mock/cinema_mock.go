@@ -0,0 +1,300 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cinema.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockCinemaHandler is a mock of CinemaHandler interface.
+type MockCinemaHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockCinemaHandlerMockRecorder
+}
+
+// MockCinemaHandlerMockRecorder is the mock recorder for MockCinemaHandler.
+type MockCinemaHandlerMockRecorder struct {
+	mock *MockCinemaHandler
+}
+
+// NewMockCinemaHandler creates a new mock instance.
+func NewMockCinemaHandler(ctrl *gomock.Controller) *MockCinemaHandler {
+	mock := &MockCinemaHandler{ctrl: ctrl}
+	mock.recorder = &MockCinemaHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCinemaHandler) EXPECT() *MockCinemaHandlerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCinemaHandler) Create(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCinemaHandlerMockRecorder) Create(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCinemaHandler)(nil).Create), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockCinemaHandler) Delete(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCinemaHandlerMockRecorder) Delete(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCinemaHandler)(nil).Delete), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockCinemaHandler) GetAll(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCinemaHandlerMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCinemaHandler)(nil).GetAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockCinemaHandler) GetByID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCinemaHandlerMockRecorder) GetByID(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCinemaHandler)(nil).GetByID), ctx)
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockCinemaHandler) UpdatePolicy(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockCinemaHandlerMockRecorder) UpdatePolicy(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockCinemaHandler)(nil).UpdatePolicy), ctx)
+}
+
+// MockCinemaService is a mock of CinemaService interface.
+type MockCinemaService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCinemaServiceMockRecorder
+}
+
+// MockCinemaServiceMockRecorder is the mock recorder for MockCinemaService.
+type MockCinemaServiceMockRecorder struct {
+	mock *MockCinemaService
+}
+
+// NewMockCinemaService creates a new mock instance.
+func NewMockCinemaService(ctrl *gomock.Controller) *MockCinemaService {
+	mock := &MockCinemaService{ctrl: ctrl}
+	mock.recorder = &MockCinemaServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCinemaService) EXPECT() *MockCinemaServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCinemaService) Create(ctx context.Context, payload domain.CinemaPayload) (*domain.CinemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, payload)
+	ret0, _ := ret[0].(*domain.CinemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCinemaServiceMockRecorder) Create(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCinemaService)(nil).Create), ctx, payload)
+}
+
+// Delete mocks base method.
+func (m *MockCinemaService) Delete(ctx context.Context, cinemaID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, cinemaID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCinemaServiceMockRecorder) Delete(ctx, cinemaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCinemaService)(nil).Delete), ctx, cinemaID)
+}
+
+// GetAll mocks base method.
+func (m *MockCinemaService) GetAll(ctx context.Context, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCinemaServiceMockRecorder) GetAll(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCinemaService)(nil).GetAll), ctx, pagination)
+}
+
+// GetByID mocks base method.
+func (m *MockCinemaService) GetByID(ctx context.Context, cinemaID uuid.UUID) (*domain.CinemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, cinemaID)
+	ret0, _ := ret[0].(*domain.CinemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCinemaServiceMockRecorder) GetByID(ctx, cinemaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCinemaService)(nil).GetByID), ctx, cinemaID)
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockCinemaService) UpdatePolicy(ctx context.Context, cinemaID uuid.UUID, payload domain.CinemaPolicyPayload) (*domain.CinemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", ctx, cinemaID, payload)
+	ret0, _ := ret[0].(*domain.CinemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockCinemaServiceMockRecorder) UpdatePolicy(ctx, cinemaID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockCinemaService)(nil).UpdatePolicy), ctx, cinemaID, payload)
+}
+
+// MockCinemaRepository is a mock of CinemaRepository interface.
+type MockCinemaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCinemaRepositoryMockRecorder
+}
+
+// MockCinemaRepositoryMockRecorder is the mock recorder for MockCinemaRepository.
+type MockCinemaRepositoryMockRecorder struct {
+	mock *MockCinemaRepository
+}
+
+// NewMockCinemaRepository creates a new mock instance.
+func NewMockCinemaRepository(ctrl *gomock.Controller) *MockCinemaRepository {
+	mock := &MockCinemaRepository{ctrl: ctrl}
+	mock.recorder = &MockCinemaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCinemaRepository) EXPECT() *MockCinemaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCinemaRepository) Create(ctx context.Context, cinema domain.Cinema) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, cinema)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCinemaRepositoryMockRecorder) Create(ctx, cinema interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCinemaRepository)(nil).Create), ctx, cinema)
+}
+
+// Delete mocks base method.
+func (m *MockCinemaRepository) Delete(ctx context.Context, cinemaID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, cinemaID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCinemaRepositoryMockRecorder) Delete(ctx, cinemaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCinemaRepository)(nil).Delete), ctx, cinemaID)
+}
+
+// GetAll mocks base method.
+func (m *MockCinemaRepository) GetAll(ctx context.Context, userID uuid.UUID, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, userID, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCinemaRepositoryMockRecorder) GetAll(ctx, userID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCinemaRepository)(nil).GetAll), ctx, userID, pagination)
+}
+
+// GetByID mocks base method.
+func (m *MockCinemaRepository) GetByID(ctx context.Context, cinemaID uuid.UUID) (*domain.Cinema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, cinemaID)
+	ret0, _ := ret[0].(*domain.Cinema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCinemaRepositoryMockRecorder) GetByID(ctx, cinemaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCinemaRepository)(nil).GetByID), ctx, cinemaID)
+}
+
+// Update mocks base method.
+func (m *MockCinemaRepository) Update(ctx context.Context, cinema domain.Cinema) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, cinema)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCinemaRepositoryMockRecorder) Update(ctx, cinema interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCinemaRepository)(nil).Update), ctx, cinema)
+}
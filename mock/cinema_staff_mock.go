@@ -0,0 +1,243 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cinema_staff.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockCinemaStaffHandler is a mock of CinemaStaffHandler interface.
+type MockCinemaStaffHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockCinemaStaffHandlerMockRecorder
+}
+
+// MockCinemaStaffHandlerMockRecorder is the mock recorder for MockCinemaStaffHandler.
+type MockCinemaStaffHandlerMockRecorder struct {
+	mock *MockCinemaStaffHandler
+}
+
+// NewMockCinemaStaffHandler creates a new mock instance.
+func NewMockCinemaStaffHandler(ctrl *gomock.Controller) *MockCinemaStaffHandler {
+	mock := &MockCinemaStaffHandler{ctrl: ctrl}
+	mock.recorder = &MockCinemaStaffHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCinemaStaffHandler) EXPECT() *MockCinemaStaffHandlerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCinemaStaffHandler) Create(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCinemaStaffHandlerMockRecorder) Create(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCinemaStaffHandler)(nil).Create), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockCinemaStaffHandler) Delete(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCinemaStaffHandlerMockRecorder) Delete(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCinemaStaffHandler)(nil).Delete), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockCinemaStaffHandler) GetAll(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCinemaStaffHandlerMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCinemaStaffHandler)(nil).GetAll), ctx)
+}
+
+// MockCinemaStaffService is a mock of CinemaStaffService interface.
+type MockCinemaStaffService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCinemaStaffServiceMockRecorder
+}
+
+// MockCinemaStaffServiceMockRecorder is the mock recorder for MockCinemaStaffService.
+type MockCinemaStaffServiceMockRecorder struct {
+	mock *MockCinemaStaffService
+}
+
+// NewMockCinemaStaffService creates a new mock instance.
+func NewMockCinemaStaffService(ctrl *gomock.Controller) *MockCinemaStaffService {
+	mock := &MockCinemaStaffService{ctrl: ctrl}
+	mock.recorder = &MockCinemaStaffServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCinemaStaffService) EXPECT() *MockCinemaStaffServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCinemaStaffService) Create(ctx context.Context, cinemaID uuid.UUID, payload domain.CinemaStaffPayload) (*domain.CinemaStaffResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, cinemaID, payload)
+	ret0, _ := ret[0].(*domain.CinemaStaffResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCinemaStaffServiceMockRecorder) Create(ctx, cinemaID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCinemaStaffService)(nil).Create), ctx, cinemaID, payload)
+}
+
+// Delete mocks base method.
+func (m *MockCinemaStaffService) Delete(ctx context.Context, cinemaID, staffID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, cinemaID, staffID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCinemaStaffServiceMockRecorder) Delete(ctx, cinemaID, staffID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCinemaStaffService)(nil).Delete), ctx, cinemaID, staffID)
+}
+
+// GetAll mocks base method.
+func (m *MockCinemaStaffService) GetAll(ctx context.Context, cinemaID uuid.UUID) ([]*domain.CinemaStaffResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, cinemaID)
+	ret0, _ := ret[0].([]*domain.CinemaStaffResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCinemaStaffServiceMockRecorder) GetAll(ctx, cinemaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCinemaStaffService)(nil).GetAll), ctx, cinemaID)
+}
+
+// MockCinemaStaffRepository is a mock of CinemaStaffRepository interface.
+type MockCinemaStaffRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCinemaStaffRepositoryMockRecorder
+}
+
+// MockCinemaStaffRepositoryMockRecorder is the mock recorder for MockCinemaStaffRepository.
+type MockCinemaStaffRepositoryMockRecorder struct {
+	mock *MockCinemaStaffRepository
+}
+
+// NewMockCinemaStaffRepository creates a new mock instance.
+func NewMockCinemaStaffRepository(ctrl *gomock.Controller) *MockCinemaStaffRepository {
+	mock := &MockCinemaStaffRepository{ctrl: ctrl}
+	mock.recorder = &MockCinemaStaffRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCinemaStaffRepository) EXPECT() *MockCinemaStaffRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCinemaStaffRepository) Create(ctx context.Context, staff domain.CinemaStaff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, staff)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCinemaStaffRepositoryMockRecorder) Create(ctx, staff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCinemaStaffRepository)(nil).Create), ctx, staff)
+}
+
+// Delete mocks base method.
+func (m *MockCinemaStaffRepository) Delete(ctx context.Context, staffID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, staffID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCinemaStaffRepositoryMockRecorder) Delete(ctx, staffID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCinemaStaffRepository)(nil).Delete), ctx, staffID)
+}
+
+// GetAllByCinemaID mocks base method.
+func (m *MockCinemaStaffRepository) GetAllByCinemaID(ctx context.Context, cinemaID uuid.UUID) ([]*domain.CinemaStaff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByCinemaID", ctx, cinemaID)
+	ret0, _ := ret[0].([]*domain.CinemaStaff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByCinemaID indicates an expected call of GetAllByCinemaID.
+func (mr *MockCinemaStaffRepositoryMockRecorder) GetAllByCinemaID(ctx, cinemaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCinemaID", reflect.TypeOf((*MockCinemaStaffRepository)(nil).GetAllByCinemaID), ctx, cinemaID)
+}
+
+// GetByCinemaAndUser mocks base method.
+func (m *MockCinemaStaffRepository) GetByCinemaAndUser(ctx context.Context, cinemaID, userID uuid.UUID) (*domain.CinemaStaff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCinemaAndUser", ctx, cinemaID, userID)
+	ret0, _ := ret[0].(*domain.CinemaStaff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCinemaAndUser indicates an expected call of GetByCinemaAndUser.
+func (mr *MockCinemaStaffRepositoryMockRecorder) GetByCinemaAndUser(ctx, cinemaID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCinemaAndUser", reflect.TypeOf((*MockCinemaStaffRepository)(nil).GetByCinemaAndUser), ctx, cinemaID, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockCinemaStaffRepository) GetByID(ctx context.Context, staffID uuid.UUID) (*domain.CinemaStaff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, staffID)
+	ret0, _ := ret[0].(*domain.CinemaStaff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCinemaStaffRepositoryMockRecorder) GetByID(ctx, staffID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCinemaStaffRepository)(nil).GetByID), ctx, staffID)
+}
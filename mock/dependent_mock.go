@@ -0,0 +1,271 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dependent.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockDependentHandler is a mock of DependentHandler interface.
+type MockDependentHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependentHandlerMockRecorder
+}
+
+// MockDependentHandlerMockRecorder is the mock recorder for MockDependentHandler.
+type MockDependentHandlerMockRecorder struct {
+	mock *MockDependentHandler
+}
+
+// NewMockDependentHandler creates a new mock instance.
+func NewMockDependentHandler(ctrl *gomock.Controller) *MockDependentHandler {
+	mock := &MockDependentHandler{ctrl: ctrl}
+	mock.recorder = &MockDependentHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependentHandler) EXPECT() *MockDependentHandlerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDependentHandler) Create(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDependentHandlerMockRecorder) Create(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDependentHandler)(nil).Create), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockDependentHandler) Delete(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDependentHandlerMockRecorder) Delete(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDependentHandler)(nil).Delete), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockDependentHandler) GetAll(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockDependentHandlerMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockDependentHandler)(nil).GetAll), ctx)
+}
+
+// Update mocks base method.
+func (m *MockDependentHandler) Update(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDependentHandlerMockRecorder) Update(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDependentHandler)(nil).Update), ctx)
+}
+
+// MockDependentService is a mock of DependentService interface.
+type MockDependentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependentServiceMockRecorder
+}
+
+// MockDependentServiceMockRecorder is the mock recorder for MockDependentService.
+type MockDependentServiceMockRecorder struct {
+	mock *MockDependentService
+}
+
+// NewMockDependentService creates a new mock instance.
+func NewMockDependentService(ctrl *gomock.Controller) *MockDependentService {
+	mock := &MockDependentService{ctrl: ctrl}
+	mock.recorder = &MockDependentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependentService) EXPECT() *MockDependentServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDependentService) Create(ctx context.Context, payload domain.DependentPayload) (*domain.DependentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, payload)
+	ret0, _ := ret[0].(*domain.DependentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDependentServiceMockRecorder) Create(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDependentService)(nil).Create), ctx, payload)
+}
+
+// Delete mocks base method.
+func (m *MockDependentService) Delete(ctx context.Context, dependentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, dependentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDependentServiceMockRecorder) Delete(ctx, dependentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDependentService)(nil).Delete), ctx, dependentID)
+}
+
+// GetAll mocks base method.
+func (m *MockDependentService) GetAll(ctx context.Context) ([]*domain.DependentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*domain.DependentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockDependentServiceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockDependentService)(nil).GetAll), ctx)
+}
+
+// Update mocks base method.
+func (m *MockDependentService) Update(ctx context.Context, dependentID uuid.UUID, payload domain.UpdateDependentPayload) (*domain.DependentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, dependentID, payload)
+	ret0, _ := ret[0].(*domain.DependentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDependentServiceMockRecorder) Update(ctx, dependentID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDependentService)(nil).Update), ctx, dependentID, payload)
+}
+
+// MockDependentRepository is a mock of DependentRepository interface.
+type MockDependentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependentRepositoryMockRecorder
+}
+
+// MockDependentRepositoryMockRecorder is the mock recorder for MockDependentRepository.
+type MockDependentRepositoryMockRecorder struct {
+	mock *MockDependentRepository
+}
+
+// NewMockDependentRepository creates a new mock instance.
+func NewMockDependentRepository(ctrl *gomock.Controller) *MockDependentRepository {
+	mock := &MockDependentRepository{ctrl: ctrl}
+	mock.recorder = &MockDependentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependentRepository) EXPECT() *MockDependentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDependentRepository) Create(ctx context.Context, dependent domain.Dependent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dependent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDependentRepositoryMockRecorder) Create(ctx, dependent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDependentRepository)(nil).Create), ctx, dependent)
+}
+
+// Delete mocks base method.
+func (m *MockDependentRepository) Delete(ctx context.Context, dependentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, dependentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDependentRepositoryMockRecorder) Delete(ctx, dependentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDependentRepository)(nil).Delete), ctx, dependentID)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockDependentRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Dependent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Dependent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockDependentRepositoryMockRecorder) GetAllByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockDependentRepository)(nil).GetAllByUserID), ctx, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockDependentRepository) GetByID(ctx context.Context, dependentID uuid.UUID) (*domain.Dependent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, dependentID)
+	ret0, _ := ret[0].(*domain.Dependent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDependentRepositoryMockRecorder) GetByID(ctx, dependentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDependentRepository)(nil).GetByID), ctx, dependentID)
+}
+
+// Update mocks base method.
+func (m *MockDependentRepository) Update(ctx context.Context, dependent domain.Dependent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, dependent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDependentRepositoryMockRecorder) Update(ctx, dependent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDependentRepository)(nil).Update), ctx, dependent)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: image_storage.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/GSVillas/movie-pass-api/client"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockImageStorage is a mock of ImageStorage interface.
+type MockImageStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageStorageMockRecorder
+}
+
+// MockImageStorageMockRecorder is the mock recorder for MockImageStorage.
+type MockImageStorageMockRecorder struct {
+	mock *MockImageStorage
+}
+
+// NewMockImageStorage creates a new mock instance.
+func NewMockImageStorage(ctrl *gomock.Controller) *MockImageStorage {
+	mock := &MockImageStorage{ctrl: ctrl}
+	mock.recorder = &MockImageStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageStorage) EXPECT() *MockImageStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockImageStorage) Delete(ctx context.Context, storageID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, storageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockImageStorageMockRecorder) Delete(ctx, storageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockImageStorage)(nil).Delete), ctx, storageID)
+}
+
+// Name mocks base method.
+func (m *MockImageStorage) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockImageStorageMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockImageStorage)(nil).Name))
+}
+
+// Upload mocks base method.
+func (m *MockImageStorage) Upload(ctx context.Context, imageBytes []byte, filename string) (*client.UploadImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, imageBytes, filename)
+	ret0, _ := ret[0].(*client.UploadImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockImageStorageMockRecorder) Upload(ctx, imageBytes, filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockImageStorage)(nil).Upload), ctx, imageBytes, filename)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: metadata_provider.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/GSVillas/movie-pass-api/client"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMetadataProvider is a mock of MetadataProvider interface.
+type MockMetadataProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetadataProviderMockRecorder
+}
+
+// MockMetadataProviderMockRecorder is the mock recorder for MockMetadataProvider.
+type MockMetadataProviderMockRecorder struct {
+	mock *MockMetadataProvider
+}
+
+// NewMockMetadataProvider creates a new mock instance.
+func NewMockMetadataProvider(ctrl *gomock.Controller) *MockMetadataProvider {
+	mock := &MockMetadataProvider{ctrl: ctrl}
+	mock.recorder = &MockMetadataProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetadataProvider) EXPECT() *MockMetadataProviderMockRecorder {
+	return m.recorder
+}
+
+// DownloadImage mocks base method.
+func (m *MockMetadataProvider) DownloadImage(ctx context.Context, imageURL string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadImage", ctx, imageURL)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadImage indicates an expected call of DownloadImage.
+func (mr *MockMetadataProviderMockRecorder) DownloadImage(ctx, imageURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadImage", reflect.TypeOf((*MockMetadataProvider)(nil).DownloadImage), ctx, imageURL)
+}
+
+// GetMovie mocks base method.
+func (m *MockMetadataProvider) GetMovie(ctx context.Context, externalID string) (*client.MetadataMovie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovie", ctx, externalID)
+	ret0, _ := ret[0].(*client.MetadataMovie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovie indicates an expected call of GetMovie.
+func (mr *MockMetadataProviderMockRecorder) GetMovie(ctx, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovie", reflect.TypeOf((*MockMetadataProvider)(nil).GetMovie), ctx, externalID)
+}
+
+// Name mocks base method.
+func (m *MockMetadataProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockMetadataProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockMetadataProvider)(nil).Name))
+}
+
+// SearchMovies mocks base method.
+func (m *MockMetadataProvider) SearchMovies(ctx context.Context, query string) ([]*client.MetadataMovieSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMovies", ctx, query)
+	ret0, _ := ret[0].([]*client.MetadataMovieSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMovies indicates an expected call of SearchMovies.
+func (mr *MockMetadataProviderMockRecorder) SearchMovies(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMovies", reflect.TypeOf((*MockMetadataProvider)(nil).SearchMovies), ctx, query)
+}
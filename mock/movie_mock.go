@@ -0,0 +1,908 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: movie.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockMovieHandler is a mock of MovieHandler interface.
+type MockMovieHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieHandlerMockRecorder
+}
+
+// MockMovieHandlerMockRecorder is the mock recorder for MockMovieHandler.
+type MockMovieHandlerMockRecorder struct {
+	mock *MockMovieHandler
+}
+
+// NewMockMovieHandler creates a new mock instance.
+func NewMockMovieHandler(ctrl *gomock.Controller) *MockMovieHandler {
+	mock := &MockMovieHandler{ctrl: ctrl}
+	mock.recorder = &MockMovieHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieHandler) EXPECT() *MockMovieHandlerMockRecorder {
+	return m.recorder
+}
+
+// AddImages mocks base method.
+func (m *MockMovieHandler) AddImages(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImages", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddImages indicates an expected call of AddImages.
+func (mr *MockMovieHandlerMockRecorder) AddImages(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImages", reflect.TypeOf((*MockMovieHandler)(nil).AddImages), ctx)
+}
+
+// Create mocks base method.
+func (m *MockMovieHandler) Create(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMovieHandlerMockRecorder) Create(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMovieHandler)(nil).Create), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockMovieHandler) Delete(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMovieHandlerMockRecorder) Delete(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMovieHandler)(nil).Delete), ctx)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockMovieHandler) GetAllByUserID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockMovieHandlerMockRecorder) GetAllByUserID(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockMovieHandler)(nil).GetAllByUserID), ctx)
+}
+
+// GetAllGenres mocks base method.
+func (m *MockMovieHandler) GetAllGenres(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllGenres", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAllGenres indicates an expected call of GetAllGenres.
+func (mr *MockMovieHandlerMockRecorder) GetAllGenres(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllGenres", reflect.TypeOf((*MockMovieHandler)(nil).GetAllGenres), ctx)
+}
+
+// GetAllIndicativeRatings mocks base method.
+func (m *MockMovieHandler) GetAllIndicativeRatings(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllIndicativeRatings", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAllIndicativeRatings indicates an expected call of GetAllIndicativeRatings.
+func (mr *MockMovieHandlerMockRecorder) GetAllIndicativeRatings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIndicativeRatings", reflect.TypeOf((*MockMovieHandler)(nil).GetAllIndicativeRatings), ctx)
+}
+
+// Import mocks base method.
+func (m *MockMovieHandler) Import(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockMovieHandlerMockRecorder) Import(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockMovieHandler)(nil).Import), ctx)
+}
+
+// RemoveImage mocks base method.
+func (m *MockMovieHandler) RemoveImage(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveImage", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveImage indicates an expected call of RemoveImage.
+func (mr *MockMovieHandlerMockRecorder) RemoveImage(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImage", reflect.TypeOf((*MockMovieHandler)(nil).RemoveImage), ctx)
+}
+
+// ReorderImages mocks base method.
+func (m *MockMovieHandler) ReorderImages(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderImages", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderImages indicates an expected call of ReorderImages.
+func (mr *MockMovieHandlerMockRecorder) ReorderImages(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderImages", reflect.TypeOf((*MockMovieHandler)(nil).ReorderImages), ctx)
+}
+
+// SearchMetadata mocks base method.
+func (m *MockMovieHandler) SearchMetadata(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMetadata", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchMetadata indicates an expected call of SearchMetadata.
+func (mr *MockMovieHandlerMockRecorder) SearchMetadata(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMetadata", reflect.TypeOf((*MockMovieHandler)(nil).SearchMetadata), ctx)
+}
+
+// SetCoverImage mocks base method.
+func (m *MockMovieHandler) SetCoverImage(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCoverImage", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCoverImage indicates an expected call of SetCoverImage.
+func (mr *MockMovieHandlerMockRecorder) SetCoverImage(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCoverImage", reflect.TypeOf((*MockMovieHandler)(nil).SetCoverImage), ctx)
+}
+
+// Update mocks base method.
+func (m *MockMovieHandler) Update(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMovieHandlerMockRecorder) Update(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMovieHandler)(nil).Update), ctx)
+}
+
+// UpdateImage mocks base method.
+func (m *MockMovieHandler) UpdateImage(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImage", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockMovieHandlerMockRecorder) UpdateImage(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockMovieHandler)(nil).UpdateImage), ctx)
+}
+
+// MockMovieService is a mock of MovieService interface.
+type MockMovieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieServiceMockRecorder
+}
+
+// MockMovieServiceMockRecorder is the mock recorder for MockMovieService.
+type MockMovieServiceMockRecorder struct {
+	mock *MockMovieService
+}
+
+// NewMockMovieService creates a new mock instance.
+func NewMockMovieService(ctrl *gomock.Controller) *MockMovieService {
+	mock := &MockMovieService{ctrl: ctrl}
+	mock.recorder = &MockMovieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieService) EXPECT() *MockMovieServiceMockRecorder {
+	return m.recorder
+}
+
+// AddImages mocks base method.
+func (m *MockMovieService) AddImages(ctx context.Context, movieID uuid.UUID, payload domain.MovieImagesPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImages", ctx, movieID, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddImages indicates an expected call of AddImages.
+func (mr *MockMovieServiceMockRecorder) AddImages(ctx, movieID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImages", reflect.TypeOf((*MockMovieService)(nil).AddImages), ctx, movieID, payload)
+}
+
+// Create mocks base method.
+func (m *MockMovieService) Create(ctx context.Context, payload domain.MoviePayload) (*domain.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, payload)
+	ret0, _ := ret[0].(*domain.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMovieServiceMockRecorder) Create(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMovieService)(nil).Create), ctx, payload)
+}
+
+// Delete mocks base method.
+func (m *MockMovieService) Delete(ctx context.Context, ID uuid.UUID, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ID, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMovieServiceMockRecorder) Delete(ctx, ID, force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMovieService)(nil).Delete), ctx, ID, force)
+}
+
+// EnqueueOrphanImageDeletes mocks base method.
+func (m *MockMovieService) EnqueueOrphanImageDeletes(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueOrphanImageDeletes", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueOrphanImageDeletes indicates an expected call of EnqueueOrphanImageDeletes.
+func (mr *MockMovieServiceMockRecorder) EnqueueOrphanImageDeletes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueOrphanImageDeletes", reflect.TypeOf((*MockMovieService)(nil).EnqueueOrphanImageDeletes), ctx)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockMovieService) GetAllByUserID(ctx context.Context, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockMovieServiceMockRecorder) GetAllByUserID(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockMovieService)(nil).GetAllByUserID), ctx, pagination)
+}
+
+// GetAllGenres mocks base method.
+func (m *MockMovieService) GetAllGenres(ctx context.Context) ([]*domain.GenreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllGenres", ctx)
+	ret0, _ := ret[0].([]*domain.GenreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllGenres indicates an expected call of GetAllGenres.
+func (mr *MockMovieServiceMockRecorder) GetAllGenres(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllGenres", reflect.TypeOf((*MockMovieService)(nil).GetAllGenres), ctx)
+}
+
+// GetAllIndicativeRatings mocks base method.
+func (m *MockMovieService) GetAllIndicativeRatings(ctx context.Context) ([]*domain.IndicativeRatingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllIndicativeRatings", ctx)
+	ret0, _ := ret[0].([]*domain.IndicativeRatingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllIndicativeRatings indicates an expected call of GetAllIndicativeRatings.
+func (mr *MockMovieServiceMockRecorder) GetAllIndicativeRatings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIndicativeRatings", reflect.TypeOf((*MockMovieService)(nil).GetAllIndicativeRatings), ctx)
+}
+
+// Import mocks base method.
+func (m *MockMovieService) Import(ctx context.Context, payload domain.MovieImportPayload) (*domain.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, payload)
+	ret0, _ := ret[0].(*domain.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockMovieServiceMockRecorder) Import(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockMovieService)(nil).Import), ctx, payload)
+}
+
+// ProcessDeleteQueue mocks base method.
+func (m *MockMovieService) ProcessDeleteQueue(ctx context.Context, task domain.MovieImageDeleteTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessDeleteQueue", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessDeleteQueue indicates an expected call of ProcessDeleteQueue.
+func (mr *MockMovieServiceMockRecorder) ProcessDeleteQueue(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessDeleteQueue", reflect.TypeOf((*MockMovieService)(nil).ProcessDeleteQueue), ctx, task)
+}
+
+// ProcessUploadQueue mocks base method.
+func (m *MockMovieService) ProcessUploadQueue(ctx context.Context, task domain.MovieImageUploadTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessUploadQueue", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessUploadQueue indicates an expected call of ProcessUploadQueue.
+func (mr *MockMovieServiceMockRecorder) ProcessUploadQueue(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessUploadQueue", reflect.TypeOf((*MockMovieService)(nil).ProcessUploadQueue), ctx, task)
+}
+
+// RemoveImage mocks base method.
+func (m *MockMovieService) RemoveImage(ctx context.Context, movieID, imageID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveImage", ctx, movieID, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveImage indicates an expected call of RemoveImage.
+func (mr *MockMovieServiceMockRecorder) RemoveImage(ctx, movieID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImage", reflect.TypeOf((*MockMovieService)(nil).RemoveImage), ctx, movieID, imageID)
+}
+
+// ReorderImages mocks base method.
+func (m *MockMovieService) ReorderImages(ctx context.Context, movieID uuid.UUID, payload domain.MovieImageOrderPayload) ([]*domain.MovieImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderImages", ctx, movieID, payload)
+	ret0, _ := ret[0].([]*domain.MovieImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderImages indicates an expected call of ReorderImages.
+func (mr *MockMovieServiceMockRecorder) ReorderImages(ctx, movieID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderImages", reflect.TypeOf((*MockMovieService)(nil).ReorderImages), ctx, movieID, payload)
+}
+
+// SearchMetadata mocks base method.
+func (m *MockMovieService) SearchMetadata(ctx context.Context, payload domain.MovieSearchPayload) ([]*domain.MovieMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMetadata", ctx, payload)
+	ret0, _ := ret[0].([]*domain.MovieMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMetadata indicates an expected call of SearchMetadata.
+func (mr *MockMovieServiceMockRecorder) SearchMetadata(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMetadata", reflect.TypeOf((*MockMovieService)(nil).SearchMetadata), ctx, payload)
+}
+
+// SetCoverImage mocks base method.
+func (m *MockMovieService) SetCoverImage(ctx context.Context, movieID, imageID uuid.UUID) ([]*domain.MovieImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCoverImage", ctx, movieID, imageID)
+	ret0, _ := ret[0].([]*domain.MovieImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCoverImage indicates an expected call of SetCoverImage.
+func (mr *MockMovieServiceMockRecorder) SetCoverImage(ctx, movieID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCoverImage", reflect.TypeOf((*MockMovieService)(nil).SetCoverImage), ctx, movieID, imageID)
+}
+
+// Update mocks base method.
+func (m *MockMovieService) Update(ctx context.Context, ID uuid.UUID, payload domain.MovieUpdatePayload) (*domain.MovieResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ID, payload)
+	ret0, _ := ret[0].(*domain.MovieResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMovieServiceMockRecorder) Update(ctx, ID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMovieService)(nil).Update), ctx, ID, payload)
+}
+
+// UpdateImage mocks base method.
+func (m *MockMovieService) UpdateImage(ctx context.Context, movieID, imageID uuid.UUID, payload domain.MovieImageUpdatePayload) (*domain.MovieImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImage", ctx, movieID, imageID, payload)
+	ret0, _ := ret[0].(*domain.MovieImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockMovieServiceMockRecorder) UpdateImage(ctx, movieID, imageID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockMovieService)(nil).UpdateImage), ctx, movieID, imageID, payload)
+}
+
+// MockMovieRepository is a mock of MovieRepository interface.
+type MockMovieRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieRepositoryMockRecorder
+}
+
+// MockMovieRepositoryMockRecorder is the mock recorder for MockMovieRepository.
+type MockMovieRepositoryMockRecorder struct {
+	mock *MockMovieRepository
+}
+
+// NewMockMovieRepository creates a new mock instance.
+func NewMockMovieRepository(ctrl *gomock.Controller) *MockMovieRepository {
+	mock := &MockMovieRepository{ctrl: ctrl}
+	mock.recorder = &MockMovieRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieRepository) EXPECT() *MockMovieRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddDeleteTaskToQueue mocks base method.
+func (m *MockMovieRepository) AddDeleteTaskToQueue(ctx context.Context, task domain.MovieImageDeleteTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeleteTaskToQueue", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDeleteTaskToQueue indicates an expected call of AddDeleteTaskToQueue.
+func (mr *MockMovieRepositoryMockRecorder) AddDeleteTaskToQueue(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeleteTaskToQueue", reflect.TypeOf((*MockMovieRepository)(nil).AddDeleteTaskToQueue), ctx, task)
+}
+
+// AddUploadTaskToQueue mocks base method.
+func (m *MockMovieRepository) AddUploadTaskToQueue(ctx context.Context, task domain.MovieImageUploadTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUploadTaskToQueue", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUploadTaskToQueue indicates an expected call of AddUploadTaskToQueue.
+func (mr *MockMovieRepositoryMockRecorder) AddUploadTaskToQueue(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUploadTaskToQueue", reflect.TypeOf((*MockMovieRepository)(nil).AddUploadTaskToQueue), ctx, task)
+}
+
+// CountFutureSessions mocks base method.
+func (m *MockMovieRepository) CountFutureSessions(ctx context.Context, movieID uuid.UUID, from time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFutureSessions", ctx, movieID, from)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFutureSessions indicates an expected call of CountFutureSessions.
+func (mr *MockMovieRepositoryMockRecorder) CountFutureSessions(ctx, movieID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFutureSessions", reflect.TypeOf((*MockMovieRepository)(nil).CountFutureSessions), ctx, movieID, from)
+}
+
+// CountMovieImages mocks base method.
+func (m *MockMovieRepository) CountMovieImages(ctx context.Context, movieID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMovieImages", ctx, movieID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMovieImages indicates an expected call of CountMovieImages.
+func (mr *MockMovieRepositoryMockRecorder) CountMovieImages(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMovieImages", reflect.TypeOf((*MockMovieRepository)(nil).CountMovieImages), ctx, movieID)
+}
+
+// Create mocks base method.
+func (m *MockMovieRepository) Create(ctx context.Context, movie domain.Movie) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, movie)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMovieRepositoryMockRecorder) Create(ctx, movie interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMovieRepository)(nil).Create), ctx, movie)
+}
+
+// CreateMovieImage mocks base method.
+func (m *MockMovieRepository) CreateMovieImage(ctx context.Context, movieImage domain.MovieImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMovieImage", ctx, movieImage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMovieImage indicates an expected call of CreateMovieImage.
+func (mr *MockMovieRepositoryMockRecorder) CreateMovieImage(ctx, movieImage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMovieImage", reflect.TypeOf((*MockMovieRepository)(nil).CreateMovieImage), ctx, movieImage)
+}
+
+// Delete mocks base method.
+func (m *MockMovieRepository) Delete(ctx context.Context, ID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMovieRepositoryMockRecorder) Delete(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMovieRepository)(nil).Delete), ctx, ID)
+}
+
+// DeleteMovieImage mocks base method.
+func (m *MockMovieRepository) DeleteMovieImage(ctx context.Context, storageID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMovieImage", ctx, storageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMovieImage indicates an expected call of DeleteMovieImage.
+func (mr *MockMovieRepositoryMockRecorder) DeleteMovieImage(ctx, storageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMovieImage", reflect.TypeOf((*MockMovieRepository)(nil).DeleteMovieImage), ctx, storageID)
+}
+
+// GetALlByUserID mocks base method.
+func (m *MockMovieRepository) GetALlByUserID(ctx context.Context, userID uuid.UUID, pagination *domain.Pagination) (*domain.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetALlByUserID", ctx, userID, pagination)
+	ret0, _ := ret[0].(*domain.Pagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetALlByUserID indicates an expected call of GetALlByUserID.
+func (mr *MockMovieRepositoryMockRecorder) GetALlByUserID(ctx, userID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetALlByUserID", reflect.TypeOf((*MockMovieRepository)(nil).GetALlByUserID), ctx, userID, pagination)
+}
+
+// GetAllGenres mocks base method.
+func (m *MockMovieRepository) GetAllGenres(ctx context.Context) ([]*domain.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllGenres", ctx)
+	ret0, _ := ret[0].([]*domain.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllGenres indicates an expected call of GetAllGenres.
+func (mr *MockMovieRepositoryMockRecorder) GetAllGenres(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllGenres", reflect.TypeOf((*MockMovieRepository)(nil).GetAllGenres), ctx)
+}
+
+// GetAllIndicativeRating mocks base method.
+func (m *MockMovieRepository) GetAllIndicativeRating(ctx context.Context) ([]*domain.IndicativeRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllIndicativeRating", ctx)
+	ret0, _ := ret[0].([]*domain.IndicativeRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllIndicativeRating indicates an expected call of GetAllIndicativeRating.
+func (mr *MockMovieRepositoryMockRecorder) GetAllIndicativeRating(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllIndicativeRating", reflect.TypeOf((*MockMovieRepository)(nil).GetAllIndicativeRating), ctx)
+}
+
+// GetByExternalID mocks base method.
+func (m *MockMovieRepository) GetByExternalID(ctx context.Context, source, externalID string) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByExternalID", ctx, source, externalID)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByExternalID indicates an expected call of GetByExternalID.
+func (mr *MockMovieRepositoryMockRecorder) GetByExternalID(ctx, source, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByExternalID", reflect.TypeOf((*MockMovieRepository)(nil).GetByExternalID), ctx, source, externalID)
+}
+
+// GetByID mocks base method.
+func (m *MockMovieRepository) GetByID(ctx context.Context, ID uuid.UUID, withPreload bool) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, ID, withPreload)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockMovieRepositoryMockRecorder) GetByID(ctx, ID, withPreload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMovieRepository)(nil).GetByID), ctx, ID, withPreload)
+}
+
+// GetGenresByIDs mocks base method.
+func (m *MockMovieRepository) GetGenresByIDs(ctx context.Context, IDs []uuid.UUID) ([]*domain.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGenresByIDs", ctx, IDs)
+	ret0, _ := ret[0].([]*domain.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGenresByIDs indicates an expected call of GetGenresByIDs.
+func (mr *MockMovieRepositoryMockRecorder) GetGenresByIDs(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenresByIDs", reflect.TypeOf((*MockMovieRepository)(nil).GetGenresByIDs), ctx, IDs)
+}
+
+// GetGenresByNames mocks base method.
+func (m *MockMovieRepository) GetGenresByNames(ctx context.Context, names []string) ([]*domain.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGenresByNames", ctx, names)
+	ret0, _ := ret[0].([]*domain.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGenresByNames indicates an expected call of GetGenresByNames.
+func (mr *MockMovieRepositoryMockRecorder) GetGenresByNames(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenresByNames", reflect.TypeOf((*MockMovieRepository)(nil).GetGenresByNames), ctx, names)
+}
+
+// GetImagesPendingDeletion mocks base method.
+func (m *MockMovieRepository) GetImagesPendingDeletion(ctx context.Context, storage string, limit int) ([]*domain.MovieImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImagesPendingDeletion", ctx, storage, limit)
+	ret0, _ := ret[0].([]*domain.MovieImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImagesPendingDeletion indicates an expected call of GetImagesPendingDeletion.
+func (mr *MockMovieRepositoryMockRecorder) GetImagesPendingDeletion(ctx, storage, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagesPendingDeletion", reflect.TypeOf((*MockMovieRepository)(nil).GetImagesPendingDeletion), ctx, storage, limit)
+}
+
+// GetIndicativeRatingByDescription mocks base method.
+func (m *MockMovieRepository) GetIndicativeRatingByDescription(ctx context.Context, description string) (*domain.IndicativeRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndicativeRatingByDescription", ctx, description)
+	ret0, _ := ret[0].(*domain.IndicativeRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIndicativeRatingByDescription indicates an expected call of GetIndicativeRatingByDescription.
+func (mr *MockMovieRepositoryMockRecorder) GetIndicativeRatingByDescription(ctx, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndicativeRatingByDescription", reflect.TypeOf((*MockMovieRepository)(nil).GetIndicativeRatingByDescription), ctx, description)
+}
+
+// GetIndicativeRatingByID mocks base method.
+func (m *MockMovieRepository) GetIndicativeRatingByID(ctx context.Context, id uuid.UUID) (*domain.IndicativeRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndicativeRatingByID", ctx, id)
+	ret0, _ := ret[0].(*domain.IndicativeRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIndicativeRatingByID indicates an expected call of GetIndicativeRatingByID.
+func (mr *MockMovieRepositoryMockRecorder) GetIndicativeRatingByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndicativeRatingByID", reflect.TypeOf((*MockMovieRepository)(nil).GetIndicativeRatingByID), ctx, id)
+}
+
+// GetNextDeleteTask mocks base method.
+func (m *MockMovieRepository) GetNextDeleteTask(ctx context.Context) (*domain.MovieImageDeleteTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextDeleteTask", ctx)
+	ret0, _ := ret[0].(*domain.MovieImageDeleteTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextDeleteTask indicates an expected call of GetNextDeleteTask.
+func (mr *MockMovieRepositoryMockRecorder) GetNextDeleteTask(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextDeleteTask", reflect.TypeOf((*MockMovieRepository)(nil).GetNextDeleteTask), ctx)
+}
+
+// GetNextImagePosition mocks base method.
+func (m *MockMovieRepository) GetNextImagePosition(ctx context.Context, movieID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextImagePosition", ctx, movieID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextImagePosition indicates an expected call of GetNextImagePosition.
+func (mr *MockMovieRepositoryMockRecorder) GetNextImagePosition(ctx, movieID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextImagePosition", reflect.TypeOf((*MockMovieRepository)(nil).GetNextImagePosition), ctx, movieID)
+}
+
+// GetNextUploadTask mocks base method.
+func (m *MockMovieRepository) GetNextUploadTask(ctx context.Context) (*domain.MovieImageUploadTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextUploadTask", ctx)
+	ret0, _ := ret[0].(*domain.MovieImageUploadTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextUploadTask indicates an expected call of GetNextUploadTask.
+func (mr *MockMovieRepositoryMockRecorder) GetNextUploadTask(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextUploadTask", reflect.TypeOf((*MockMovieRepository)(nil).GetNextUploadTask), ctx)
+}
+
+// RemoveMovieImage mocks base method.
+func (m *MockMovieRepository) RemoveMovieImage(ctx context.Context, imageID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMovieImage", ctx, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMovieImage indicates an expected call of RemoveMovieImage.
+func (mr *MockMovieRepositoryMockRecorder) RemoveMovieImage(ctx, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMovieImage", reflect.TypeOf((*MockMovieRepository)(nil).RemoveMovieImage), ctx, imageID)
+}
+
+// ReorderMovieImages mocks base method.
+func (m *MockMovieRepository) ReorderMovieImages(ctx context.Context, movieID uuid.UUID, imageIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderMovieImages", ctx, movieID, imageIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderMovieImages indicates an expected call of ReorderMovieImages.
+func (mr *MockMovieRepositoryMockRecorder) ReorderMovieImages(ctx, movieID, imageIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderMovieImages", reflect.TypeOf((*MockMovieRepository)(nil).ReorderMovieImages), ctx, movieID, imageIDs)
+}
+
+// ReplaceCredits mocks base method.
+func (m *MockMovieRepository) ReplaceCredits(ctx context.Context, movieID uuid.UUID, role domain.MovieCreditRole, credits []domain.MovieCredit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceCredits", ctx, movieID, role, credits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceCredits indicates an expected call of ReplaceCredits.
+func (mr *MockMovieRepositoryMockRecorder) ReplaceCredits(ctx, movieID, role, credits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceCredits", reflect.TypeOf((*MockMovieRepository)(nil).ReplaceCredits), ctx, movieID, role, credits)
+}
+
+// ReplaceGenres mocks base method.
+func (m *MockMovieRepository) ReplaceGenres(ctx context.Context, movieID uuid.UUID, genres []*domain.Genre) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceGenres", ctx, movieID, genres)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceGenres indicates an expected call of ReplaceGenres.
+func (mr *MockMovieRepositoryMockRecorder) ReplaceGenres(ctx, movieID, genres interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceGenres", reflect.TypeOf((*MockMovieRepository)(nil).ReplaceGenres), ctx, movieID, genres)
+}
+
+// SetMovieCover mocks base method.
+func (m *MockMovieRepository) SetMovieCover(ctx context.Context, movieID, imageID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMovieCover", ctx, movieID, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMovieCover indicates an expected call of SetMovieCover.
+func (mr *MockMovieRepositoryMockRecorder) SetMovieCover(ctx, movieID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMovieCover", reflect.TypeOf((*MockMovieRepository)(nil).SetMovieCover), ctx, movieID, imageID)
+}
+
+// Update mocks base method.
+func (m *MockMovieRepository) Update(ctx context.Context, movie domain.Movie) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, movie)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMovieRepositoryMockRecorder) Update(ctx, movie interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMovieRepository)(nil).Update), ctx, movie)
+}
+
+// UpdateMovieImage mocks base method.
+func (m *MockMovieRepository) UpdateMovieImage(ctx context.Context, movieImage domain.MovieImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMovieImage", ctx, movieImage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMovieImage indicates an expected call of UpdateMovieImage.
+func (mr *MockMovieRepositoryMockRecorder) UpdateMovieImage(ctx, movieImage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMovieImage", reflect.TypeOf((*MockMovieRepository)(nil).UpdateMovieImage), ctx, movieImage)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/GSVillas/movie-pass-api/client"
+	model "github.com/GSVillas/movie-pass-api/config/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOIDCClient is a mock of OIDCClient interface.
+type MockOIDCClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCClientMockRecorder
+}
+
+// MockOIDCClientMockRecorder is the mock recorder for MockOIDCClient.
+type MockOIDCClientMockRecorder struct {
+	mock *MockOIDCClient
+}
+
+// NewMockOIDCClient creates a new mock instance.
+func NewMockOIDCClient(ctrl *gomock.Controller) *MockOIDCClient {
+	mock := &MockOIDCClient{ctrl: ctrl}
+	mock.recorder = &MockOIDCClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCClient) EXPECT() *MockOIDCClientMockRecorder {
+	return m.recorder
+}
+
+// AuthorizationURL mocks base method.
+func (m *MockOIDCClient) AuthorizationURL(ctx context.Context, provider model.OIDCProvider, state, nonce, codeChallenge string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizationURL", ctx, provider, state, nonce, codeChallenge)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizationURL indicates an expected call of AuthorizationURL.
+func (mr *MockOIDCClientMockRecorder) AuthorizationURL(ctx, provider, state, nonce, codeChallenge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizationURL", reflect.TypeOf((*MockOIDCClient)(nil).AuthorizationURL), ctx, provider, state, nonce, codeChallenge)
+}
+
+// ExchangeCode mocks base method.
+func (m *MockOIDCClient) ExchangeCode(ctx context.Context, provider model.OIDCProvider, code, codeVerifier string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeCode", ctx, provider, code, codeVerifier)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeCode indicates an expected call of ExchangeCode.
+func (mr *MockOIDCClientMockRecorder) ExchangeCode(ctx, provider, code, codeVerifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockOIDCClient)(nil).ExchangeCode), ctx, provider, code, codeVerifier)
+}
+
+// VerifyIDToken mocks base method.
+func (m *MockOIDCClient) VerifyIDToken(ctx context.Context, provider model.OIDCProvider, idToken, nonce string) (*client.OIDCClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyIDToken", ctx, provider, idToken, nonce)
+	ret0, _ := ret[0].(*client.OIDCClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyIDToken indicates an expected call of VerifyIDToken.
+func (mr *MockOIDCClientMockRecorder) VerifyIDToken(ctx, provider, idToken, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyIDToken", reflect.TypeOf((*MockOIDCClient)(nil).VerifyIDToken), ctx, provider, idToken, nonce)
+}
@@ -0,0 +1,388 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockOrganizationHandler is a mock of OrganizationHandler interface.
+type MockOrganizationHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationHandlerMockRecorder
+}
+
+// MockOrganizationHandlerMockRecorder is the mock recorder for MockOrganizationHandler.
+type MockOrganizationHandlerMockRecorder struct {
+	mock *MockOrganizationHandler
+}
+
+// NewMockOrganizationHandler creates a new mock instance.
+func NewMockOrganizationHandler(ctrl *gomock.Controller) *MockOrganizationHandler {
+	mock := &MockOrganizationHandler{ctrl: ctrl}
+	mock.recorder = &MockOrganizationHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationHandler) EXPECT() *MockOrganizationHandlerMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockOrganizationHandler) AddMember(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockOrganizationHandlerMockRecorder) AddMember(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockOrganizationHandler)(nil).AddMember), ctx)
+}
+
+// Create mocks base method.
+func (m *MockOrganizationHandler) Create(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationHandlerMockRecorder) Create(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationHandler)(nil).Create), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockOrganizationHandler) GetAll(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockOrganizationHandlerMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrganizationHandler)(nil).GetAll), ctx)
+}
+
+// GetAllMembers mocks base method.
+func (m *MockOrganizationHandler) GetAllMembers(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMembers", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAllMembers indicates an expected call of GetAllMembers.
+func (mr *MockOrganizationHandlerMockRecorder) GetAllMembers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMembers", reflect.TypeOf((*MockOrganizationHandler)(nil).GetAllMembers), ctx)
+}
+
+// RemoveMember mocks base method.
+func (m *MockOrganizationHandler) RemoveMember(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockOrganizationHandlerMockRecorder) RemoveMember(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockOrganizationHandler)(nil).RemoveMember), ctx)
+}
+
+// UpdateSecurity mocks base method.
+func (m *MockOrganizationHandler) UpdateSecurity(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecurity", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecurity indicates an expected call of UpdateSecurity.
+func (mr *MockOrganizationHandlerMockRecorder) UpdateSecurity(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurity", reflect.TypeOf((*MockOrganizationHandler)(nil).UpdateSecurity), ctx)
+}
+
+// MockOrganizationService is a mock of OrganizationService interface.
+type MockOrganizationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationServiceMockRecorder
+}
+
+// MockOrganizationServiceMockRecorder is the mock recorder for MockOrganizationService.
+type MockOrganizationServiceMockRecorder struct {
+	mock *MockOrganizationService
+}
+
+// NewMockOrganizationService creates a new mock instance.
+func NewMockOrganizationService(ctrl *gomock.Controller) *MockOrganizationService {
+	mock := &MockOrganizationService{ctrl: ctrl}
+	mock.recorder = &MockOrganizationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationService) EXPECT() *MockOrganizationServiceMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockOrganizationService) AddMember(ctx context.Context, organizationID uuid.UUID, payload domain.OrganizationMemberPayload) (*domain.OrganizationMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, organizationID, payload)
+	ret0, _ := ret[0].(*domain.OrganizationMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockOrganizationServiceMockRecorder) AddMember(ctx, organizationID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockOrganizationService)(nil).AddMember), ctx, organizationID, payload)
+}
+
+// Create mocks base method.
+func (m *MockOrganizationService) Create(ctx context.Context, payload domain.OrganizationPayload) (*domain.OrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, payload)
+	ret0, _ := ret[0].(*domain.OrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationServiceMockRecorder) Create(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationService)(nil).Create), ctx, payload)
+}
+
+// GetAll mocks base method.
+func (m *MockOrganizationService) GetAll(ctx context.Context) ([]*domain.OrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*domain.OrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockOrganizationServiceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrganizationService)(nil).GetAll), ctx)
+}
+
+// GetAllMembers mocks base method.
+func (m *MockOrganizationService) GetAllMembers(ctx context.Context, organizationID uuid.UUID) ([]*domain.OrganizationMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMembers", ctx, organizationID)
+	ret0, _ := ret[0].([]*domain.OrganizationMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllMembers indicates an expected call of GetAllMembers.
+func (mr *MockOrganizationServiceMockRecorder) GetAllMembers(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMembers", reflect.TypeOf((*MockOrganizationService)(nil).GetAllMembers), ctx, organizationID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockOrganizationService) RemoveMember(ctx context.Context, organizationID, memberID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, organizationID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockOrganizationServiceMockRecorder) RemoveMember(ctx, organizationID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockOrganizationService)(nil).RemoveMember), ctx, organizationID, memberID)
+}
+
+// UpdateSecurity mocks base method.
+func (m *MockOrganizationService) UpdateSecurity(ctx context.Context, organizationID uuid.UUID, payload domain.OrganizationSecurityPayload) (*domain.OrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecurity", ctx, organizationID, payload)
+	ret0, _ := ret[0].(*domain.OrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecurity indicates an expected call of UpdateSecurity.
+func (mr *MockOrganizationServiceMockRecorder) UpdateSecurity(ctx, organizationID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurity", reflect.TypeOf((*MockOrganizationService)(nil).UpdateSecurity), ctx, organizationID, payload)
+}
+
+// MockOrganizationRepository is a mock of OrganizationRepository interface.
+type MockOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationRepositoryMockRecorder
+}
+
+// MockOrganizationRepositoryMockRecorder is the mock recorder for MockOrganizationRepository.
+type MockOrganizationRepositoryMockRecorder struct {
+	mock *MockOrganizationRepository
+}
+
+// NewMockOrganizationRepository creates a new mock instance.
+func NewMockOrganizationRepository(ctrl *gomock.Controller) *MockOrganizationRepository {
+	mock := &MockOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationRepository) EXPECT() *MockOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOrganizationRepository) Create(ctx context.Context, organization domain.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, organization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationRepositoryMockRecorder) Create(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationRepository)(nil).Create), ctx, organization)
+}
+
+// CreateMember mocks base method.
+func (m *MockOrganizationRepository) CreateMember(ctx context.Context, member domain.OrganizationMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMember indicates an expected call of CreateMember.
+func (mr *MockOrganizationRepositoryMockRecorder) CreateMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockOrganizationRepository)(nil).CreateMember), ctx, member)
+}
+
+// DeleteMember mocks base method.
+func (m *MockOrganizationRepository) DeleteMember(ctx context.Context, memberID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockOrganizationRepositoryMockRecorder) DeleteMember(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockOrganizationRepository)(nil).DeleteMember), ctx, memberID)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockOrganizationRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockOrganizationRepositoryMockRecorder) GetAllByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockOrganizationRepository)(nil).GetAllByUserID), ctx, userID)
+}
+
+// GetAllMembers mocks base method.
+func (m *MockOrganizationRepository) GetAllMembers(ctx context.Context, organizationID uuid.UUID) ([]*domain.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMembers", ctx, organizationID)
+	ret0, _ := ret[0].([]*domain.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllMembers indicates an expected call of GetAllMembers.
+func (mr *MockOrganizationRepositoryMockRecorder) GetAllMembers(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMembers", reflect.TypeOf((*MockOrganizationRepository)(nil).GetAllMembers), ctx, organizationID)
+}
+
+// GetByID mocks base method.
+func (m *MockOrganizationRepository) GetByID(ctx context.Context, organizationID uuid.UUID) (*domain.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, organizationID)
+	ret0, _ := ret[0].(*domain.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOrganizationRepositoryMockRecorder) GetByID(ctx, organizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrganizationRepository)(nil).GetByID), ctx, organizationID)
+}
+
+// GetMember mocks base method.
+func (m *MockOrganizationRepository) GetMember(ctx context.Context, organizationID, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(*domain.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockOrganizationRepositoryMockRecorder) GetMember(ctx, organizationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockOrganizationRepository)(nil).GetMember), ctx, organizationID, userID)
+}
+
+// GetMemberByID mocks base method.
+func (m *MockOrganizationRepository) GetMemberByID(ctx context.Context, memberID uuid.UUID) (*domain.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberByID", ctx, memberID)
+	ret0, _ := ret[0].(*domain.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberByID indicates an expected call of GetMemberByID.
+func (mr *MockOrganizationRepositoryMockRecorder) GetMemberByID(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberByID", reflect.TypeOf((*MockOrganizationRepository)(nil).GetMemberByID), ctx, memberID)
+}
+
+// Update mocks base method.
+func (m *MockOrganizationRepository) Update(ctx context.Context, organization domain.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, organization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOrganizationRepositoryMockRecorder) Update(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationRepository)(nil).Update), ctx, organization)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: partner.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockPartnerHandler is a mock of PartnerHandler interface.
+type MockPartnerHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartnerHandlerMockRecorder
+}
+
+// MockPartnerHandlerMockRecorder is the mock recorder for MockPartnerHandler.
+type MockPartnerHandlerMockRecorder struct {
+	mock *MockPartnerHandler
+}
+
+// NewMockPartnerHandler creates a new mock instance.
+func NewMockPartnerHandler(ctrl *gomock.Controller) *MockPartnerHandler {
+	mock := &MockPartnerHandler{ctrl: ctrl}
+	mock.recorder = &MockPartnerHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartnerHandler) EXPECT() *MockPartnerHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateTicket mocks base method.
+func (m *MockPartnerHandler) CreateTicket(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *MockPartnerHandlerMockRecorder) CreateTicket(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockPartnerHandler)(nil).CreateTicket), ctx)
+}
+
+// GetShowtimes mocks base method.
+func (m *MockPartnerHandler) GetShowtimes(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimes", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetShowtimes indicates an expected call of GetShowtimes.
+func (mr *MockPartnerHandlerMockRecorder) GetShowtimes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimes", reflect.TypeOf((*MockPartnerHandler)(nil).GetShowtimes), ctx)
+}
+
+// MockPartnerService is a mock of PartnerService interface.
+type MockPartnerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartnerServiceMockRecorder
+}
+
+// MockPartnerServiceMockRecorder is the mock recorder for MockPartnerService.
+type MockPartnerServiceMockRecorder struct {
+	mock *MockPartnerService
+}
+
+// NewMockPartnerService creates a new mock instance.
+func NewMockPartnerService(ctrl *gomock.Controller) *MockPartnerService {
+	mock := &MockPartnerService{ctrl: ctrl}
+	mock.recorder = &MockPartnerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartnerService) EXPECT() *MockPartnerServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateTicket mocks base method.
+func (m *MockPartnerService) CreateTicket(ctx context.Context, cinemaSessionID uuid.UUID, payload domain.PartnerTicketPayload) (*domain.TicketResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx, cinemaSessionID, payload)
+	ret0, _ := ret[0].(*domain.TicketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *MockPartnerServiceMockRecorder) CreateTicket(ctx, cinemaSessionID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockPartnerService)(nil).CreateTicket), ctx, cinemaSessionID, payload)
+}
+
+// GetShowtimes mocks base method.
+func (m *MockPartnerService) GetShowtimes(ctx context.Context, cinemaID uuid.UUID) ([]*domain.ShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimes", ctx, cinemaID)
+	ret0, _ := ret[0].([]*domain.ShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimes indicates an expected call of GetShowtimes.
+func (mr *MockPartnerServiceMockRecorder) GetShowtimes(ctx, cinemaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimes", reflect.TypeOf((*MockPartnerService)(nil).GetShowtimes), ctx, cinemaID)
+}
+
+// MockPartnerRepository is a mock of PartnerRepository interface.
+type MockPartnerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartnerRepositoryMockRecorder
+}
+
+// MockPartnerRepositoryMockRecorder is the mock recorder for MockPartnerRepository.
+type MockPartnerRepositoryMockRecorder struct {
+	mock *MockPartnerRepository
+}
+
+// NewMockPartnerRepository creates a new mock instance.
+func NewMockPartnerRepository(ctrl *gomock.Controller) *MockPartnerRepository {
+	mock := &MockPartnerRepository{ctrl: ctrl}
+	mock.recorder = &MockPartnerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartnerRepository) EXPECT() *MockPartnerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetUpcomingSessions mocks base method.
+func (m *MockPartnerRepository) GetUpcomingSessions(ctx context.Context, cinemaID uuid.UUID, from time.Time) ([]*domain.CinemaSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpcomingSessions", ctx, cinemaID, from)
+	ret0, _ := ret[0].([]*domain.CinemaSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingSessions indicates an expected call of GetUpcomingSessions.
+func (mr *MockPartnerRepositoryMockRecorder) GetUpcomingSessions(ctx, cinemaID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingSessions", reflect.TypeOf((*MockPartnerRepository)(nil).GetUpcomingSessions), ctx, cinemaID, from)
+}
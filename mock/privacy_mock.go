@@ -0,0 +1,375 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: privacy.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockPrivacyHandler is a mock of PrivacyHandler interface.
+type MockPrivacyHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyHandlerMockRecorder
+}
+
+// MockPrivacyHandlerMockRecorder is the mock recorder for MockPrivacyHandler.
+type MockPrivacyHandlerMockRecorder struct {
+	mock *MockPrivacyHandler
+}
+
+// NewMockPrivacyHandler creates a new mock instance.
+func NewMockPrivacyHandler(ctrl *gomock.Controller) *MockPrivacyHandler {
+	mock := &MockPrivacyHandler{ctrl: ctrl}
+	mock.recorder = &MockPrivacyHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacyHandler) EXPECT() *MockPrivacyHandlerMockRecorder {
+	return m.recorder
+}
+
+// DownloadExport mocks base method.
+func (m *MockPrivacyHandler) DownloadExport(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadExport", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadExport indicates an expected call of DownloadExport.
+func (mr *MockPrivacyHandlerMockRecorder) DownloadExport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadExport", reflect.TypeOf((*MockPrivacyHandler)(nil).DownloadExport), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockPrivacyHandler) GetAll(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPrivacyHandlerMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPrivacyHandler)(nil).GetAll), ctx)
+}
+
+// RequestDeletion mocks base method.
+func (m *MockPrivacyHandler) RequestDeletion(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestDeletion", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestDeletion indicates an expected call of RequestDeletion.
+func (mr *MockPrivacyHandlerMockRecorder) RequestDeletion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestDeletion", reflect.TypeOf((*MockPrivacyHandler)(nil).RequestDeletion), ctx)
+}
+
+// RequestExport mocks base method.
+func (m *MockPrivacyHandler) RequestExport(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestExport", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestExport indicates an expected call of RequestExport.
+func (mr *MockPrivacyHandlerMockRecorder) RequestExport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestExport", reflect.TypeOf((*MockPrivacyHandler)(nil).RequestExport), ctx)
+}
+
+// MockPrivacyService is a mock of PrivacyService interface.
+type MockPrivacyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyServiceMockRecorder
+}
+
+// MockPrivacyServiceMockRecorder is the mock recorder for MockPrivacyService.
+type MockPrivacyServiceMockRecorder struct {
+	mock *MockPrivacyService
+}
+
+// NewMockPrivacyService creates a new mock instance.
+func NewMockPrivacyService(ctrl *gomock.Controller) *MockPrivacyService {
+	mock := &MockPrivacyService{ctrl: ctrl}
+	mock.recorder = &MockPrivacyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacyService) EXPECT() *MockPrivacyServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockPrivacyService) GetAll(ctx context.Context) ([]*domain.PrivacyRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*domain.PrivacyRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPrivacyServiceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPrivacyService)(nil).GetAll), ctx)
+}
+
+// GetExportFile mocks base method.
+func (m *MockPrivacyService) GetExportFile(ctx context.Context, requestID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExportFile", ctx, requestID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExportFile indicates an expected call of GetExportFile.
+func (mr *MockPrivacyServiceMockRecorder) GetExportFile(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportFile", reflect.TypeOf((*MockPrivacyService)(nil).GetExportFile), ctx, requestID)
+}
+
+// ProcessQueue mocks base method.
+func (m *MockPrivacyService) ProcessQueue(ctx context.Context, task domain.PrivacyTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessQueue", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessQueue indicates an expected call of ProcessQueue.
+func (mr *MockPrivacyServiceMockRecorder) ProcessQueue(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessQueue", reflect.TypeOf((*MockPrivacyService)(nil).ProcessQueue), ctx, task)
+}
+
+// PurgeExpiredExports mocks base method.
+func (m *MockPrivacyService) PurgeExpiredExports(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredExports", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeExpiredExports indicates an expected call of PurgeExpiredExports.
+func (mr *MockPrivacyServiceMockRecorder) PurgeExpiredExports(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredExports", reflect.TypeOf((*MockPrivacyService)(nil).PurgeExpiredExports), ctx)
+}
+
+// RequestDeletion mocks base method.
+func (m *MockPrivacyService) RequestDeletion(ctx context.Context, payload domain.DeleteAccountPayload) (*domain.PrivacyRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestDeletion", ctx, payload)
+	ret0, _ := ret[0].(*domain.PrivacyRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestDeletion indicates an expected call of RequestDeletion.
+func (mr *MockPrivacyServiceMockRecorder) RequestDeletion(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestDeletion", reflect.TypeOf((*MockPrivacyService)(nil).RequestDeletion), ctx, payload)
+}
+
+// RequestExport mocks base method.
+func (m *MockPrivacyService) RequestExport(ctx context.Context) (*domain.PrivacyRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestExport", ctx)
+	ret0, _ := ret[0].(*domain.PrivacyRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestExport indicates an expected call of RequestExport.
+func (mr *MockPrivacyServiceMockRecorder) RequestExport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestExport", reflect.TypeOf((*MockPrivacyService)(nil).RequestExport), ctx)
+}
+
+// MockPrivacyRepository is a mock of PrivacyRepository interface.
+type MockPrivacyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyRepositoryMockRecorder
+}
+
+// MockPrivacyRepositoryMockRecorder is the mock recorder for MockPrivacyRepository.
+type MockPrivacyRepositoryMockRecorder struct {
+	mock *MockPrivacyRepository
+}
+
+// NewMockPrivacyRepository creates a new mock instance.
+func NewMockPrivacyRepository(ctrl *gomock.Controller) *MockPrivacyRepository {
+	mock := &MockPrivacyRepository{ctrl: ctrl}
+	mock.recorder = &MockPrivacyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacyRepository) EXPECT() *MockPrivacyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddTaskToQueue mocks base method.
+func (m *MockPrivacyRepository) AddTaskToQueue(ctx context.Context, task domain.PrivacyTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTaskToQueue", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTaskToQueue indicates an expected call of AddTaskToQueue.
+func (mr *MockPrivacyRepositoryMockRecorder) AddTaskToQueue(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTaskToQueue", reflect.TypeOf((*MockPrivacyRepository)(nil).AddTaskToQueue), ctx, task)
+}
+
+// AnonymizeUser mocks base method.
+func (m *MockPrivacyRepository) AnonymizeUser(ctx context.Context, userID uuid.UUID, anonymizedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymizeUser", ctx, userID, anonymizedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AnonymizeUser indicates an expected call of AnonymizeUser.
+func (mr *MockPrivacyRepositoryMockRecorder) AnonymizeUser(ctx, userID, anonymizedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymizeUser", reflect.TypeOf((*MockPrivacyRepository)(nil).AnonymizeUser), ctx, userID, anonymizedAt)
+}
+
+// Create mocks base method.
+func (m *MockPrivacyRepository) Create(ctx context.Context, request domain.PrivacyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPrivacyRepositoryMockRecorder) Create(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPrivacyRepository)(nil).Create), ctx, request)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockPrivacyRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.PrivacyRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.PrivacyRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockPrivacyRepositoryMockRecorder) GetAllByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockPrivacyRepository)(nil).GetAllByUserID), ctx, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockPrivacyRepository) GetByID(ctx context.Context, requestID uuid.UUID) (*domain.PrivacyRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, requestID)
+	ret0, _ := ret[0].(*domain.PrivacyRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPrivacyRepositoryMockRecorder) GetByID(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPrivacyRepository)(nil).GetByID), ctx, requestID)
+}
+
+// GetExpiredExports mocks base method.
+func (m *MockPrivacyRepository) GetExpiredExports(ctx context.Context, now time.Time) ([]*domain.PrivacyRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredExports", ctx, now)
+	ret0, _ := ret[0].([]*domain.PrivacyRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredExports indicates an expected call of GetExpiredExports.
+func (mr *MockPrivacyRepositoryMockRecorder) GetExpiredExports(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredExports", reflect.TypeOf((*MockPrivacyRepository)(nil).GetExpiredExports), ctx, now)
+}
+
+// GetNextTask mocks base method.
+func (m *MockPrivacyRepository) GetNextTask(ctx context.Context) (*domain.PrivacyTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextTask", ctx)
+	ret0, _ := ret[0].(*domain.PrivacyTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextTask indicates an expected call of GetNextTask.
+func (mr *MockPrivacyRepositoryMockRecorder) GetNextTask(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextTask", reflect.TypeOf((*MockPrivacyRepository)(nil).GetNextTask), ctx)
+}
+
+// GetOpenByUserID mocks base method.
+func (m *MockPrivacyRepository) GetOpenByUserID(ctx context.Context, userID uuid.UUID, requestType domain.PrivacyRequestType) (*domain.PrivacyRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenByUserID", ctx, userID, requestType)
+	ret0, _ := ret[0].(*domain.PrivacyRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenByUserID indicates an expected call of GetOpenByUserID.
+func (mr *MockPrivacyRepositoryMockRecorder) GetOpenByUserID(ctx, userID, requestType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenByUserID", reflect.TypeOf((*MockPrivacyRepository)(nil).GetOpenByUserID), ctx, userID, requestType)
+}
+
+// GetTicketsByUserID mocks base method.
+func (m *MockPrivacyRepository) GetTicketsByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.SeatReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.SeatReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketsByUserID indicates an expected call of GetTicketsByUserID.
+func (mr *MockPrivacyRepositoryMockRecorder) GetTicketsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByUserID", reflect.TypeOf((*MockPrivacyRepository)(nil).GetTicketsByUserID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockPrivacyRepository) Update(ctx context.Context, request domain.PrivacyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPrivacyRepositoryMockRecorder) Update(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPrivacyRepository)(nil).Update), ctx, request)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: seat.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockSeatRepository is a mock of SeatRepository interface.
+type MockSeatRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeatRepositoryMockRecorder
+}
+
+// MockSeatRepositoryMockRecorder is the mock recorder for MockSeatRepository.
+type MockSeatRepositoryMockRecorder struct {
+	mock *MockSeatRepository
+}
+
+// NewMockSeatRepository creates a new mock instance.
+func NewMockSeatRepository(ctrl *gomock.Controller) *MockSeatRepository {
+	mock := &MockSeatRepository{ctrl: ctrl}
+	mock.recorder = &MockSeatRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeatRepository) EXPECT() *MockSeatRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSeatRepository) Create(ctx context.Context, seat domain.Seat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, seat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeatRepositoryMockRecorder) Create(ctx, seat interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeatRepository)(nil).Create), ctx, seat)
+}
+
+// Delete mocks base method.
+func (m *MockSeatRepository) Delete(ctx context.Context, seatID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, seatID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSeatRepositoryMockRecorder) Delete(ctx, seatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSeatRepository)(nil).Delete), ctx, seatID)
+}
+
+// GetAll mocks base method.
+func (m *MockSeatRepository) GetAll(ctx context.Context) ([]domain.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]domain.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSeatRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSeatRepository)(nil).GetAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockSeatRepository) GetByID(ctx context.Context, seatID uuid.UUID) (*domain.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, seatID)
+	ret0, _ := ret[0].(*domain.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSeatRepositoryMockRecorder) GetByID(ctx, seatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSeatRepository)(nil).GetByID), ctx, seatID)
+}
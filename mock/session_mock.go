@@ -7,12 +7,121 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/GSVillas/movie-pass-api/domain"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
 )
 
+// MockSessionHandler is a mock of SessionHandler interface.
+type MockSessionHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionHandlerMockRecorder
+}
+
+// MockSessionHandlerMockRecorder is the mock recorder for MockSessionHandler.
+type MockSessionHandlerMockRecorder struct {
+	mock *MockSessionHandler
+}
+
+// NewMockSessionHandler creates a new mock instance.
+func NewMockSessionHandler(ctrl *gomock.Controller) *MockSessionHandler {
+	mock := &MockSessionHandler{ctrl: ctrl}
+	mock.recorder = &MockSessionHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionHandler) EXPECT() *MockSessionHandlerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSessionHandler) Delete(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSessionHandlerMockRecorder) Delete(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSessionHandler)(nil).Delete), ctx)
+}
+
+// DeleteAll mocks base method.
+func (m *MockSessionHandler) DeleteAll(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAll indicates an expected call of DeleteAll.
+func (mr *MockSessionHandlerMockRecorder) DeleteAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockSessionHandler)(nil).DeleteAll), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockSessionHandler) GetAll(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSessionHandlerMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSessionHandler)(nil).GetAll), ctx)
+}
+
+// GetJWKS mocks base method.
+func (m *MockSessionHandler) GetJWKS(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJWKS", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetJWKS indicates an expected call of GetJWKS.
+func (mr *MockSessionHandlerMockRecorder) GetJWKS(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWKS", reflect.TypeOf((*MockSessionHandler)(nil).GetJWKS), ctx)
+}
+
+// Refresh mocks base method.
+func (m *MockSessionHandler) Refresh(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockSessionHandlerMockRecorder) Refresh(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockSessionHandler)(nil).Refresh), ctx)
+}
+
+// SignOut mocks base method.
+func (m *MockSessionHandler) SignOut(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignOut", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignOut indicates an expected call of SignOut.
+func (mr *MockSessionHandlerMockRecorder) SignOut(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockSessionHandler)(nil).SignOut), ctx)
+}
+
 // MockSessionService is a mock of SessionService interface.
 type MockSessionService struct {
 	ctrl     *gomock.Controller
@@ -37,18 +146,75 @@ func (m *MockSessionService) EXPECT() *MockSessionServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockSessionService) Create(ctx context.Context, user domain.User) (string, error) {
+func (m *MockSessionService) Create(ctx context.Context, user domain.User, metadata domain.SessionMetadata) (*domain.SessionTokens, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, user)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Create", ctx, user, metadata)
+	ret0, _ := ret[0].(*domain.SessionTokens)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockSessionServiceMockRecorder) Create(ctx, user interface{}) *gomock.Call {
+func (mr *MockSessionServiceMockRecorder) Create(ctx, user, metadata interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionService)(nil).Create), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionService)(nil).Create), ctx, user, metadata)
+}
+
+// Delete mocks base method.
+func (m *MockSessionService) Delete(ctx context.Context, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSessionServiceMockRecorder) Delete(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSessionService)(nil).Delete), ctx, sessionID)
+}
+
+// DeleteAll mocks base method.
+func (m *MockSessionService) DeleteAll(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAll indicates an expected call of DeleteAll.
+func (mr *MockSessionServiceMockRecorder) DeleteAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockSessionService)(nil).DeleteAll), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockSessionService) GetAll(ctx context.Context) ([]*domain.SessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*domain.SessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSessionServiceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSessionService)(nil).GetAll), ctx)
+}
+
+// GetJWKS mocks base method.
+func (m *MockSessionService) GetJWKS() *domain.JSONWebKeySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJWKS")
+	ret0, _ := ret[0].(*domain.JSONWebKeySet)
+	return ret0
+}
+
+// GetJWKS indicates an expected call of GetJWKS.
+func (mr *MockSessionServiceMockRecorder) GetJWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWKS", reflect.TypeOf((*MockSessionService)(nil).GetJWKS))
 }
 
 // GetSession mocks base method.
@@ -66,6 +232,91 @@ func (mr *MockSessionServiceMockRecorder) GetSession(ctx, token interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionService)(nil).GetSession), ctx, token)
 }
 
+// MarkTwoFactorVerified mocks base method.
+func (m *MockSessionService) MarkTwoFactorVerified(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTwoFactorVerified", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkTwoFactorVerified indicates an expected call of MarkTwoFactorVerified.
+func (mr *MockSessionServiceMockRecorder) MarkTwoFactorVerified(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTwoFactorVerified", reflect.TypeOf((*MockSessionService)(nil).MarkTwoFactorVerified), ctx)
+}
+
+// Refresh mocks base method.
+func (m *MockSessionService) Refresh(ctx context.Context, refreshToken string) (*domain.SessionTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*domain.SessionTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockSessionServiceMockRecorder) Refresh(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockSessionService)(nil).Refresh), ctx, refreshToken)
+}
+
+// RevokeAll mocks base method.
+func (m *MockSessionService) RevokeAll(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAll", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockSessionServiceMockRecorder) RevokeAll(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockSessionService)(nil).RevokeAll), ctx, userID)
+}
+
+// RevokeOthers mocks base method.
+func (m *MockSessionService) RevokeOthers(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOthers", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeOthers indicates an expected call of RevokeOthers.
+func (mr *MockSessionServiceMockRecorder) RevokeOthers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOthers", reflect.TypeOf((*MockSessionService)(nil).RevokeOthers), ctx)
+}
+
+// SignOut mocks base method.
+func (m *MockSessionService) SignOut(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignOut", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignOut indicates an expected call of SignOut.
+func (mr *MockSessionServiceMockRecorder) SignOut(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockSessionService)(nil).SignOut), ctx)
+}
+
+// SyncUser mocks base method.
+func (m *MockSessionService) SyncUser(ctx context.Context, user domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncUser indicates an expected call of SyncUser.
+func (mr *MockSessionServiceMockRecorder) SyncUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncUser", reflect.TypeOf((*MockSessionService)(nil).SyncUser), ctx, user)
+}
+
 // MockSessionRepository is a mock of SessionRepository interface.
 type MockSessionRepository struct {
 	ctrl     *gomock.Controller
@@ -89,6 +340,21 @@ func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ConsumeRefreshToken mocks base method.
+func (m *MockSessionRepository) ConsumeRefreshToken(ctx context.Context, tokenHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeRefreshToken", ctx, tokenHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeRefreshToken indicates an expected call of ConsumeRefreshToken.
+func (mr *MockSessionRepositoryMockRecorder) ConsumeRefreshToken(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeRefreshToken", reflect.TypeOf((*MockSessionRepository)(nil).ConsumeRefreshToken), ctx, tokenHash)
+}
+
 // Create mocks base method.
 func (m *MockSessionRepository) Create(ctx context.Context, session domain.Session) error {
 	m.ctrl.T.Helper()
@@ -103,17 +369,132 @@ func (mr *MockSessionRepositoryMockRecorder) Create(ctx, session interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionRepository)(nil).Create), ctx, session)
 }
 
+// CreateRefreshToken mocks base method.
+func (m *MockSessionRepository) CreateRefreshToken(ctx context.Context, tokenHash string, refreshToken domain.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, tokenHash, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockSessionRepositoryMockRecorder) CreateRefreshToken(ctx, tokenHash, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockSessionRepository)(nil).CreateRefreshToken), ctx, tokenHash, refreshToken)
+}
+
+// Delete mocks base method.
+func (m *MockSessionRepository) Delete(ctx context.Context, userID, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSessionRepositoryMockRecorder) Delete(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSessionRepository)(nil).Delete), ctx, userID, sessionID)
+}
+
+// DeleteAll mocks base method.
+func (m *MockSessionRepository) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAll", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAll indicates an expected call of DeleteAll.
+func (mr *MockSessionRepositoryMockRecorder) DeleteAll(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockSessionRepository)(nil).DeleteAll), ctx, userID)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockSessionRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockSessionRepositoryMockRecorder) GetAllByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockSessionRepository)(nil).GetAllByUserID), ctx, userID)
+}
+
+// GetRefreshToken mocks base method.
+func (m *MockSessionRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshToken", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshToken indicates an expected call of GetRefreshToken.
+func (mr *MockSessionRepositoryMockRecorder) GetRefreshToken(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshToken", reflect.TypeOf((*MockSessionRepository)(nil).GetRefreshToken), ctx, tokenHash)
+}
+
+// GetRevokedBefore mocks base method.
+func (m *MockSessionRepository) GetRevokedBefore(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevokedBefore", ctx, userID)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevokedBefore indicates an expected call of GetRevokedBefore.
+func (mr *MockSessionRepositoryMockRecorder) GetRevokedBefore(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevokedBefore", reflect.TypeOf((*MockSessionRepository)(nil).GetRevokedBefore), ctx, userID)
+}
+
 // GetSession mocks base method.
-func (m *MockSessionRepository) GetSession(ctx context.Context, userID uuid.UUID) (*domain.Session, error) {
+func (m *MockSessionRepository) GetSession(ctx context.Context, userID, sessionID uuid.UUID) (*domain.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", ctx, userID)
+	ret := m.ctrl.Call(m, "GetSession", ctx, userID, sessionID)
 	ret0, _ := ret[0].(*domain.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSession indicates an expected call of GetSession.
-func (mr *MockSessionRepositoryMockRecorder) GetSession(ctx, userID interface{}) *gomock.Call {
+func (mr *MockSessionRepositoryMockRecorder) GetSession(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionRepository)(nil).GetSession), ctx, userID, sessionID)
+}
+
+// SetRevokedBefore mocks base method.
+func (m *MockSessionRepository) SetRevokedBefore(ctx context.Context, userID uuid.UUID, revokedBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRevokedBefore", ctx, userID, revokedBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRevokedBefore indicates an expected call of SetRevokedBefore.
+func (mr *MockSessionRepositoryMockRecorder) SetRevokedBefore(ctx, userID, revokedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRevokedBefore", reflect.TypeOf((*MockSessionRepository)(nil).SetRevokedBefore), ctx, userID, revokedBefore)
+}
+
+// Update mocks base method.
+func (m *MockSessionRepository) Update(ctx context.Context, session domain.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSessionRepositoryMockRecorder) Update(ctx, session interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionRepository)(nil).GetSession), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSessionRepository)(nil).Update), ctx, session)
 }
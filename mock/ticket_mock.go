@@ -0,0 +1,275 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ticket.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/GSVillas/movie-pass-api/domain"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockTicketHandler is a mock of TicketHandler interface.
+type MockTicketHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketHandlerMockRecorder
+}
+
+// MockTicketHandlerMockRecorder is the mock recorder for MockTicketHandler.
+type MockTicketHandlerMockRecorder struct {
+	mock *MockTicketHandler
+}
+
+// NewMockTicketHandler creates a new mock instance.
+func NewMockTicketHandler(ctrl *gomock.Controller) *MockTicketHandler {
+	mock := &MockTicketHandler{ctrl: ctrl}
+	mock.recorder = &MockTicketHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketHandler) EXPECT() *MockTicketHandlerMockRecorder {
+	return m.recorder
+}
+
+// CheckIn mocks base method.
+func (m *MockTicketHandler) CheckIn(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockTicketHandlerMockRecorder) CheckIn(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockTicketHandler)(nil).CheckIn), ctx)
+}
+
+// Create mocks base method.
+func (m *MockTicketHandler) Create(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTicketHandlerMockRecorder) Create(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTicketHandler)(nil).Create), ctx)
+}
+
+// GetAllByUser mocks base method.
+func (m *MockTicketHandler) GetAllByUser(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser.
+func (mr *MockTicketHandlerMockRecorder) GetAllByUser(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockTicketHandler)(nil).GetAllByUser), ctx)
+}
+
+// GetForCheckIn mocks base method.
+func (m *MockTicketHandler) GetForCheckIn(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForCheckIn", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetForCheckIn indicates an expected call of GetForCheckIn.
+func (mr *MockTicketHandlerMockRecorder) GetForCheckIn(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForCheckIn", reflect.TypeOf((*MockTicketHandler)(nil).GetForCheckIn), ctx)
+}
+
+// MockTicketService is a mock of TicketService interface.
+type MockTicketService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketServiceMockRecorder
+}
+
+// MockTicketServiceMockRecorder is the mock recorder for MockTicketService.
+type MockTicketServiceMockRecorder struct {
+	mock *MockTicketService
+}
+
+// NewMockTicketService creates a new mock instance.
+func NewMockTicketService(ctrl *gomock.Controller) *MockTicketService {
+	mock := &MockTicketService{ctrl: ctrl}
+	mock.recorder = &MockTicketServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketService) EXPECT() *MockTicketServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckIn mocks base method.
+func (m *MockTicketService) CheckIn(ctx context.Context, cinemaID, ticketID uuid.UUID) (*domain.TicketCheckInResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, cinemaID, ticketID)
+	ret0, _ := ret[0].(*domain.TicketCheckInResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockTicketServiceMockRecorder) CheckIn(ctx, cinemaID, ticketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockTicketService)(nil).CheckIn), ctx, cinemaID, ticketID)
+}
+
+// Create mocks base method.
+func (m *MockTicketService) Create(ctx context.Context, cinemaSessionID uuid.UUID, payload domain.TicketPayload) (*domain.TicketResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, cinemaSessionID, payload)
+	ret0, _ := ret[0].(*domain.TicketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTicketServiceMockRecorder) Create(ctx, cinemaSessionID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTicketService)(nil).Create), ctx, cinemaSessionID, payload)
+}
+
+// GetAllByUser mocks base method.
+func (m *MockTicketService) GetAllByUser(ctx context.Context) ([]*domain.TicketResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", ctx)
+	ret0, _ := ret[0].([]*domain.TicketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser.
+func (mr *MockTicketServiceMockRecorder) GetAllByUser(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockTicketService)(nil).GetAllByUser), ctx)
+}
+
+// GetForCheckIn mocks base method.
+func (m *MockTicketService) GetForCheckIn(ctx context.Context, cinemaID, ticketID uuid.UUID) (*domain.TicketCheckInResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForCheckIn", ctx, cinemaID, ticketID)
+	ret0, _ := ret[0].(*domain.TicketCheckInResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForCheckIn indicates an expected call of GetForCheckIn.
+func (mr *MockTicketServiceMockRecorder) GetForCheckIn(ctx, cinemaID, ticketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForCheckIn", reflect.TypeOf((*MockTicketService)(nil).GetForCheckIn), ctx, cinemaID, ticketID)
+}
+
+// MockTicketRepository is a mock of TicketRepository interface.
+type MockTicketRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketRepositoryMockRecorder
+}
+
+// MockTicketRepositoryMockRecorder is the mock recorder for MockTicketRepository.
+type MockTicketRepositoryMockRecorder struct {
+	mock *MockTicketRepository
+}
+
+// NewMockTicketRepository creates a new mock instance.
+func NewMockTicketRepository(ctrl *gomock.Controller) *MockTicketRepository {
+	mock := &MockTicketRepository{ctrl: ctrl}
+	mock.recorder = &MockTicketRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketRepository) EXPECT() *MockTicketRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckIn mocks base method.
+func (m *MockTicketRepository) CheckIn(ctx context.Context, ticketID, staffUserID uuid.UUID, checkedInAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, ticketID, staffUserID, checkedInAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockTicketRepositoryMockRecorder) CheckIn(ctx, ticketID, staffUserID, checkedInAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockTicketRepository)(nil).CheckIn), ctx, ticketID, staffUserID, checkedInAt)
+}
+
+// Create mocks base method.
+func (m *MockTicketRepository) Create(ctx context.Context, reservation domain.SeatReservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTicketRepositoryMockRecorder) Create(ctx, reservation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTicketRepository)(nil).Create), ctx, reservation)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockTicketRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.SeatReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.SeatReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockTicketRepositoryMockRecorder) GetAllByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockTicketRepository)(nil).GetAllByUserID), ctx, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockTicketRepository) GetByID(ctx context.Context, ticketID uuid.UUID) (*domain.SeatReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, ticketID)
+	ret0, _ := ret[0].(*domain.SeatReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTicketRepositoryMockRecorder) GetByID(ctx, ticketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTicketRepository)(nil).GetByID), ctx, ticketID)
+}
+
+// GetCinemaSessionByID mocks base method.
+func (m *MockTicketRepository) GetCinemaSessionByID(ctx context.Context, cinemaSessionID uuid.UUID) (*domain.CinemaSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCinemaSessionByID", ctx, cinemaSessionID)
+	ret0, _ := ret[0].(*domain.CinemaSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCinemaSessionByID indicates an expected call of GetCinemaSessionByID.
+func (mr *MockTicketRepositoryMockRecorder) GetCinemaSessionByID(ctx, cinemaSessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCinemaSessionByID", reflect.TypeOf((*MockTicketRepository)(nil).GetCinemaSessionByID), ctx, cinemaSessionID)
+}
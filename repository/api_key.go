@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepository struct {
+	i  *do.Injector
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(i *do.Injector) (domain.APIKeyRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	return &apiKeyRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (a *apiKeyRepository) Create(ctx context.Context, apiKey domain.APIKey) error {
+	if err := a.db.WithContext(ctx).Omit("Organization", "CreatedBy").Create(&apiKey).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *apiKeyRepository) GetByID(ctx context.Context, apiKeyID uuid.UUID) (*domain.APIKey, error) {
+	var apiKey domain.APIKey
+	if err := a.db.WithContext(ctx).Where("id = ?", apiKeyID).First(&apiKey).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+func (a *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	var apiKey domain.APIKey
+	if err := a.db.WithContext(ctx).Where("keyHash = ?", keyHash).First(&apiKey).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+func (a *apiKeyRepository) GetAllByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]*domain.APIKey, error) {
+	var apiKeys []*domain.APIKey
+	if err := a.db.WithContext(ctx).Where("organizationId = ?", organizationID).Order("createdAt DESC").Find(&apiKeys).Error; err != nil {
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+func (a *apiKeyRepository) Update(ctx context.Context, apiKey domain.APIKey) error {
+	if err := a.db.WithContext(ctx).Omit("Organization", "CreatedBy").Save(&apiKey).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *apiKeyRepository) UpdateLastUsed(ctx context.Context, apiKeyID uuid.UUID, lastUsedAt time.Time) error {
+	if err := a.db.WithContext(ctx).Model(&domain.APIKey{}).Where("id = ?", apiKeyID).Update("lastUsedAt", lastUsedAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
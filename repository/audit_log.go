@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type auditLogRepository struct {
+	i  *do.Injector
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(i *do.Injector) (domain.AuditLogRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	return &auditLogRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (a *auditLogRepository) Create(ctx context.Context, auditLog domain.AuditLog) error {
+	if err := a.db.WithContext(ctx).Create(&auditLog).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *auditLogRepository) GetAll(ctx context.Context, filter domain.AuditLogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+	var auditLogs []*domain.AuditLog
+	query := a.db.WithContext(ctx).Model(&domain.AuditLog{})
+
+	if filter.OrganizationID != nil {
+		query = query.Where("organizationId = ?", *filter.OrganizationID)
+	}
+
+	if filter.ActorID != nil {
+		query = query.Where("actorId = ?", *filter.ActorID)
+	}
+
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+
+	if filter.TargetType != "" {
+		query = query.Where("targetType = ?", filter.TargetType)
+	}
+
+	if filter.TargetID != nil {
+		query = query.Where("targetId = ?", *filter.TargetID)
+	}
+
+	if filter.From != nil {
+		query = query.Where("createdAt >= ?", *filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where("createdAt <= ?", *filter.To)
+	}
+
+	query = query.Session(&gorm.Session{})
+
+	if err := query.Scopes(paginate(&auditLogs, pagination, query)).Find(&auditLogs).Error; err != nil {
+		return nil, err
+	}
+	pagination.Rows = auditLogs
+
+	return pagination, nil
+}
+
+func (a *auditLogRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result := a.db.WithContext(ctx).Where("createdAt < ?", before).Delete(&domain.AuditLog{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
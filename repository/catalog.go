@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type catalogRepository struct {
+	i  *do.Injector
+	db *gorm.DB
+}
+
+func NewCatalogRepository(i *do.Injector) (domain.CatalogRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	return &catalogRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (c *catalogRepository) GetNowShowing(ctx context.Context, filter domain.CatalogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+	query := c.movieQuery(ctx, filter).
+		Where("Movie.id IN (?)", c.sessionMovieIDs(filter))
+
+	return c.find(query, pagination)
+}
+
+// GetComingSoon lists movies released after the filter start. Cinema filters
+// only keep the movies that already have sessions (pre-sales) there.
+func (c *catalogRepository) GetComingSoon(ctx context.Context, filter domain.CatalogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+	query := c.movieQuery(ctx, filter).
+		Where("Movie.releaseDate > ?", filter.From)
+
+	if filter.HasCinemaFilter() {
+		sessionFilter := filter
+		sessionFilter.To = time.Time{}
+		query = query.Where("Movie.id IN (?)", c.sessionMovieIDs(sessionFilter))
+	}
+
+	return c.find(query, pagination)
+}
+
+func (c *catalogRepository) movieQuery(ctx context.Context, filter domain.CatalogFilter) *gorm.DB {
+	query := c.db.WithContext(ctx).Model(&domain.Movie{})
+
+	if filter.GenreID != nil {
+		query = query.Where("Movie.id IN (?)", c.db.Table("MovieGenre").Select("movieId").Where("genreId = ?", *filter.GenreID))
+	}
+
+	if filter.IndicativeRatingID != nil {
+		query = query.Where("Movie.indicativeRatingId = ?", *filter.IndicativeRatingID)
+	}
+
+	return query
+}
+
+func (c *catalogRepository) sessionMovieIDs(filter domain.CatalogFilter) *gorm.DB {
+	sessions := c.db.Model(&domain.CinemaSession{}).
+		Select("CinemaSession.MovieId").
+		Joins("JOIN CinemaRoom ON CinemaRoom.id = CinemaSession.cinemaRoomId").
+		Joins("JOIN Cinema ON Cinema.id = CinemaRoom.cinemaId").
+		Where("CinemaSession.startTime > ?", filter.From)
+
+	if !filter.To.IsZero() {
+		sessions = sessions.Where("CinemaSession.startTime <= ?", filter.To)
+	}
+
+	if filter.City != "" {
+		sessions = sessions.Where("Cinema.city = ?", filter.City)
+	}
+
+	if filter.CinemaID != nil {
+		sessions = sessions.Where("Cinema.id = ?", *filter.CinemaID)
+	}
+
+	return sessions
+}
+
+func (c *catalogRepository) find(query *gorm.DB, pagination *domain.Pagination) (*domain.Pagination, error) {
+	var movies []*domain.Movie
+	query = query.Session(&gorm.Session{})
+
+	if err := query.
+		Scopes(paginate(&movies, pagination, query)).
+		Preload("Images", "isCover = ?", true).
+		Preload("Images.Variants").
+		Preload("IndicativeRating").
+		Preload("Genres").
+		Find(&movies).Error; err != nil {
+		return nil, err
+	}
+	pagination.Rows = movies
+
+	return pagination, nil
+}
+
+func (c *catalogRepository) Search(ctx context.Context, query domain.MovieSearchQuery, pagination *domain.Pagination) ([]*domain.MovieSearchResult, int64, error) {
+	matches := c.db.WithContext(ctx).
+		Model(&domain.Movie{}).
+		Where("MATCH(searchText) AGAINST (? IN BOOLEAN MODE)", query.Terms).
+		Session(&gorm.Session{})
+
+	var total int64
+	if err := matches.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	// Title matches weigh twice as much as matches in the synopsis or cast.
+	var results []*domain.MovieSearchResult
+	if err := matches.
+		Select("id AS movieId, (MATCH(searchTitle) AGAINST (? IN BOOLEAN MODE) * 2 + MATCH(searchText) AGAINST (? IN BOOLEAN MODE)) * IF(id IN (?), ?, 1) AS score",
+			query.Terms, query.Terms, c.nowShowingMovieIDs(query), query.NowShowingBoost).
+		Order("score DESC").
+		Offset(pagination.GetOffset()).
+		Limit(pagination.GetLimit()).
+		Scan(&results).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return results, total, nil
+}
+
+func (c *catalogRepository) GetSearchCandidates(ctx context.Context, query domain.MovieSearchQuery, trigrams []string, limit int) ([]*domain.MovieSearchCandidate, error) {
+	var candidates []*domain.MovieSearchCandidate
+	if len(trigrams) == 0 {
+		return candidates, nil
+	}
+
+	// The candidates sharing the most trigrams with the query are kept, so
+	// the limit does not cut the best matches of a large catalog.
+	anyTrigram := c.db.Where("searchTitle LIKE ?", "%"+trigrams[0]+"%")
+	matches := make([]string, 0, len(trigrams))
+	patterns := make([]any, 0, len(trigrams))
+	for i, trigram := range trigrams {
+		if i > 0 {
+			anyTrigram = anyTrigram.Or("searchTitle LIKE ?", "%"+trigram+"%")
+		}
+		matches = append(matches, "(searchTitle LIKE ?)")
+		patterns = append(patterns, "%"+trigram+"%")
+	}
+
+	if err := c.db.WithContext(ctx).
+		Model(&domain.Movie{}).
+		Select("id AS movieId, searchTitle, id IN (?) AS nowShowing", c.nowShowingMovieIDs(query)).
+		Where(anyTrigram).
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: strings.Join(matches, " + ") + " DESC, id", Vars: patterns, WithoutParentheses: true}}).
+		Limit(limit).
+		Scan(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+func (c *catalogRepository) GetMoviesByIDs(ctx context.Context, movieIDs []uuid.UUID) ([]*domain.Movie, error) {
+	var movies []*domain.Movie
+	if len(movieIDs) == 0 {
+		return movies, nil
+	}
+
+	if err := c.db.WithContext(ctx).
+		Where("id IN ?", movieIDs).
+		Preload("Images", "isCover = ?", true).
+		Preload("Images.Variants").
+		Preload("IndicativeRating").
+		Preload("Genres").
+		Find(&movies).Error; err != nil {
+		return nil, err
+	}
+
+	return movies, nil
+}
+
+func (c *catalogRepository) nowShowingMovieIDs(query domain.MovieSearchQuery) *gorm.DB {
+	return c.db.Model(&domain.CinemaSession{}).
+		Select("MovieId").
+		Where("startTime > ? AND startTime <= ?", query.NowShowingFrom, query.NowShowingTo)
+}
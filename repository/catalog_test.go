@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogRepository_GetSearchCandidates_ShouldRankByMatchingTrigramsBeforeLimit(t *testing.T) {
+	db, connector := newRecordingDB(t)
+	repository := &catalogRepository{db: db}
+
+	_, err := repository.GetSearchCandidates(context.Background(), domain.MovieSearchQuery{}, []string{"  c", " ce", "cen"}, 200)
+
+	require.NoError(t, err)
+	require.Len(t, connector.statements, 1)
+
+	statement := connector.statements[0]
+	order := strings.Index(statement, "ORDER BY (searchTitle LIKE ?) + (searchTitle LIKE ?) + (searchTitle LIKE ?) DESC")
+	limit := strings.Index(statement, "LIMIT ?")
+	assert.NotEqual(t, -1, order, statement)
+	assert.Greater(t, limit, order, statement)
+}
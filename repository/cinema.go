@@ -58,7 +58,12 @@ func (c *cinemaRepository) GetByID(ctx context.Context, cinemaID uuid.UUID) (*do
 
 func (c *cinemaRepository) GetAll(ctx context.Context, userID uuid.UUID, pagination *domain.Pagination) (*domain.Pagination, error) {
 	var cinemas []domain.Cinema
-	if err := c.db.WithContext(ctx).Scopes(paginate(cinemas, pagination, c.db)).Find(&cinemas).Error; err != nil {
+	query := c.db.WithContext(ctx).
+		Where("organizationId IN (?)", c.db.Model(&domain.OrganizationMember{}).Select("organizationId").Where("userId = ?", userID)).
+		Or("id IN (?)", c.db.Model(&domain.CinemaStaff{}).Select("cinemaId").Where("userId = ?", userID)).
+		Session(&gorm.Session{})
+
+	if err := query.Scopes(paginate(&cinemas, pagination, query)).Find(&cinemas).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -70,6 +75,14 @@ func (c *cinemaRepository) GetAll(ctx context.Context, userID uuid.UUID, paginat
 	return pagination, nil
 }
 
+func (c *cinemaRepository) Update(ctx context.Context, cinema domain.Cinema) error {
+	if err := c.db.WithContext(ctx).Omit("Organization", "User").Save(&cinema).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *cinemaRepository) Delete(ctx context.Context, cinemaID uuid.UUID) error {
 	if err := c.db.WithContext(ctx).Where("id = ?", cinemaID).Delete(&domain.Cinema{}).Error; err != nil {
 		return err
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type cinemaStaffRepository struct {
+	i  *do.Injector
+	db *gorm.DB
+}
+
+func NewCinemaStaffRepository(i *do.Injector) (domain.CinemaStaffRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cinemaStaffRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (c *cinemaStaffRepository) Create(ctx context.Context, staff domain.CinemaStaff) error {
+	if err := c.db.WithContext(ctx).Create(&staff).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *cinemaStaffRepository) GetByID(ctx context.Context, staffID uuid.UUID) (*domain.CinemaStaff, error) {
+	var staff domain.CinemaStaff
+	if err := c.db.WithContext(ctx).Where("id = ?", staffID).First(&staff).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &staff, nil
+}
+
+func (c *cinemaStaffRepository) GetByCinemaAndUser(ctx context.Context, cinemaID, userID uuid.UUID) (*domain.CinemaStaff, error) {
+	var staff domain.CinemaStaff
+	if err := c.db.WithContext(ctx).
+		Where("cinemaId = ? AND userId = ?", cinemaID, userID).
+		First(&staff).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &staff, nil
+}
+
+func (c *cinemaStaffRepository) GetAllByCinemaID(ctx context.Context, cinemaID uuid.UUID) ([]*domain.CinemaStaff, error) {
+	var staff []*domain.CinemaStaff
+	if err := c.db.WithContext(ctx).
+		Where("cinemaId = ?", cinemaID).
+		Preload("User").
+		Find(&staff).Error; err != nil {
+		return nil, err
+	}
+
+	return staff, nil
+}
+
+func (c *cinemaStaffRepository) Delete(ctx context.Context, staffID uuid.UUID) error {
+	if err := c.db.WithContext(ctx).Where("id = ?", staffID).Delete(&domain.CinemaStaff{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
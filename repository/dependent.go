@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type dependentRepository struct {
+	i  *do.Injector
+	db *gorm.DB
+}
+
+func NewDependentRepository(i *do.Injector) (domain.DependentRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	return &dependentRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (d *dependentRepository) Create(ctx context.Context, dependent domain.Dependent) error {
+	if err := d.db.WithContext(ctx).Omit("User").Create(&dependent).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *dependentRepository) GetByID(ctx context.Context, dependentID uuid.UUID) (*domain.Dependent, error) {
+	var dependent domain.Dependent
+	if err := d.db.WithContext(ctx).Where("id = ?", dependentID).First(&dependent).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &dependent, nil
+}
+
+func (d *dependentRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Dependent, error) {
+	var dependents []*domain.Dependent
+	if err := d.db.WithContext(ctx).Where("userId = ?", userID).Order("createdAt ASC").Find(&dependents).Error; err != nil {
+		return nil, err
+	}
+
+	return dependents, nil
+}
+
+func (d *dependentRepository) Update(ctx context.Context, dependent domain.Dependent) error {
+	if err := d.db.WithContext(ctx).Omit("User").Save(&dependent).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Delete soft deletes the dependent so tickets already issued keep the
+// holder name.
+func (d *dependentRepository) Delete(ctx context.Context, dependentID uuid.UUID) error {
+	if err := d.db.WithContext(ctx).Where("id = ?", dependentID).Delete(&domain.Dependent{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/go-redis/redis/v8"
+	"github.com/samber/do"
+)
+
+type loginAttemptRepository struct {
+	i           *do.Injector
+	redisClient *redis.Client
+}
+
+func NewLoginAttemptRepository(i *do.Injector) (domain.LoginAttemptRepository, error) {
+	redisClient, err := do.Invoke[*redis.Client](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize Redis client: %w", err)
+	}
+
+	return &loginAttemptRepository{
+		i:           i,
+		redisClient: redisClient,
+	}, nil
+}
+
+func (l *loginAttemptRepository) RegisterFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	failuresKey := l.getFailuresKey(key)
+
+	pipe := l.redisClient.TxPipeline()
+	count := pipe.Incr(ctx, failuresKey)
+	pipe.Expire(ctx, failuresKey, window)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return count.Val(), nil
+}
+
+func (l *loginAttemptRepository) ResetFailures(ctx context.Context, key string) error {
+	if err := l.redisClient.Del(ctx, l.getFailuresKey(key), l.getLockKey(key)).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (l *loginAttemptRepository) Lock(ctx context.Context, key string, duration time.Duration) error {
+	if err := l.redisClient.Set(ctx, l.getLockKey(key), time.Now().UTC().Add(duration).Unix(), duration).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (l *loginAttemptRepository) GetLockTTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := l.redisClient.PTTL(ctx, l.getLockKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+func (l *loginAttemptRepository) getFailuresKey(key string) string {
+	return fmt.Sprintf("login_failures_%s", key)
+}
+
+func (l *loginAttemptRepository) getLockKey(key string) string {
+	return fmt.Sprintf("login_lock_%s", key)
+}
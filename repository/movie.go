@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
@@ -64,6 +65,77 @@ func (m *MovieRepository) GetIndicativeRatingByID(ctx context.Context, id uuid.U
 	return &indicativeRating, nil
 }
 
+func (m *MovieRepository) GetIndicativeRatingByDescription(ctx context.Context, description string) (*domain.IndicativeRating, error) {
+	var indicativeRating domain.IndicativeRating
+
+	if err := m.db.WithContext(ctx).First(&indicativeRating, "description = ?", description).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &indicativeRating, nil
+}
+
+func (m *MovieRepository) GetAllGenres(ctx context.Context) ([]*domain.Genre, error) {
+	var genres []*domain.Genre
+	if err := m.db.WithContext(ctx).Order("name ASC").Find(&genres).Error; err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
+
+func (m *MovieRepository) GetGenresByIDs(ctx context.Context, IDs []uuid.UUID) ([]*domain.Genre, error) {
+	var genres []*domain.Genre
+	if len(IDs) == 0 {
+		return genres, nil
+	}
+
+	if err := m.db.WithContext(ctx).Where("id IN ?", IDs).Find(&genres).Error; err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
+
+func (m *MovieRepository) GetGenresByNames(ctx context.Context, names []string) ([]*domain.Genre, error) {
+	var genres []*domain.Genre
+	if len(names) == 0 {
+		return genres, nil
+	}
+
+	if err := m.db.WithContext(ctx).Where("name IN ?", names).Find(&genres).Error; err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
+
+func (m *MovieRepository) ReplaceGenres(ctx context.Context, movieID uuid.UUID, genres []*domain.Genre) error {
+	movie := domain.Movie{ID: movieID}
+	if err := m.db.WithContext(ctx).Model(&movie).Association("Genres").Replace(genres); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MovieRepository) ReplaceCredits(ctx context.Context, movieID uuid.UUID, role domain.MovieCreditRole, credits []domain.MovieCredit) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("movieId = ? AND role = ?", movieID, role).Delete(&domain.MovieCredit{}).Error; err != nil {
+			return err
+		}
+
+		if len(credits) == 0 {
+			return nil
+		}
+
+		return tx.Create(&credits).Error
+	})
+}
+
 func (m *MovieRepository) Create(ctx context.Context, movie domain.Movie) error {
 	if err := m.db.WithContext(ctx).Create(&movie).Error; err != nil {
 		return err
@@ -114,10 +186,12 @@ func (m *MovieRepository) GetNextUploadTask(ctx context.Context) (*domain.MovieI
 func (m *MovieRepository) GetALlByUserID(ctx context.Context, userID uuid.UUID, pagination *domain.Pagination) (*domain.Pagination, error) {
 	var movies []*domain.Movie
 	if err := m.db.WithContext(ctx).
-		Where("userId = ?", userID.String()).
+		Where("organizationId IN (?)", m.db.Model(&domain.OrganizationMember{}).Select("organizationId").Where("userId = ?", userID)).
 		Scopes(paginate(&movies, pagination, m.db)).
-		Preload("Images").
+		Preload("Images", "isCover = ?", true).
+		Preload("Images.Variants").
 		Preload("IndicativeRating").
+		Preload("Genres").
 		Find(&movies).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -125,19 +199,15 @@ func (m *MovieRepository) GetALlByUserID(ctx context.Context, userID uuid.UUID,
 		return nil, err
 	}
 
-	for _, movie := range movies {
-		if len(movie.Images) > 0 {
-			movie.Images = movie.Images[:1]
-		}
-	}
-
 	pagination.Rows = movies
 
 	return pagination, nil
 }
 
 func (m *MovieRepository) Update(ctx context.Context, movie domain.Movie) error {
-	if err := m.db.Save(movie).Error; err != nil {
+	// Images, genres and credits have their own methods; saving them here
+	// would insert back rows that were just replaced.
+	if err := m.db.WithContext(ctx).Omit("Images", "Genres", "Credits").Save(&movie).Error; err != nil {
 		return err
 	}
 
@@ -149,7 +219,11 @@ func (m *MovieRepository) GetByID(ctx context.Context, ID uuid.UUID, withPreload
 	db := m.db.WithContext(ctx)
 
 	if withPreload {
-		db = db.Preload("Images").Preload("IndicativeRating")
+		db = db.Preload("Images", func(db *gorm.DB) *gorm.DB {
+			return db.Where("parentId IS NULL").Order("position ASC")
+		}).Preload("Images.Variants").Preload("Credits", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).Preload("IndicativeRating").Preload("Genres")
 	}
 
 	if err := db.First(&movie, "id = ?", ID).Error; err != nil {
@@ -162,8 +236,122 @@ func (m *MovieRepository) GetByID(ctx context.Context, ID uuid.UUID, withPreload
 	return &movie, nil
 }
 
-func (m *MovieRepository) DeleteMovieImage(ctx context.Context, cloudFlareID uuid.UUID) error {
-	if err := m.db.WithContext(ctx).Where("cloudFlareId = ?", cloudFlareID).Delete(&domain.MovieImage{}).Error; err != nil {
+func (m *MovieRepository) GetByExternalID(ctx context.Context, source, externalID string) (*domain.Movie, error) {
+	var movie domain.Movie
+
+	if err := m.db.WithContext(ctx).First(&movie, "externalSource = ? AND externalId = ?", source, externalID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &movie, nil
+}
+
+// Delete soft deletes the movie so sessions and tickets that reference it
+// keep their history.
+func (m *MovieRepository) Delete(ctx context.Context, ID uuid.UUID) error {
+	if err := m.db.WithContext(ctx).Where("id = ?", ID).Delete(&domain.Movie{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MovieRepository) CountFutureSessions(ctx context.Context, movieID uuid.UUID, from time.Time) (int64, error) {
+	var count int64
+	if err := m.db.WithContext(ctx).Model(&domain.CinemaSession{}).Where("MovieId = ? AND startTime > ?", movieID, from).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetImagesPendingDeletion returns images of the storage backend that were
+// removed or belong to a deleted movie but whose file was not deleted yet.
+func (m *MovieRepository) GetImagesPendingDeletion(ctx context.Context, storage string, limit int) ([]*domain.MovieImage, error) {
+	var movieImages []*domain.MovieImage
+	if err := m.db.WithContext(ctx).
+		Unscoped().
+		Where("storage = ?", storage).
+		Where(m.db.Where("deletedAt IS NOT NULL").Or("movieId IN (?)", m.db.Unscoped().Model(&domain.Movie{}).Select("id").Where("deletedAt IS NOT NULL"))).
+		Limit(limit).
+		Find(&movieImages).Error; err != nil {
+		return nil, err
+	}
+
+	return movieImages, nil
+}
+
+// GetNextImagePosition returns the position after the last image of the
+// movie. Removed images leave gaps, so the count cannot be used.
+func (m *MovieRepository) GetNextImagePosition(ctx context.Context, movieID uuid.UUID) (int, error) {
+	var position int
+	if err := m.db.WithContext(ctx).
+		Model(&domain.MovieImage{}).
+		Select("COALESCE(MAX(position) + 1, 0)").
+		Where("movieId = ? AND parentId IS NULL", movieID).
+		Scan(&position).Error; err != nil {
+		return 0, err
+	}
+
+	return position, nil
+}
+
+func (m *MovieRepository) CountMovieImages(ctx context.Context, movieID uuid.UUID) (int64, error) {
+	var count int64
+	if err := m.db.WithContext(ctx).Model(&domain.MovieImage{}).Where("movieId = ? AND parentId IS NULL", movieID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (m *MovieRepository) UpdateMovieImage(ctx context.Context, movieImage domain.MovieImage) error {
+	if err := m.db.WithContext(ctx).Save(&movieImage).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveMovieImage soft deletes the image together with its variants.
+func (m *MovieRepository) RemoveMovieImage(ctx context.Context, imageID uuid.UUID) error {
+	if err := m.db.WithContext(ctx).Where("id = ? OR parentId = ?", imageID, imageID).Delete(&domain.MovieImage{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MovieRepository) ReorderMovieImages(ctx context.Context, movieID uuid.UUID, imageIDs []uuid.UUID) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for position, imageID := range imageIDs {
+			if err := tx.Model(&domain.MovieImage{}).
+				Where("(id = ? OR parentId = ?) AND movieId = ?", imageID, imageID, movieID).
+				Updates(map[string]any{"position": position, "updatedAt": time.Now().UTC()}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (m *MovieRepository) SetMovieCover(ctx context.Context, movieID, imageID uuid.UUID) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.MovieImage{}).Where("movieId = ? AND id <> ?", movieID, imageID).Update("isCover", false).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.MovieImage{}).Where("movieId = ? AND id = ?", movieID, imageID).Update("isCover", true).Error
+	})
+}
+
+// DeleteMovieImage removes the row for good once the file was deleted.
+func (m *MovieRepository) DeleteMovieImage(ctx context.Context, storageID uuid.UUID) error {
+	if err := m.db.WithContext(ctx).Unscoped().Where("storageId = ?", storageID).Delete(&domain.MovieImage{}).Error; err != nil {
 		return err
 	}
 
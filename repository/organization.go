@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type organizationRepository struct {
+	i  *do.Injector
+	db *gorm.DB
+}
+
+func NewOrganizationRepository(i *do.Injector) (domain.OrganizationRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (o *organizationRepository) Create(ctx context.Context, organization domain.Organization) error {
+	return o.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&organization).Error; err != nil {
+			return err
+		}
+
+		owner := domain.OrganizationMember{
+			ID:             uuid.New(),
+			OrganizationID: organization.ID,
+			UserID:         organization.OwnerID,
+			Role:           domain.OrganizationRoleOwner,
+			CreatedAt:      organization.CreatedAt,
+		}
+
+		return tx.Create(&owner).Error
+	})
+}
+
+func (o *organizationRepository) GetByID(ctx context.Context, organizationID uuid.UUID) (*domain.Organization, error) {
+	var organization domain.Organization
+	if err := o.db.WithContext(ctx).Where("id = ?", organizationID).First(&organization).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &organization, nil
+}
+
+func (o *organizationRepository) Update(ctx context.Context, organization domain.Organization) error {
+	if err := o.db.WithContext(ctx).Omit("Owner").Save(&organization).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *organizationRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.OrganizationMember, error) {
+	var members []*domain.OrganizationMember
+	if err := o.db.WithContext(ctx).
+		Where("userId = ?", userID).
+		Preload("Organization").
+		Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (o *organizationRepository) CreateMember(ctx context.Context, member domain.OrganizationMember) error {
+	if err := o.db.WithContext(ctx).Create(&member).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *organizationRepository) GetMember(ctx context.Context, organizationID, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	var member domain.OrganizationMember
+	if err := o.db.WithContext(ctx).
+		Where("organizationId = ? AND userId = ?", organizationID, userID).
+		First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+func (o *organizationRepository) GetMemberByID(ctx context.Context, memberID uuid.UUID) (*domain.OrganizationMember, error) {
+	var member domain.OrganizationMember
+	if err := o.db.WithContext(ctx).Where("id = ?", memberID).First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+func (o *organizationRepository) GetAllMembers(ctx context.Context, organizationID uuid.UUID) ([]*domain.OrganizationMember, error) {
+	var members []*domain.OrganizationMember
+	if err := o.db.WithContext(ctx).
+		Where("organizationId = ?", organizationID).
+		Preload("User").
+		Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (o *organizationRepository) DeleteMember(ctx context.Context, memberID uuid.UUID) error {
+	if err := o.db.WithContext(ctx).Where("id = ?", memberID).Delete(&domain.OrganizationMember{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type partnerRepository struct {
+	i  *do.Injector
+	db *gorm.DB
+}
+
+func NewPartnerRepository(i *do.Injector) (domain.PartnerRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	return &partnerRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (p *partnerRepository) GetUpcomingSessions(ctx context.Context, cinemaID uuid.UUID, from time.Time) ([]*domain.CinemaSession, error) {
+	var sessions []*domain.CinemaSession
+	if err := p.db.WithContext(ctx).
+		Preload("Movie.IndicativeRating").
+		Joins("JOIN CinemaRoom ON CinemaRoom.id = CinemaSession.cinemaRoomId").
+		Where("CinemaRoom.cinemaId = ?", cinemaID).
+		Where("CinemaSession.startTime > ?", from).
+		Where("CinemaSession.MovieId IN (?)", p.db.Model(&domain.Movie{}).Select("id")).
+		Order("CinemaSession.startTime ASC").
+		Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type privacyRepository struct {
+	i           *do.Injector
+	db          *gorm.DB
+	redisClient *redis.Client
+}
+
+func NewPrivacyRepository(i *do.Injector) (domain.PrivacyRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	redisClient, err := do.Invoke[*redis.Client](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize Redis client: %w", err)
+	}
+
+	return &privacyRepository{
+		i:           i,
+		db:          db,
+		redisClient: redisClient,
+	}, nil
+}
+
+func (p *privacyRepository) Create(ctx context.Context, request domain.PrivacyRequest) error {
+	if err := p.db.WithContext(ctx).Create(&request).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *privacyRepository) Update(ctx context.Context, request domain.PrivacyRequest) error {
+	if err := p.db.WithContext(ctx).Omit("User").Save(&request).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *privacyRepository) GetByID(ctx context.Context, requestID uuid.UUID) (*domain.PrivacyRequest, error) {
+	var request *domain.PrivacyRequest
+	if err := p.db.WithContext(ctx).Where("id = ?", requestID).First(&request).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (p *privacyRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.PrivacyRequest, error) {
+	var requests []*domain.PrivacyRequest
+	if err := p.db.WithContext(ctx).Where("userId = ?", userID).Order("createdAt DESC").Find(&requests).Error; err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (p *privacyRepository) GetOpenByUserID(ctx context.Context, userID uuid.UUID, requestType domain.PrivacyRequestType) (*domain.PrivacyRequest, error) {
+	var request *domain.PrivacyRequest
+	if err := p.db.WithContext(ctx).
+		Where("userId = ? AND type = ? AND status IN ?", userID, requestType, []domain.PrivacyRequestStatus{domain.PrivacyRequestStatusPending, domain.PrivacyRequestStatusProcessing}).
+		First(&request).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (p *privacyRepository) GetExpiredExports(ctx context.Context, now time.Time) ([]*domain.PrivacyRequest, error) {
+	var requests []*domain.PrivacyRequest
+	if err := p.db.WithContext(ctx).
+		Where("type = ? AND filePath IS NOT NULL AND filePath <> '' AND expiresAt < ?", domain.PrivacyRequestTypeExport, now).
+		Find(&requests).Error; err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (p *privacyRepository) GetTicketsByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.SeatReservation, error) {
+	var reservations []*domain.SeatReservation
+	if err := p.db.WithContext(ctx).Where("UserID = ? AND apiKeyId IS NULL", userID).Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// AnonymizeUser replaces the personal data of the user and removes the data
+// that only exists because of the account. Seat reservations are kept since
+// they are financial records, but lose the link to the dependents, which are
+// deleted for good.
+func (p *privacyRepository) AnonymizeUser(ctx context.Context, userID uuid.UUID, anonymizedAt time.Time) error {
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		updates := map[string]any{
+			"firstName":          "Deleted",
+			"lastName":           "User",
+			"email":              fmt.Sprintf("deleted+%s@anonymized.invalid", userID),
+			"birthDate":          nil,
+			"passwordHash":       "",
+			"emailVerifiedAt":    nil,
+			"twoFactorSecret":    nil,
+			"twoFactorEnabledAt": nil,
+			"anonymizedAt":       anonymizedAt,
+			"updatedAt":          anonymizedAt,
+		}
+
+		if err := tx.Model(&domain.User{}).Where("id = ?", userID).Updates(updates).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&domain.SeatReservation{}).Where("UserID = ? AND dependentId IS NOT NULL", userID).Update("dependentId", nil).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("userId = ?", userID).Delete(&domain.Dependent{}).Error; err != nil {
+			return err
+		}
+
+		for _, model := range []any{&domain.UserIdentity{}, &domain.UserRecoveryCode{}, &domain.OrganizationMember{}, &domain.CinemaStaff{}} {
+			if err := tx.Where("userId = ?", userID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		// Audit entries are kept for accountability, without the IP address.
+		if err := tx.Model(&domain.AuditLog{}).Where("actorId = ?", userID).Update("ipAddress", "").Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (p *privacyRepository) AddTaskToQueue(ctx context.Context, task domain.PrivacyTask) error {
+	data, err := jsoniter.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("error to serialize task for Redis. Error: %w", err)
+	}
+
+	if err := p.redisClient.RPush(ctx, p.getPrivacyQueueKey(), data).Err(); err != nil {
+		return fmt.Errorf("error to add task to Redis queue. Error: %w", err)
+	}
+
+	return nil
+}
+
+func (p *privacyRepository) GetNextTask(ctx context.Context) (*domain.PrivacyTask, error) {
+	data, err := p.redisClient.LPop(ctx, p.getPrivacyQueueKey()).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var task domain.PrivacyTask
+	if err := jsoniter.Unmarshal([]byte(data), &task); err != nil {
+		return nil, fmt.Errorf("error to deserialize task from Redis. error:%w", err)
+	}
+
+	return &task, nil
+}
+
+func (p *privacyRepository) getPrivacyQueueKey() string {
+	return "privacy_queue"
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// recordingConnector is a database/sql connector that accepts every
+// statement and keeps it, so repository tests can assert the SQL that ran
+// without a MySQL server.
+type recordingConnector struct {
+	mu         sync.Mutex
+	statements []string
+}
+
+func (r *recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{connector: r}, nil
+}
+
+func (r *recordingConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (r *recordingConnector) record(statement string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.statements = append(r.statements, statement)
+}
+
+type recordingConn struct {
+	connector *recordingConnector
+}
+
+func (r *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (r *recordingConn) Close() error {
+	return nil
+}
+
+func (r *recordingConn) Begin() (driver.Tx, error) {
+	r.connector.record("BEGIN")
+	return r, nil
+}
+
+func (r *recordingConn) Commit() error {
+	r.connector.record("COMMIT")
+	return nil
+}
+
+func (r *recordingConn) Rollback() error {
+	r.connector.record("ROLLBACK")
+	return nil
+}
+
+func (r *recordingConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	r.connector.record(query)
+	return driver.RowsAffected(1), nil
+}
+
+func (r *recordingConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	r.connector.record(query)
+	return emptyRows{}, nil
+}
+
+// emptyRows answers every query with no rows.
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string {
+	return nil
+}
+
+func (emptyRows) Close() error {
+	return nil
+}
+
+func (emptyRows) Next([]driver.Value) error {
+	return io.EOF
+}
+
+func newRecordingDB(t *testing.T) (*gorm.DB, *recordingConnector) {
+	connector := &recordingConnector{}
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sql.OpenDB(connector),
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+
+	return db, connector
+}
+
+func TestPrivacyRepository_AnonymizeUser_ShouldUnlinkAndDeleteDependentsInTransaction(t *testing.T) {
+	db, connector := newRecordingDB(t)
+	repository := &privacyRepository{db: db}
+
+	err := repository.AnonymizeUser(context.Background(), uuid.New(), time.Now().UTC())
+
+	require.NoError(t, err)
+	require.NotEmpty(t, connector.statements)
+	assert.Equal(t, "BEGIN", connector.statements[0])
+	assert.Equal(t, "COMMIT", connector.statements[len(connector.statements)-1])
+
+	var unlinksDependents, deletesDependents bool
+	for _, statement := range connector.statements {
+		if strings.HasPrefix(statement, "UPDATE `SeatReservation` SET `dependentId`=?") && strings.Contains(statement, "WHERE UserID = ?") {
+			unlinksDependents = true
+		}
+
+		if strings.HasPrefix(statement, "DELETE FROM `Dependent` WHERE userId = ?") {
+			deletesDependents = true
+		}
+	}
+
+	assert.True(t, unlinksDependents, "seat reservations should lose their dependent: %v", connector.statements)
+	assert.True(t, deletesDependents, "dependents should be deleted for good: %v", connector.statements)
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/go-redis/redis/v8"
+	"github.com/samber/do"
+)
+
+type rateLimiter struct {
+	i           *do.Injector
+	redisClient *redis.Client
+}
+
+func NewRateLimiter(i *do.Injector) (domain.RateLimiter, error) {
+	redisClient, err := do.Invoke[*redis.Client](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize Redis client: %w", err)
+	}
+
+	return &rateLimiter{
+		i:           i,
+		redisClient: redisClient,
+	}, nil
+}
+
+func (r *rateLimiter) Allow(ctx context.Context, key string, limit int64, window time.Duration) error {
+	rateLimitKey := r.getRateLimitKey(key)
+
+	count, err := r.redisClient.Incr(ctx, rateLimitKey).Result()
+	if err != nil {
+		return err
+	}
+
+	if count == 1 {
+		if err := r.redisClient.Expire(ctx, rateLimitKey, window).Err(); err != nil {
+			return err
+		}
+	}
+
+	if count <= limit {
+		return nil
+	}
+
+	retryAfter, err := r.redisClient.TTL(ctx, rateLimitKey).Result()
+	if err != nil {
+		return err
+	}
+
+	if retryAfter < 0 {
+		if err := r.redisClient.Expire(ctx, rateLimitKey, window).Err(); err != nil {
+			return err
+		}
+		retryAfter = window
+	}
+
+	return &domain.RateLimitError{RetryAfter: retryAfter}
+}
+
+func (r *rateLimiter) getRateLimitKey(key string) string {
+	return fmt.Sprintf("rate_limit_%s", key)
+}
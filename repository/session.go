@@ -44,15 +44,23 @@ func (s *sessionRepository) Create(ctx context.Context, session domain.Session)
 		return err
 	}
 
-	if err := s.redisClient.Set(ctx, s.getSessionKey(session.UserID.String()), sessionJSON, time.Duration(config.Env.SessionExp)*time.Hour).Err(); err != nil {
+	expiration := time.Duration(config.Env.SessionExp) * time.Hour
+	userSessionsKey := s.getUserSessionsKey(session.UserID.String())
+
+	pipe := s.redisClient.TxPipeline()
+	pipe.Set(ctx, s.getSessionKey(session.UserID.String(), session.ID.String()), sessionJSON, expiration)
+	pipe.SAdd(ctx, userSessionsKey, session.ID.String())
+	pipe.Expire(ctx, userSessionsKey, expiration)
+
+	if _, err := pipe.Exec(ctx); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (s *sessionRepository) GetSession(ctx context.Context, userID uuid.UUID) (*domain.Session, error) {
-	sessionJSON, err := s.redisClient.Get(ctx, s.getSessionKey(userID.String())).Result()
+func (s *sessionRepository) GetSession(ctx context.Context, userID, sessionID uuid.UUID) (*domain.Session, error) {
+	sessionJSON, err := s.redisClient.Get(ctx, s.getSessionKey(userID.String(), sessionID.String())).Result()
 	if err != nil {
 		if err == redis.Nil {
 			return nil, nil
@@ -68,6 +76,173 @@ func (s *sessionRepository) GetSession(ctx context.Context, userID uuid.UUID) (*
 	return &session, nil
 }
 
-func (s *sessionRepository) getSessionKey(userID string) string {
-	return fmt.Sprintf("session_%s", userID)
+func (s *sessionRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error) {
+	userSessionsKey := s.getUserSessionsKey(userID.String())
+
+	sessionIDs, err := s.redisClient.SMembers(ctx, userSessionsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sessionIDs) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, s.getSessionKey(userID.String(), sessionID))
+	}
+
+	values, err := s.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*domain.Session
+	var expiredSessionIDs []any
+	for index, value := range values {
+		sessionJSON, ok := value.(string)
+		if !ok {
+			expiredSessionIDs = append(expiredSessionIDs, sessionIDs[index])
+			continue
+		}
+
+		var session domain.Session
+		if err := jsoniter.UnmarshalFromString(sessionJSON, &session); err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, &session)
+	}
+
+	if len(expiredSessionIDs) > 0 {
+		if err := s.redisClient.SRem(ctx, userSessionsKey, expiredSessionIDs...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sessions, nil
+}
+
+func (s *sessionRepository) Update(ctx context.Context, session domain.Session) error {
+	sessionJSON, err := jsoniter.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	if err := s.redisClient.SetXX(ctx, s.getSessionKey(session.UserID.String(), session.ID.String()), sessionJSON, redis.KeepTTL).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) Delete(ctx context.Context, userID, sessionID uuid.UUID) error {
+	pipe := s.redisClient.TxPipeline()
+	pipe.Del(ctx, s.getSessionKey(userID.String(), sessionID.String()))
+	pipe.SRem(ctx, s.getUserSessionsKey(userID.String()), sessionID.String())
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	userSessionsKey := s.getUserSessionsKey(userID.String())
+
+	sessionIDs, err := s.redisClient.SMembers(ctx, userSessionsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := []string{userSessionsKey}
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, s.getSessionKey(userID.String(), sessionID))
+	}
+
+	if err := s.redisClient.Del(ctx, keys...).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) SetRevokedBefore(ctx context.Context, userID uuid.UUID, revokedBefore time.Time) error {
+	expiration := time.Duration(config.Env.SessionExp) * time.Hour
+	if err := s.redisClient.Set(ctx, s.getRevokedBeforeKey(userID.String()), revokedBefore.Unix(), expiration).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) GetRevokedBefore(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	timestamp, err := s.redisClient.Get(ctx, s.getRevokedBeforeKey(userID.String())).Int64()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	revokedBefore := time.Unix(timestamp, 0).UTC()
+	return &revokedBefore, nil
+}
+
+func (s *sessionRepository) CreateRefreshToken(ctx context.Context, tokenHash string, refreshToken domain.RefreshToken) error {
+	refreshTokenJSON, err := jsoniter.Marshal(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	expiration := time.Duration(config.Env.SessionExp) * time.Hour
+	if err := s.redisClient.Set(ctx, s.getRefreshTokenKey(tokenHash), refreshTokenJSON, expiration).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	refreshTokenJSON, err := s.redisClient.Get(ctx, s.getRefreshTokenKey(tokenHash)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var refreshToken domain.RefreshToken
+	if err := jsoniter.UnmarshalFromString(refreshTokenJSON, &refreshToken); err != nil {
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
+
+func (s *sessionRepository) ConsumeRefreshToken(ctx context.Context, tokenHash string) (bool, error) {
+	expiration := time.Duration(config.Env.SessionExp) * time.Hour
+	return s.redisClient.SetNX(ctx, s.getRefreshTokenUsedKey(tokenHash), time.Now().UTC().Unix(), expiration).Result()
+}
+
+func (s *sessionRepository) getSessionKey(userID, sessionID string) string {
+	return fmt.Sprintf("session_%s_%s", userID, sessionID)
+}
+
+func (s *sessionRepository) getUserSessionsKey(userID string) string {
+	return fmt.Sprintf("sessions_%s", userID)
+}
+
+func (s *sessionRepository) getRevokedBeforeKey(userID string) string {
+	return fmt.Sprintf("session_revoked_before_%s", userID)
+}
+
+func (s *sessionRepository) getRefreshTokenKey(tokenHash string) string {
+	return fmt.Sprintf("refresh_token_%s", tokenHash)
+}
+
+func (s *sessionRepository) getRefreshTokenUsedKey(tokenHash string) string {
+	return fmt.Sprintf("refresh_token_used_%s", tokenHash)
 }
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type ticketRepository struct {
+	i  *do.Injector
+	db *gorm.DB
+}
+
+func NewTicketRepository(i *do.Injector) (domain.TicketRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	return &ticketRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (t *ticketRepository) GetCinemaSessionByID(ctx context.Context, cinemaSessionID uuid.UUID) (*domain.CinemaSession, error) {
+	var cinemaSession domain.CinemaSession
+	if err := t.db.WithContext(ctx).
+		Preload("Movie.IndicativeRating").
+		Preload("CinemaRoom.Cinema").
+		Where("id = ?", cinemaSessionID).
+		Where("MovieId IN (?)", t.db.Model(&domain.Movie{}).Select("id")).
+		First(&cinemaSession).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &cinemaSession, nil
+}
+
+func (t *ticketRepository) Create(ctx context.Context, reservation domain.SeatReservation) error {
+	if err := t.db.WithContext(ctx).Omit("CinemaSession", "Seat", "User", "Dependent", "APIKey").Create(&reservation).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return domain.ErrSeatUnavailable
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (t *ticketRepository) GetByID(ctx context.Context, ticketID uuid.UUID) (*domain.SeatReservation, error) {
+	var reservation domain.SeatReservation
+	if err := t.preload(ctx).Where("id = ?", ticketID).First(&reservation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+func (t *ticketRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.SeatReservation, error) {
+	var reservations []*domain.SeatReservation
+	if err := t.preload(ctx).Where("UserID = ? AND apiKeyId IS NULL", userID).Order("createdAt DESC").Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+func (t *ticketRepository) CheckIn(ctx context.Context, ticketID, staffUserID uuid.UUID, checkedInAt time.Time) (bool, error) {
+	result := t.db.WithContext(ctx).
+		Model(&domain.SeatReservation{}).
+		Where("id = ? AND checkedInAt IS NULL", ticketID).
+		Updates(map[string]any{
+			"checkedInAt": checkedInAt,
+			"checkedInBy": staffUserID,
+			"updatedAt":   checkedInAt,
+		})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (t *ticketRepository) preload(ctx context.Context) *gorm.DB {
+	return t.db.WithContext(ctx).
+		Preload("CinemaSession.Movie", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
+		Preload("CinemaSession.Movie.IndicativeRating").
+		Preload("CinemaSession.CinemaRoom").
+		Preload("Seat").
+		Preload("User").
+		Preload("Dependent", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		})
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+const totpStepRetention = 5 * time.Minute
+
+type twoFactorRepository struct {
+	i           *do.Injector
+	db          *gorm.DB
+	redisClient *redis.Client
+}
+
+func NewTwoFactorRepository(i *do.Injector) (domain.TwoFactorRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	redisClient, err := do.Invoke[*redis.Client](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize Redis client: %w", err)
+	}
+
+	return &twoFactorRepository{
+		i:           i,
+		db:          db,
+		redisClient: redisClient,
+	}, nil
+}
+
+func (t *twoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, recoveryCodes []domain.UserRecoveryCode) error {
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("userId = ?", userID).Delete(&domain.UserRecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&recoveryCodes).Error
+	})
+}
+
+func (t *twoFactorRepository) DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	if err := t.db.WithContext(ctx).Where("userId = ?", userID).Delete(&domain.UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (t *twoFactorRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	result := t.db.WithContext(ctx).
+		Model(&domain.UserRecoveryCode{}).
+		Where("userId = ? AND codeHash = ? AND usedAt IS NULL", userID, codeHash).
+		Update("usedAt", time.Now().UTC())
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (t *twoFactorRepository) MarkCodeStepUsed(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	return t.redisClient.SetNX(ctx, t.getCodeStepKey(userID.String(), step), time.Now().UTC().Unix(), totpStepRetention).Result()
+}
+
+func (t *twoFactorRepository) getCodeStepKey(userID string, step int64) string {
+	return fmt.Sprintf("two_factor_step_%s_%d", userID, step)
+}
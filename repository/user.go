@@ -3,9 +3,13 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/GSVillas/movie-pass-api/config"
 	"github.com/GSVillas/movie-pass-api/domain"
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/samber/do"
 	"gorm.io/gorm"
 )
@@ -42,6 +46,19 @@ func (u *userRepository) Create(ctx context.Context, user domain.User) error {
 	return nil
 }
 
+func (u *userRepository) GetByID(ctx context.Context, ID uuid.UUID) (*domain.User, error) {
+	var user *domain.User
+	if err := u.db.WithContext(ctx).Where("id = ?", ID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (u *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user *domain.User
 	if err := u.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
@@ -54,3 +71,102 @@ func (u *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 
 	return user, nil
 }
+
+func (u *userRepository) UpdatePassword(ctx context.Context, ID uuid.UUID, passwordHash string) error {
+	updates := map[string]any{
+		"passwordHash": passwordHash,
+		"updatedAt":    time.Now().UTC(),
+	}
+
+	if err := u.db.WithContext(ctx).Model(&domain.User{}).Where("id = ?", ID).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userRepository) UpdateProfile(ctx context.Context, ID uuid.UUID, firstName, lastName string, birthDate *time.Time) error {
+	updates := map[string]any{
+		"firstName": firstName,
+		"lastName":  lastName,
+		"birthDate": birthDate,
+		"updatedAt": time.Now().UTC(),
+	}
+
+	if err := u.db.WithContext(ctx).Model(&domain.User{}).Where("id = ?", ID).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userRepository) UpdateEmail(ctx context.Context, ID uuid.UUID, email string, verifiedAt time.Time) error {
+	updates := map[string]any{
+		"email":           email,
+		"emailVerifiedAt": verifiedAt,
+		"updatedAt":       time.Now().UTC(),
+	}
+
+	if err := u.db.WithContext(ctx).Model(&domain.User{}).Where("id = ?", ID).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userRepository) MarkEmailVerified(ctx context.Context, ID uuid.UUID, verifiedAt time.Time) error {
+	updates := map[string]any{
+		"emailVerifiedAt": verifiedAt,
+		"updatedAt":       time.Now().UTC(),
+	}
+
+	if err := u.db.WithContext(ctx).Model(&domain.User{}).Where("id = ? AND emailVerifiedAt IS NULL", ID).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userRepository) UpdateTwoFactor(ctx context.Context, ID uuid.UUID, secret string, enabledAt *time.Time) error {
+	updates := map[string]any{
+		"twoFactorSecret":    secret,
+		"twoFactorEnabledAt": enabledAt,
+		"updatedAt":          time.Now().UTC(),
+	}
+
+	if err := u.db.WithContext(ctx).Model(&domain.User{}).Where("id = ?", ID).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userRepository) CreatePasswordResetToken(ctx context.Context, tokenHash string, userID uuid.UUID) error {
+	expiration := time.Duration(config.Env.PasswordResetExp) * time.Minute
+	if err := u.redisClient.Set(ctx, u.getPasswordResetKey(tokenHash), userID.String(), expiration).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userRepository) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (*uuid.UUID, error) {
+	value, err := u.redisClient.GetDel(ctx, u.getPasswordResetKey(tokenHash)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userID, nil
+}
+
+func (u *userRepository) getPasswordResetKey(tokenHash string) string {
+	return fmt.Sprintf("password_reset_%s", tokenHash)
+}
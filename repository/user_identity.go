@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+const oidcStateExp = 10 * time.Minute
+
+type userIdentityRepository struct {
+	i           *do.Injector
+	db          *gorm.DB
+	redisClient *redis.Client
+}
+
+func NewUserIdentityRepository(i *do.Injector) (domain.UserIdentityRepository, error) {
+	db, err := do.Invoke[*gorm.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DB connection: %w", err)
+	}
+
+	redisClient, err := do.Invoke[*redis.Client](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize Redis client: %w", err)
+	}
+
+	return &userIdentityRepository{
+		i:           i,
+		db:          db,
+		redisClient: redisClient,
+	}, nil
+}
+
+func (u *userIdentityRepository) Create(ctx context.Context, identity domain.UserIdentity) error {
+	if err := u.db.WithContext(ctx).Create(&identity).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userIdentityRepository) CreateWithUser(ctx context.Context, user domain.User, identity domain.UserIdentity) error {
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&identity).Error
+	})
+}
+
+func (u *userIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	var identity *domain.UserIdentity
+	if err := u.db.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func (u *userIdentityRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.UserIdentity, error) {
+	var identities []*domain.UserIdentity
+	if err := u.db.WithContext(ctx).Where("userId = ?", userID).Find(&identities).Error; err != nil {
+		return nil, err
+	}
+
+	return identities, nil
+}
+
+func (u *userIdentityRepository) CreateState(ctx context.Context, state string, oidcState domain.OIDCState) error {
+	data, err := jsoniter.Marshal(oidcState)
+	if err != nil {
+		return err
+	}
+
+	if err := u.redisClient.Set(ctx, u.getStateKey(state), data, oidcStateExp).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userIdentityRepository) ConsumeState(ctx context.Context, state string) (*domain.OIDCState, error) {
+	data, err := u.redisClient.GetDel(ctx, u.getStateKey(state)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var oidcState domain.OIDCState
+	if err := jsoniter.UnmarshalFromString(data, &oidcState); err != nil {
+		return nil, err
+	}
+
+	return &oidcState, nil
+}
+
+func (u *userIdentityRepository) getStateKey(state string) string {
+	return fmt.Sprintf("oidc_state_%s", state)
+}
@@ -0,0 +1,47 @@
+package secure
+
+import (
+	"fmt"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/golang-jwt/jwt"
+)
+
+func SignToken(claims jwt.Claims) (string, error) {
+	signingKey := config.Env.KeyRing.Active()
+	if signingKey == nil || signingKey.PrivateKey == nil {
+		return "", domain.ErrSigningKeyNotFound
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = signingKey.KID
+
+	return token.SignedString(signingKey.PrivateKey)
+}
+
+func ParseToken(tokenString string, claims jwt.Claims) error {
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
+			return nil, domain.ErrorUnexpectedMethod
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		signingKey := config.Env.KeyRing.Get(kid)
+		if signingKey == nil {
+			return nil, domain.ErrSigningKeyNotFound
+		}
+
+		return signingKey.PublicKey, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrTokenInvalid, err.Error())
+	}
+
+	if !token.Valid {
+		return domain.ErrTokenInvalid
+	}
+
+	return nil
+}
@@ -1,11 +1,246 @@
 package secure
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrPasswordMismatch        = errors.New("password does not match hash")
+	ErrUnsupportedPasswordHash = errors.New("unsupported password hash format")
+)
+
+const (
+	defaultArgon2Memory      = 64 * 1024
+	defaultArgon2Iterations  = 3
+	defaultArgon2Parallelism = 2
+	argon2SaltLength         = 16
+	argon2KeyLength          = 32
+)
+
+// PasswordHasher produces self describing hashes, so hashes created by older
+// algorithms or parameters keep working next to the current ones.
+type PasswordHasher interface {
+	// Match reports whether the encoded hash was produced by this hasher.
+	Match(encodedHash string) bool
+	Hash(password string) (string, error)
+	Verify(encodedHash, password string) error
+	// NeedsRehash reports whether the hash uses weaker parameters than the
+	// ones currently configured.
+	NeedsRehash(encodedHash string) bool
+}
+
+var (
+	passwordHashersOnce sync.Once
+	passwordHashers     []PasswordHasher
+	defaultHasher       PasswordHasher
+
+	dummyPasswordHash     string
+	dummyPasswordHashOnce sync.Once
+)
+
+func loadPasswordHashers() {
+	passwordHashersOnce.Do(func() {
+		defaultHasher = NewArgon2idHasher(config.Env.Argon2Memory, config.Env.Argon2Iterations, config.Env.Argon2Parallelism)
+		passwordHashers = []PasswordHasher{defaultHasher, bcryptHasher{}}
+	})
+}
 
 func HashPassword(password string) ([]byte, error) {
-	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	loadPasswordHashers()
+
+	hash, err := defaultHasher.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(hash), nil
 }
 
 func CheckPassword(hashedPassword, password string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	hasher, err := findPasswordHasher(hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	return hasher.Verify(hashedPassword, password)
+}
+
+// PasswordNeedsRehash reports whether a hash that was just verified should be
+// replaced by one produced with the default hasher.
+func PasswordNeedsRehash(hashedPassword string) bool {
+	hasher, err := findPasswordHasher(hashedPassword)
+	if err != nil {
+		return false
+	}
+
+	return hasher != defaultHasher || hasher.NeedsRehash(hashedPassword)
+}
+
+// CheckDummyPassword spends the same time as CheckPassword so that unknown
+// accounts cannot be told apart from wrong passwords by response time.
+func CheckDummyPassword(password string) {
+	loadPasswordHashers()
+
+	dummyPasswordHashOnce.Do(func() {
+		dummyPasswordHash, _ = defaultHasher.Hash("movie-pass-dummy-password")
+	})
+
+	_ = defaultHasher.Verify(dummyPasswordHash, password)
+}
+
+func findPasswordHasher(hashedPassword string) (PasswordHasher, error) {
+	loadPasswordHashers()
+
+	for _, hasher := range passwordHashers {
+		if hasher.Match(hashedPassword) {
+			return hasher, nil
+		}
+	}
+
+	return nil, ErrUnsupportedPasswordHash
+}
+
+// argon2idHasher encodes hashes in the PHC string format:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+type argon2idHasher struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+func NewArgon2idHasher(memory, iterations, parallelism int) PasswordHasher {
+	if memory <= 0 {
+		memory = defaultArgon2Memory
+	}
+
+	if iterations <= 0 {
+		iterations = defaultArgon2Iterations
+	}
+
+	if parallelism <= 0 {
+		parallelism = defaultArgon2Parallelism
+	}
+
+	return &argon2idHasher{
+		memory:      uint32(memory),
+		iterations:  uint32(iterations),
+		parallelism: uint8(parallelism),
+	}
+}
+
+func (a *argon2idHasher) Match(encodedHash string) bool {
+	return strings.HasPrefix(encodedHash, "$argon2id$")
+}
+
+func (a *argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, a.iterations, a.memory, a.parallelism, argon2KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		a.memory,
+		a.iterations,
+		a.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a *argon2idHasher) Verify(encodedHash, password string) error {
+	params, salt, key, err := a.decode(encodedHash)
+	if err != nil {
+		return err
+	}
+
+	candidate := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(candidate, key) != 1 {
+		return ErrPasswordMismatch
+	}
+
+	return nil
+}
+
+func (a *argon2idHasher) NeedsRehash(encodedHash string) bool {
+	params, _, _, err := a.decode(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return params.memory < a.memory || params.iterations < a.iterations || params.parallelism < a.parallelism
+}
+
+func (a *argon2idHasher) decode(encodedHash string) (*argon2idHasher, []byte, []byte, error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 {
+		return nil, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	var params argon2idHasher
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return nil, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	return &params, salt, key, nil
+}
+
+// bcryptHasher only verifies legacy hashes. Passwords are never hashed with
+// it anymore because bcrypt ignores everything after 72 bytes.
+type bcryptHasher struct{}
+
+func (bcryptHasher) Match(encodedHash string) bool {
+	return strings.HasPrefix(encodedHash, "$2a$") || strings.HasPrefix(encodedHash, "$2b$") || strings.HasPrefix(encodedHash, "$2y$")
+}
+
+func (bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+func (bcryptHasher) Verify(encodedHash, password string) error {
+	if err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password)); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return ErrPasswordMismatch
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (bcryptHasher) NeedsRehash(string) bool {
+	return true
 }
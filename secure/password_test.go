@@ -0,0 +1,109 @@
+package secure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestArgon2idHasher_Verify_WhenPasswordMatches_ShouldReturnNil(t *testing.T) {
+	hasher := NewArgon2idHasher(1024, 1, 1)
+
+	hash, err := hasher.Hash("Str0ngP@ssw0rd!")
+	require.NoError(t, err)
+
+	assert.True(t, hasher.Match(hash))
+	assert.Regexp(t, `^\$argon2id\$v=19\$m=1024,t=1,p=1\$[A-Za-z0-9+/]+\$[A-Za-z0-9+/]+$`, hash)
+	assert.NoError(t, hasher.Verify(hash, "Str0ngP@ssw0rd!"))
+	assert.ErrorIs(t, hasher.Verify(hash, "Str0ngP@ssw0rd?"), ErrPasswordMismatch)
+}
+
+func TestArgon2idHasher_Hash_ShouldUseRandomSalt(t *testing.T) {
+	hasher := NewArgon2idHasher(1024, 1, 1)
+
+	first, err := hasher.Hash("Str0ngP@ssw0rd!")
+	require.NoError(t, err)
+	second, err := hasher.Hash("Str0ngP@ssw0rd!")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestArgon2idHasher_Verify_WhenHashIsMalformed_ShouldReturnErrUnsupportedPasswordHash(t *testing.T) {
+	hasher := NewArgon2idHasher(1024, 1, 1)
+	hash, err := hasher.Hash("Str0ngP@ssw0rd!")
+	require.NoError(t, err)
+
+	malformed := map[string]string{
+		"missing parts":     "$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA",
+		"unknown version":   "$argon2id$v=16$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5",
+		"invalid params":    "$argon2id$v=19$m=abc,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5",
+		"invalid salt":      "$argon2id$v=19$m=1024,t=1,p=1$not base64!$a2V5",
+		"invalid key":       "$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$not base64!",
+		"empty key":         "$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$",
+		"padded encoding":   hash + "==",
+		"trailing sections": hash + "$extra",
+	}
+
+	for name, encodedHash := range malformed {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, hasher.Verify(encodedHash, "Str0ngP@ssw0rd!"), ErrUnsupportedPasswordHash)
+			assert.True(t, hasher.NeedsRehash(encodedHash))
+		})
+	}
+}
+
+func TestArgon2idHasher_NeedsRehash_WhenParamsChange_ShouldCompareWithCurrentParams(t *testing.T) {
+	weak := NewArgon2idHasher(1024, 1, 1)
+	hash, err := weak.Hash("Str0ngP@ssw0rd!")
+	require.NoError(t, err)
+
+	assert.False(t, weak.NeedsRehash(hash))
+	assert.True(t, NewArgon2idHasher(2048, 1, 1).NeedsRehash(hash))
+	assert.True(t, NewArgon2idHasher(1024, 2, 1).NeedsRehash(hash))
+	assert.True(t, NewArgon2idHasher(1024, 1, 2).NeedsRehash(hash))
+	assert.False(t, NewArgon2idHasher(512, 1, 1).NeedsRehash(hash))
+}
+
+func TestCheckPassword_WhenHashIsLegacyBcrypt_ShouldVerifyIt(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("Str0ngP@ssw0rd!"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	assert.NoError(t, CheckPassword(string(hash), "Str0ngP@ssw0rd!"))
+	assert.ErrorIs(t, CheckPassword(string(hash), "Str0ngP@ssw0rd?"), ErrPasswordMismatch)
+}
+
+func TestCheckPassword_WhenHashFormatIsUnknown_ShouldReturnErrUnsupportedPasswordHash(t *testing.T) {
+	assert.ErrorIs(t, CheckPassword("$pbkdf2$whatever", "Str0ngP@ssw0rd!"), ErrUnsupportedPasswordHash)
+	assert.ErrorIs(t, CheckPassword("", "Str0ngP@ssw0rd!"), ErrUnsupportedPasswordHash)
+}
+
+func TestHashPassword_ShouldProduceArgon2idHashVerifiedByCheckPassword(t *testing.T) {
+	hash, err := HashPassword("Str0ngP@ssw0rd!")
+	require.NoError(t, err)
+
+	assert.Regexp(t, `^\$argon2id\$`, string(hash))
+	assert.NoError(t, CheckPassword(string(hash), "Str0ngP@ssw0rd!"))
+	assert.ErrorIs(t, CheckPassword(string(hash), "wrong"), ErrPasswordMismatch)
+	assert.False(t, PasswordNeedsRehash(string(hash)))
+}
+
+func TestPasswordNeedsRehash_WhenHashIsLegacyBcrypt_ShouldReturnTrue(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("Str0ngP@ssw0rd!"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	assert.True(t, PasswordNeedsRehash(string(hash)))
+}
+
+func TestPasswordNeedsRehash_WhenArgon2ParamsAreWeakerThanConfigured_ShouldReturnTrue(t *testing.T) {
+	hash, err := NewArgon2idHasher(1024, 1, 1).Hash("Str0ngP@ssw0rd!")
+	require.NoError(t, err)
+
+	assert.True(t, PasswordNeedsRehash(hash))
+}
+
+func TestPasswordNeedsRehash_WhenHashFormatIsUnknown_ShouldReturnFalse(t *testing.T) {
+	assert.False(t, PasswordNeedsRehash("$pbkdf2$whatever"))
+}
@@ -0,0 +1,99 @@
+package secure
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc6238Secret is the SHA1 seed of the RFC 6238 test vectors.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestGenerateTOTPCode_ShouldMatchRFC6238Vectors(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range vectors {
+		code, err := GenerateTOTPCode(rfc6238Secret, time.Unix(unix, 0))
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, "time %d", unix)
+	}
+}
+
+func TestGenerateTOTPCode_WhenSecretIsInvalid_ShouldReturnError(t *testing.T) {
+	code, err := GenerateTOTPCode("not base32!", time.Now())
+
+	assert.Error(t, err)
+	assert.Empty(t, code)
+}
+
+func TestValidateTOTPCode_WhenCodeIsFromAdjacentStep_ShouldReturnMatchedStep(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	currentStep := now.Unix() / totpPeriod
+
+	for _, offset := range []int64{-1, 0, 1} {
+		code, err := GenerateTOTPCode(rfc6238Secret, now.Add(time.Duration(offset*totpPeriod)*time.Second))
+		require.NoError(t, err)
+
+		step, ok := ValidateTOTPCode(rfc6238Secret, code, now)
+
+		assert.True(t, ok, "offset %d", offset)
+		assert.Equal(t, currentStep+offset, step)
+	}
+}
+
+func TestValidateTOTPCode_WhenCodeIsOutsideSkew_ShouldReturnFalse(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code, err := GenerateTOTPCode(rfc6238Secret, now.Add(2*totpPeriod*time.Second))
+	require.NoError(t, err)
+
+	step, ok := ValidateTOTPCode(rfc6238Secret, code, now)
+
+	assert.False(t, ok)
+	assert.Zero(t, step)
+}
+
+func TestValidateTOTPCode_WhenSecretIsInvalid_ShouldReturnFalse(t *testing.T) {
+	_, ok := ValidateTOTPCode("not base32!", "123456", time.Now())
+
+	assert.False(t, ok)
+}
+
+func TestGenerateTOTPSecret_ShouldReturnUnpaddedBase32OfSecretSize(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, totpSecretSize)
+	assert.NotContains(t, secret, "=")
+
+	other, err := GenerateTOTPSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestTOTPURI_ShouldDescribeTheSecretForAuthenticatorApps(t *testing.T) {
+	uri := TOTPURI("Movie Pass", "user@example.com", rfc6238Secret)
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/Movie Pass:user@example.com", parsed.Path)
+	assert.Equal(t, rfc6238Secret, parsed.Query().Get("secret"))
+	assert.Equal(t, "Movie Pass", parsed.Query().Get("issuer"))
+	assert.Equal(t, "SHA1", parsed.Query().Get("algorithm"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+}
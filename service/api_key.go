@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+const (
+	apiKeyPrefix        = "mpk_"
+	apiKeySize          = 32
+	apiKeyDisplayLength = 12
+	// lastUsedResolution avoids a write on every request of busy partners.
+	lastUsedResolution = time.Minute
+)
+
+type apiKeyService struct {
+	i                    *do.Injector
+	apiKeyRepository     domain.APIKeyRepository
+	authorizationService domain.AuthorizationService
+	auditService         domain.AuditService
+}
+
+func NewAPIKeyService(i *do.Injector) (domain.APIKeyService, error) {
+	apiKeyRepository, err := do.Invoke[domain.APIKeyRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize APIKeyRepository: %w", err)
+	}
+
+	authorizationService, err := do.Invoke[domain.AuthorizationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuthorizationService: %w", err)
+	}
+
+	auditService, err := do.Invoke[domain.AuditService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuditService: %w", err)
+	}
+
+	return &apiKeyService{
+		i:                    i,
+		apiKeyRepository:     apiKeyRepository,
+		authorizationService: authorizationService,
+		auditService:         auditService,
+	}, nil
+}
+
+func (a *apiKeyService) Create(ctx context.Context, organizationID uuid.UUID, payload domain.APIKeyPayload) (*domain.APIKeySecretResponse, error) {
+	if err := a.authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionAPIKeyManage); err != nil {
+		return nil, err
+	}
+
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	key, err := a.generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := domain.APIKey{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		CreatedByID:    session.UserID,
+		Name:           payload.Name,
+		Prefix:         key[:apiKeyDisplayLength],
+		KeyHash:        secure.HashToken(key),
+		CreatedAt:      time.Now().UTC(),
+	}
+	apiKey.SetScopes(payload.Scopes)
+
+	if err := a.apiKeyRepository.Create(ctx, apiKey); err != nil {
+		return nil, fmt.Errorf("error to create api key for organization ID %s: %w", organizationID, err)
+	}
+
+	response := apiKey.ToAPIKeyResponse()
+	a.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &organizationID,
+		Action:         domain.AuditActionAPIKeyCreate,
+		TargetType:     domain.AuditTargetAPIKey,
+		TargetID:       apiKey.ID,
+		After:          response,
+	})
+
+	return &domain.APIKeySecretResponse{
+		APIKeyResponse: *response,
+		Key:            key,
+	}, nil
+}
+
+func (a *apiKeyService) GetAll(ctx context.Context, organizationID uuid.UUID) ([]*domain.APIKeyResponse, error) {
+	if err := a.authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionAPIKeyManage); err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := a.apiKeyRepository.GetAllByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("error to fetch api keys of organization ID %s: %w", organizationID, err)
+	}
+
+	response := make([]*domain.APIKeyResponse, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		response = append(response, apiKey.ToAPIKeyResponse())
+	}
+
+	return response, nil
+}
+
+func (a *apiKeyService) Revoke(ctx context.Context, organizationID, apiKeyID uuid.UUID) error {
+	apiKey, err := a.getOrganizationKey(ctx, organizationID, apiKeyID)
+	if err != nil {
+		return err
+	}
+
+	before := apiKey.ToAPIKeyResponse()
+	now := time.Now().UTC()
+	apiKey.RevokedAt = &now
+	apiKey.UpdatedAt = now
+
+	if err := a.apiKeyRepository.Update(ctx, *apiKey); err != nil {
+		return fmt.Errorf("error to revoke api key ID %s: %w", apiKeyID, err)
+	}
+
+	a.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &organizationID,
+		Action:         domain.AuditActionAPIKeyRevoke,
+		TargetType:     domain.AuditTargetAPIKey,
+		TargetID:       apiKeyID,
+		Before:         before,
+		After:          apiKey.ToAPIKeyResponse(),
+	})
+
+	return nil
+}
+
+// Rotate replaces the secret of the key keeping its name and scopes. The
+// previous secret stops working immediately.
+func (a *apiKeyService) Rotate(ctx context.Context, organizationID, apiKeyID uuid.UUID) (*domain.APIKeySecretResponse, error) {
+	apiKey, err := a.getOrganizationKey(ctx, organizationID, apiKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := a.generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	before := apiKey.ToAPIKeyResponse()
+	apiKey.Prefix = key[:apiKeyDisplayLength]
+	apiKey.KeyHash = secure.HashToken(key)
+	apiKey.LastUsedAt = nil
+	apiKey.UpdatedAt = time.Now().UTC()
+
+	if err := a.apiKeyRepository.Update(ctx, *apiKey); err != nil {
+		return nil, fmt.Errorf("error to rotate api key ID %s: %w", apiKeyID, err)
+	}
+
+	response := apiKey.ToAPIKeyResponse()
+	a.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &organizationID,
+		Action:         domain.AuditActionAPIKeyRotate,
+		TargetType:     domain.AuditTargetAPIKey,
+		TargetID:       apiKeyID,
+		Before:         before,
+		After:          response,
+	})
+
+	return &domain.APIKeySecretResponse{
+		APIKeyResponse: *response,
+		Key:            key,
+	}, nil
+}
+
+func (a *apiKeyService) Authenticate(ctx context.Context, key string) (*domain.APIKey, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, domain.ErrAPIKeyInvalid
+	}
+
+	apiKey, err := a.apiKeyRepository.GetByHash(ctx, secure.HashToken(key))
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve api key by hash: %w", err)
+	}
+
+	if apiKey == nil || apiKey.RevokedAt != nil {
+		return nil, domain.ErrAPIKeyInvalid
+	}
+
+	now := time.Now().UTC()
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= lastUsedResolution {
+		if err := a.apiKeyRepository.UpdateLastUsed(ctx, apiKey.ID, now); err != nil {
+			slog.Error("Failed to update api key last used timestamp", slog.String("apiKeyId", apiKey.ID.String()), slog.String("error", err.Error()))
+		} else {
+			apiKey.LastUsedAt = &now
+		}
+	}
+
+	return apiKey, nil
+}
+
+func (a *apiKeyService) getOrganizationKey(ctx context.Context, organizationID, apiKeyID uuid.UUID) (*domain.APIKey, error) {
+	if err := a.authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionAPIKeyManage); err != nil {
+		return nil, err
+	}
+
+	apiKey, err := a.apiKeyRepository.GetByID(ctx, apiKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve api key by ID %s: %w", apiKeyID, err)
+	}
+
+	if apiKey == nil || apiKey.OrganizationID != organizationID {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+
+	if apiKey.RevokedAt != nil {
+		return nil, domain.ErrAPIKeyRevoked
+	}
+
+	return apiKey, nil
+}
+
+func (a *apiKeyService) generateKey() (string, error) {
+	token, err := secure.GenerateToken(apiKeySize)
+	if err != nil {
+		return "", fmt.Errorf("error to generate api key: %w", err)
+	}
+
+	return apiKeyPrefix + token, nil
+}
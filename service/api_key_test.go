@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyService_Create_WhenPayloadIsValid_ShouldStoreOnlyKeyHash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepositoryMock := mock.NewMockAPIKeyRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+	apiKeyService := &apiKeyService{apiKeyRepository: apiKeyRepositoryMock, authorizationService: authorizationServiceMock, auditService: auditServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	organizationID := uuid.New()
+	payload := domain.APIKeyPayload{
+		Name:   "Aggregator",
+		Scopes: []domain.APIKeyScope{domain.APIKeyScopeShowtimesRead, domain.APIKeyScopeShowtimesRead, domain.APIKeyScopeOrdersWrite},
+	}
+
+	var stored domain.APIKey
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), organizationID, gomock.Any()).Return(nil)
+	apiKeyRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, apiKey domain.APIKey) error {
+			stored = apiKey
+			return nil
+		})
+
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, entry domain.AuditEntry) {
+			assert.Equal(t, domain.AuditActionAPIKeyCreate, entry.Action)
+			assert.Equal(t, &organizationID, entry.OrganizationID)
+		})
+
+	response, err := apiKeyService.Create(ctx, organizationID, payload)
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(response.Key, apiKeyPrefix))
+	assert.Equal(t, secure.HashToken(response.Key), stored.KeyHash)
+	assert.NotContains(t, stored.KeyHash, response.Key)
+	assert.Equal(t, response.Key[:apiKeyDisplayLength], stored.Prefix)
+	assert.Equal(t, session.UserID, stored.CreatedByID)
+	assert.Equal(t, "showtimes:read,orders:write", stored.Scopes)
+}
+
+func TestAPIKeyService_Authenticate_WhenKeyIsRevoked_ShouldReturnErrAPIKeyInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepositoryMock := mock.NewMockAPIKeyRepository(ctrl)
+	apiKeyService := &apiKeyService{apiKeyRepository: apiKeyRepositoryMock}
+
+	key := apiKeyPrefix + "revoked"
+	revokedAt := time.Now().UTC().Add(-time.Hour)
+
+	apiKeyRepositoryMock.EXPECT().GetByHash(gomock.Any(), secure.HashToken(key)).Return(&domain.APIKey{ID: uuid.New(), RevokedAt: &revokedAt}, nil)
+
+	apiKey, err := apiKeyService.Authenticate(context.Background(), key)
+
+	assert.ErrorIs(t, err, domain.ErrAPIKeyInvalid)
+	assert.Nil(t, apiKey)
+}
+
+func TestAPIKeyService_Authenticate_WhenKeyHasNoPrefix_ShouldNotQueryRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepositoryMock := mock.NewMockAPIKeyRepository(ctrl)
+	apiKeyService := &apiKeyService{apiKeyRepository: apiKeyRepositoryMock}
+
+	apiKey, err := apiKeyService.Authenticate(context.Background(), "not-a-key")
+
+	assert.ErrorIs(t, err, domain.ErrAPIKeyInvalid)
+	assert.Nil(t, apiKey)
+}
+
+func TestAPIKeyService_Authenticate_WhenUsedRecently_ShouldNotUpdateLastUsed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepositoryMock := mock.NewMockAPIKeyRepository(ctrl)
+	apiKeyService := &apiKeyService{apiKeyRepository: apiKeyRepositoryMock}
+
+	key := apiKeyPrefix + "recent"
+	lastUsedAt := time.Now().UTC().Add(-10 * time.Second)
+
+	apiKeyRepositoryMock.EXPECT().GetByHash(gomock.Any(), secure.HashToken(key)).Return(&domain.APIKey{ID: uuid.New(), LastUsedAt: &lastUsedAt}, nil)
+
+	apiKey, err := apiKeyService.Authenticate(context.Background(), key)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &lastUsedAt, apiKey.LastUsedAt)
+}
+
+func TestAPIKeyService_Authenticate_WhenLastUseIsStale_ShouldUpdateLastUsed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepositoryMock := mock.NewMockAPIKeyRepository(ctrl)
+	apiKeyService := &apiKeyService{apiKeyRepository: apiKeyRepositoryMock}
+
+	key := apiKeyPrefix + "stale"
+	stored := &domain.APIKey{ID: uuid.New()}
+
+	apiKeyRepositoryMock.EXPECT().GetByHash(gomock.Any(), secure.HashToken(key)).Return(stored, nil)
+	apiKeyRepositoryMock.EXPECT().UpdateLastUsed(gomock.Any(), stored.ID, gomock.Any()).Return(nil)
+
+	apiKey, err := apiKeyService.Authenticate(context.Background(), key)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, apiKey.LastUsedAt)
+}
+
+func TestAPIKeyService_Rotate_WhenKeyBelongsToOrganization_ShouldReplaceHash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepositoryMock := mock.NewMockAPIKeyRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+	apiKeyService := &apiKeyService{apiKeyRepository: apiKeyRepositoryMock, authorizationService: authorizationServiceMock, auditService: auditServiceMock}
+
+	organizationID := uuid.New()
+	existing := &domain.APIKey{ID: uuid.New(), OrganizationID: organizationID, KeyHash: secure.HashToken(apiKeyPrefix + "old"), Scopes: "showtimes:read"}
+
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), organizationID, gomock.Any()).Return(nil)
+	apiKeyRepositoryMock.EXPECT().GetByID(gomock.Any(), existing.ID).Return(existing, nil)
+	apiKeyRepositoryMock.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, apiKey domain.APIKey) error {
+			assert.NotEqual(t, secure.HashToken(apiKeyPrefix+"old"), apiKey.KeyHash)
+			assert.Equal(t, "showtimes:read", apiKey.Scopes)
+			return nil
+		})
+
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, entry domain.AuditEntry) {
+			assert.Equal(t, domain.AuditActionAPIKeyRotate, entry.Action)
+			assert.Equal(t, &organizationID, entry.OrganizationID)
+		})
+
+	response, err := apiKeyService.Rotate(context.Background(), organizationID, existing.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, secure.HashToken(response.Key), existing.KeyHash)
+}
+
+func TestAPIKeyService_Revoke_WhenKeyBelongsToAnotherOrganization_ShouldReturnErrAPIKeyNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepositoryMock := mock.NewMockAPIKeyRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	apiKeyService := &apiKeyService{apiKeyRepository: apiKeyRepositoryMock, authorizationService: authorizationServiceMock}
+
+	organizationID := uuid.New()
+	existing := &domain.APIKey{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), organizationID, gomock.Any()).Return(nil)
+	apiKeyRepositoryMock.EXPECT().GetByID(gomock.Any(), existing.ID).Return(existing, nil)
+
+	err := apiKeyService.Revoke(context.Background(), organizationID, existing.ID)
+
+	assert.ErrorIs(t, err, domain.ErrAPIKeyNotFound)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/samber/do"
+)
+
+const (
+	auditLogSort     = "createdAt DESC"
+	auditLogMaxLimit = 100
+)
+
+type auditService struct {
+	i                    *do.Injector
+	auditLogRepository   domain.AuditLogRepository
+	authorizationService domain.AuthorizationService
+}
+
+func NewAuditService(i *do.Injector) (domain.AuditService, error) {
+	auditLogRepository, err := do.Invoke[domain.AuditLogRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuditLogRepository: %w", err)
+	}
+
+	authorizationService, err := do.Invoke[domain.AuthorizationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuthorizationService: %w", err)
+	}
+
+	return &auditService{
+		i:                    i,
+		auditLogRepository:   auditLogRepository,
+		authorizationService: authorizationService,
+	}, nil
+}
+
+// Record is called after the action succeeded, so a failure to write the
+// entry is logged instead of being returned to the caller.
+func (a *auditService) Record(ctx context.Context, entry domain.AuditEntry) {
+	log := slog.With(
+		slog.String("service", "audit"),
+		slog.String("func", "Record"),
+		slog.String("action", string(entry.Action)),
+		slog.String("targetId", entry.TargetID.String()),
+	)
+
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		log.Warn("Audit entry without an authenticated user")
+		return
+	}
+
+	changes, err := domain.DiffAudit(entry.Before, entry.After)
+	if err != nil {
+		log.Error("Failed to diff audit entry", slog.String("error", err.Error()))
+		return
+	}
+
+	encodedChanges, err := jsoniter.MarshalToString(changes)
+	if err != nil {
+		log.Error("Failed to encode audit changes", slog.String("error", err.Error()))
+		return
+	}
+
+	requestID, _ := ctx.Value(domain.RequestIDKey).(string)
+	ipAddress, _ := ctx.Value(domain.IPAddressKey).(string)
+
+	auditLog := domain.AuditLog{
+		ID:             uuid.New(),
+		OrganizationID: entry.OrganizationID,
+		ActorID:        session.UserID,
+		Action:         entry.Action,
+		TargetType:     entry.TargetType,
+		TargetID:       entry.TargetID,
+		Changes:        encodedChanges,
+		IPAddress:      ipAddress,
+		RequestID:      requestID,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	if err := a.auditLogRepository.Create(ctx, auditLog); err != nil {
+		log.Error("Failed to store audit entry", slog.String("error", err.Error()))
+	}
+}
+
+// GetAll returns the entries of an organization to its members. Without an
+// organization the caller only sees the actions they performed.
+func (a *auditService) GetAll(ctx context.Context, filter domain.AuditLogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	if filter.OrganizationID != nil {
+		if err := a.authorizationService.AuthorizeOrganization(ctx, *filter.OrganizationID, domain.PermissionAuditRead); err != nil {
+			return nil, err
+		}
+	} else {
+		filter.ActorID = &session.UserID
+	}
+
+	pagination.Sort = auditLogSort
+	if pagination.GetLimit() > auditLogMaxLimit {
+		pagination.Limit = auditLogMaxLimit
+	}
+
+	auditLogsPagination, err := a.auditLogRepository.GetAll(ctx, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error to fetch audit logs for user ID %s: %w", session.UserID, err)
+	}
+
+	auditLogs, _ := auditLogsPagination.Rows.([]*domain.AuditLog)
+	response := make([]*domain.AuditLogResponse, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		response = append(response, auditLog.ToAuditLogResponse())
+	}
+
+	auditLogsPagination.Rows = response
+
+	return auditLogsPagination, nil
+}
+
+// PurgeExpired deletes entries older than the configured retention in days.
+// A retention of zero keeps entries forever.
+func (a *auditService) PurgeExpired(ctx context.Context) error {
+	if config.Env.AuditLogRetention <= 0 {
+		return nil
+	}
+
+	cutoff := time.Now().UTC().AddDate(0, 0, -config.Env.AuditLogRetention)
+	deleted, err := a.auditLogRepository.DeleteOlderThan(ctx, cutoff)
+	if err != nil {
+		return fmt.Errorf("error to purge audit logs older than %s: %w", cutoff.Format(time.RFC3339), err)
+	}
+
+	if deleted > 0 {
+		slog.Info("audit logs purged", slog.Int64("count", deleted))
+	}
+
+	return nil
+}
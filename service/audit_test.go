@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditService_Record_WhenTargetChanged_ShouldStoreOnlyChangedFieldsWithRequestMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditLogRepositoryMock := mock.NewMockAuditLogRepository(ctrl)
+	auditService := &auditService{auditLogRepository: auditLogRepositoryMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	ctx = context.WithValue(ctx, domain.RequestIDKey, "req-123")
+	ctx = context.WithValue(ctx, domain.IPAddressKey, "203.0.113.10")
+
+	organizationID := uuid.New()
+	cinemaID := uuid.New()
+	before := &domain.CinemaResponse{ID: cinemaID, Name: "Cine Centro", AllowAccompaniedMinors: false}
+	after := &domain.CinemaResponse{ID: cinemaID, Name: "Cine Centro", AllowAccompaniedMinors: true}
+
+	auditLogRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, auditLog domain.AuditLog) error {
+			assert.Equal(t, session.UserID, auditLog.ActorID)
+			assert.Equal(t, &organizationID, auditLog.OrganizationID)
+			assert.Equal(t, "req-123", auditLog.RequestID)
+			assert.Equal(t, "203.0.113.10", auditLog.IPAddress)
+
+			var changes map[string]domain.AuditChange
+			assert.NoError(t, jsoniter.UnmarshalFromString(auditLog.Changes, &changes))
+			assert.Len(t, changes, 1)
+			assert.Equal(t, false, changes["allowAccompaniedMinors"].Before)
+			assert.Equal(t, true, changes["allowAccompaniedMinors"].After)
+			return nil
+		})
+
+	auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &organizationID,
+		Action:         domain.AuditActionCinemaPolicyUpdate,
+		TargetType:     domain.AuditTargetCinema,
+		TargetID:       cinemaID,
+		Before:         before,
+		After:          after,
+	})
+}
+
+func TestAuditService_Record_WhenTargetDeleted_ShouldKeepPreviousValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditLogRepositoryMock := mock.NewMockAuditLogRepository(ctrl)
+	auditService := &auditService{auditLogRepository: auditLogRepositoryMock}
+
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: uuid.New()})
+	cinema := &domain.CinemaResponse{ID: uuid.New(), Name: "Cine Centro"}
+
+	auditLogRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, auditLog domain.AuditLog) error {
+			var changes map[string]domain.AuditChange
+			assert.NoError(t, jsoniter.UnmarshalFromString(auditLog.Changes, &changes))
+			assert.Equal(t, "Cine Centro", changes["name"].Before)
+			assert.Nil(t, changes["name"].After)
+			return nil
+		})
+
+	auditService.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditActionCinemaDelete,
+		TargetType: domain.AuditTargetCinema,
+		TargetID:   cinema.ID,
+		Before:     cinema,
+	})
+}
+
+func TestAuditService_GetAll_WhenNoOrganizationIsGiven_ShouldOnlyReturnOwnActions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditLogRepositoryMock := mock.NewMockAuditLogRepository(ctrl)
+	auditService := &auditService{auditLogRepository: auditLogRepositoryMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	otherUserID := uuid.New()
+
+	auditLogRepositoryMock.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter domain.AuditLogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+			assert.Equal(t, &session.UserID, filter.ActorID)
+			assert.Equal(t, "createdAt DESC", pagination.Sort)
+			assert.Equal(t, 100, pagination.Limit)
+			pagination.Rows = []*domain.AuditLog{{ID: uuid.New(), ActorID: session.UserID, Changes: `{}`}}
+			return pagination, nil
+		})
+
+	response, err := auditService.GetAll(ctx, domain.AuditLogFilter{ActorID: &otherUserID}, &domain.Pagination{Limit: 500, Sort: "id; DROP TABLE User"})
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Rows, 1)
+}
+
+func TestAuditService_GetAll_WhenUserIsNotOrganizationMember_ShouldReturnErrPermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditLogRepositoryMock := mock.NewMockAuditLogRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	auditService := &auditService{auditLogRepository: auditLogRepositoryMock, authorizationService: authorizationServiceMock}
+
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: uuid.New()})
+	organizationID := uuid.New()
+
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), organizationID, gomock.Any()).Return(domain.ErrPermissionDenied)
+
+	response, err := auditService.GetAll(ctx, domain.AuditLogFilter{OrganizationID: &organizationID}, &domain.Pagination{})
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	assert.Nil(t, response)
+}
+
+func TestAuditService_PurgeExpired_WhenRetentionIsConfigured_ShouldDeleteOlderEntries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	previous := config.Env.AuditLogRetention
+	config.Env.AuditLogRetention = 30
+	t.Cleanup(func() { config.Env.AuditLogRetention = previous })
+
+	auditLogRepositoryMock := mock.NewMockAuditLogRepository(ctrl)
+	auditService := &auditService{auditLogRepository: auditLogRepositoryMock}
+
+	auditLogRepositoryMock.EXPECT().DeleteOlderThan(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, before time.Time) (int64, error) {
+			assert.WithinDuration(t, time.Now().UTC().AddDate(0, 0, -30), before, time.Minute)
+			return 3, nil
+		})
+
+	err := auditService.PurgeExpired(context.Background())
+
+	assert.NoError(t, err)
+}
+
+func TestAuditService_PurgeExpired_WhenRetentionIsZero_ShouldKeepEntries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	previous := config.Env.AuditLogRetention
+	config.Env.AuditLogRetention = 0
+	t.Cleanup(func() { config.Env.AuditLogRetention = previous })
+
+	auditLogRepositoryMock := mock.NewMockAuditLogRepository(ctrl)
+	auditService := &auditService{auditLogRepository: auditLogRepositoryMock}
+
+	err := auditService.PurgeExpired(context.Background())
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type authorizationService struct {
+	i                      *do.Injector
+	cinemaRepository       domain.CinemaRepository
+	organizationRepository domain.OrganizationRepository
+	cinemaStaffRepository  domain.CinemaStaffRepository
+}
+
+func NewAuthorizationService(i *do.Injector) (domain.AuthorizationService, error) {
+	cinemaRepository, err := do.Invoke[domain.CinemaRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize CinemaRepository: %w", err)
+	}
+
+	organizationRepository, err := do.Invoke[domain.OrganizationRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize OrganizationRepository: %w", err)
+	}
+
+	cinemaStaffRepository, err := do.Invoke[domain.CinemaStaffRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize CinemaStaffRepository: %w", err)
+	}
+
+	return &authorizationService{
+		i:                      i,
+		cinemaRepository:       cinemaRepository,
+		organizationRepository: organizationRepository,
+		cinemaStaffRepository:  cinemaStaffRepository,
+	}, nil
+}
+
+// Authorize grants every permission to owners and admins of the cinema's
+// organization; staff only get the permissions of their role on that cinema.
+func (a *authorizationService) Authorize(ctx context.Context, cinemaID uuid.UUID, permission domain.Permission) error {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return domain.ErrUserNotFoundInContext
+	}
+
+	cinema, err := a.cinemaRepository.GetByID(ctx, cinemaID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve cinema by ID %s: %w", cinemaID, err)
+	}
+
+	if cinema == nil {
+		return domain.ErrCinemaNotFound
+	}
+
+	member, err := a.organizationRepository.GetMember(ctx, cinema.OrganizationID, session.UserID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve organization member for user ID %s: %w", session.UserID, err)
+	}
+
+	if member != nil {
+		return a.checkTwoFactor(ctx, session, cinema.OrganizationID)
+	}
+
+	staff, err := a.cinemaStaffRepository.GetByCinemaAndUser(ctx, cinemaID, session.UserID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve cinema staff for user ID %s: %w", session.UserID, err)
+	}
+
+	if staff == nil || !staff.Role.HasPermission(permission) {
+		return domain.ErrPermissionDenied
+	}
+
+	return a.checkTwoFactor(ctx, session, cinema.OrganizationID)
+}
+
+// AuthorizeOrganization checks the permission against the caller's role in
+// the organization.
+func (a *authorizationService) AuthorizeOrganization(ctx context.Context, organizationID uuid.UUID, permission domain.Permission) error {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return domain.ErrUserNotFoundInContext
+	}
+
+	organization, err := a.organizationRepository.GetByID(ctx, organizationID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve organization by ID %s: %w", organizationID, err)
+	}
+
+	if organization == nil {
+		return domain.ErrOrganizationNotFound
+	}
+
+	member, err := a.organizationRepository.GetMember(ctx, organizationID, session.UserID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve organization member for user ID %s: %w", session.UserID, err)
+	}
+
+	if member == nil || !member.Role.HasPermission(permission) {
+		return domain.ErrPermissionDenied
+	}
+
+	if organization.RequireTwoFactor && !session.TwoFactorVerified {
+		return domain.ErrTwoFactorRequired
+	}
+
+	return nil
+}
+
+func (a *authorizationService) checkTwoFactor(ctx context.Context, session *domain.Session, organizationID uuid.UUID) error {
+	if session.TwoFactorVerified {
+		return nil
+	}
+
+	organization, err := a.organizationRepository.GetByID(ctx, organizationID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve organization by ID %s: %w", organizationID, err)
+	}
+
+	if organization != nil && organization.RequireTwoFactor {
+		return domain.ErrTwoFactorRequired
+	}
+
+	return nil
+}
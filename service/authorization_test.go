@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizationService_Authorize_WhenUserIsOrganizationMember_ShouldReturnNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cinemaRepositoryMock := mock.NewMockCinemaRepository(ctrl)
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	cinemaStaffRepositoryMock := mock.NewMockCinemaStaffRepository(ctrl)
+	authorizationService := &authorizationService{
+		cinemaRepository:       cinemaRepositoryMock,
+		organizationRepository: organizationRepositoryMock,
+		cinemaStaffRepository:  cinemaStaffRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	cinema := &domain.Cinema{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	cinemaRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.ID).Return(cinema, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), cinema.OrganizationID, session.UserID).Return(&domain.OrganizationMember{Role: domain.OrganizationRoleAdmin}, nil)
+	organizationRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.OrganizationID).Return(&domain.Organization{ID: cinema.OrganizationID}, nil)
+
+	err := authorizationService.Authorize(ctx, cinema.ID, domain.PermissionCinemaDelete)
+
+	assert.NoError(t, err)
+}
+
+func TestAuthorizationService_Authorize_WhenStaffRoleHasPermission_ShouldReturnNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cinemaRepositoryMock := mock.NewMockCinemaRepository(ctrl)
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	cinemaStaffRepositoryMock := mock.NewMockCinemaStaffRepository(ctrl)
+	authorizationService := &authorizationService{
+		cinemaRepository:       cinemaRepositoryMock,
+		organizationRepository: organizationRepositoryMock,
+		cinemaStaffRepository:  cinemaStaffRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	cinema := &domain.Cinema{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	cinemaRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.ID).Return(cinema, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), cinema.OrganizationID, session.UserID).Return(nil, nil)
+	cinemaStaffRepositoryMock.EXPECT().GetByCinemaAndUser(gomock.Any(), cinema.ID, session.UserID).Return(&domain.CinemaStaff{Role: domain.CinemaRoleManager}, nil)
+	organizationRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.OrganizationID).Return(&domain.Organization{ID: cinema.OrganizationID}, nil)
+
+	err := authorizationService.Authorize(ctx, cinema.ID, domain.PermissionStaffManage)
+
+	assert.NoError(t, err)
+}
+
+func TestAuthorizationService_Authorize_WhenManagerDeletesCinema_ShouldReturnErrPermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cinemaRepositoryMock := mock.NewMockCinemaRepository(ctrl)
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	cinemaStaffRepositoryMock := mock.NewMockCinemaStaffRepository(ctrl)
+	authorizationService := &authorizationService{
+		cinemaRepository:       cinemaRepositoryMock,
+		organizationRepository: organizationRepositoryMock,
+		cinemaStaffRepository:  cinemaStaffRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	cinema := &domain.Cinema{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	cinemaRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.ID).Return(cinema, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), cinema.OrganizationID, session.UserID).Return(nil, nil)
+	cinemaStaffRepositoryMock.EXPECT().GetByCinemaAndUser(gomock.Any(), cinema.ID, session.UserID).Return(&domain.CinemaStaff{Role: domain.CinemaRoleManager}, nil)
+
+	err := authorizationService.Authorize(ctx, cinema.ID, domain.PermissionCinemaDelete)
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestAuthorizationService_Authorize_WhenUserIsStaffOfAnotherCinema_ShouldReturnErrPermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cinemaRepositoryMock := mock.NewMockCinemaRepository(ctrl)
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	cinemaStaffRepositoryMock := mock.NewMockCinemaStaffRepository(ctrl)
+	authorizationService := &authorizationService{
+		cinemaRepository:       cinemaRepositoryMock,
+		organizationRepository: organizationRepositoryMock,
+		cinemaStaffRepository:  cinemaStaffRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	cinema := &domain.Cinema{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	cinemaRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.ID).Return(cinema, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), cinema.OrganizationID, session.UserID).Return(nil, nil)
+	cinemaStaffRepositoryMock.EXPECT().GetByCinemaAndUser(gomock.Any(), cinema.ID, session.UserID).Return(nil, nil)
+
+	err := authorizationService.Authorize(ctx, cinema.ID, domain.PermissionCinemaRead)
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestAuthorizationService_Authorize_WhenCinemaNotFound_ShouldReturnErrCinemaNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cinemaRepositoryMock := mock.NewMockCinemaRepository(ctrl)
+	authorizationService := &authorizationService{
+		cinemaRepository: cinemaRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	cinemaID := uuid.New()
+
+	cinemaRepositoryMock.EXPECT().GetByID(gomock.Any(), cinemaID).Return(nil, nil)
+
+	err := authorizationService.Authorize(ctx, cinemaID, domain.PermissionCinemaRead)
+
+	assert.ErrorIs(t, err, domain.ErrCinemaNotFound)
+}
+
+func TestAuthorizationService_Authorize_WhenOrganizationRequiresTwoFactorAndSessionIsNotVerified_ShouldReturnErrTwoFactorRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cinemaRepositoryMock := mock.NewMockCinemaRepository(ctrl)
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	cinemaStaffRepositoryMock := mock.NewMockCinemaStaffRepository(ctrl)
+	authorizationService := &authorizationService{
+		cinemaRepository:       cinemaRepositoryMock,
+		organizationRepository: organizationRepositoryMock,
+		cinemaStaffRepository:  cinemaStaffRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	cinema := &domain.Cinema{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	cinemaRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.ID).Return(cinema, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), cinema.OrganizationID, session.UserID).Return(nil, nil)
+	cinemaStaffRepositoryMock.EXPECT().GetByCinemaAndUser(gomock.Any(), cinema.ID, session.UserID).Return(&domain.CinemaStaff{Role: domain.CinemaRoleCashier}, nil)
+	organizationRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.OrganizationID).Return(&domain.Organization{ID: cinema.OrganizationID, RequireTwoFactor: true}, nil)
+
+	err := authorizationService.Authorize(ctx, cinema.ID, domain.PermissionTicketSell)
+
+	assert.ErrorIs(t, err, domain.ErrTwoFactorRequired)
+}
+
+func TestAuthorizationService_Authorize_WhenSessionIsTwoFactorVerified_ShouldNotLoadOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cinemaRepositoryMock := mock.NewMockCinemaRepository(ctrl)
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	cinemaStaffRepositoryMock := mock.NewMockCinemaStaffRepository(ctrl)
+	authorizationService := &authorizationService{
+		cinemaRepository:       cinemaRepositoryMock,
+		organizationRepository: organizationRepositoryMock,
+		cinemaStaffRepository:  cinemaStaffRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New(), TwoFactorVerified: true}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	cinema := &domain.Cinema{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	cinemaRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.ID).Return(cinema, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), cinema.OrganizationID, session.UserID).Return(&domain.OrganizationMember{Role: domain.OrganizationRoleOwner}, nil)
+
+	err := authorizationService.Authorize(ctx, cinema.ID, domain.PermissionCinemaDelete)
+
+	assert.NoError(t, err)
+}
+
+func TestAuthorizationService_AuthorizeOrganization_WhenOwner_ShouldReturnNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	authorizationService := &authorizationService{
+		organizationRepository: organizationRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	organizationID := uuid.New()
+
+	organizationRepositoryMock.EXPECT().GetByID(gomock.Any(), organizationID).Return(&domain.Organization{ID: organizationID}, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), organizationID, session.UserID).Return(&domain.OrganizationMember{Role: domain.OrganizationRoleOwner}, nil)
+
+	err := authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionOrganizationSecurity)
+
+	assert.NoError(t, err)
+}
+
+func TestAuthorizationService_AuthorizeOrganization_WhenAdminReadsAuditLog_ShouldReturnNil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	authorizationService := &authorizationService{
+		organizationRepository: organizationRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	organizationID := uuid.New()
+
+	organizationRepositoryMock.EXPECT().GetByID(gomock.Any(), organizationID).Return(&domain.Organization{ID: organizationID}, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), organizationID, session.UserID).Return(&domain.OrganizationMember{Role: domain.OrganizationRoleAdmin}, nil)
+
+	err := authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionAuditRead)
+
+	assert.NoError(t, err)
+}
+
+func TestAuthorizationService_AuthorizeOrganization_WhenAdminManagesOwnerOnlySettings_ShouldReturnErrPermissionDenied(t *testing.T) {
+	permissions := []domain.Permission{
+		domain.PermissionOrganizationSecurity,
+		domain.PermissionMemberManage,
+		domain.PermissionAPIKeyManage,
+	}
+
+	for _, permission := range permissions {
+		t.Run(string(permission), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+			authorizationService := &authorizationService{
+				organizationRepository: organizationRepositoryMock,
+			}
+
+			session := &domain.Session{UserID: uuid.New(), TwoFactorVerified: true}
+			ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+			organizationID := uuid.New()
+
+			organizationRepositoryMock.EXPECT().GetByID(gomock.Any(), organizationID).Return(&domain.Organization{ID: organizationID}, nil)
+			organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), organizationID, session.UserID).Return(&domain.OrganizationMember{Role: domain.OrganizationRoleAdmin}, nil)
+
+			err := authorizationService.AuthorizeOrganization(ctx, organizationID, permission)
+
+			assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+		})
+	}
+}
+
+func TestAuthorizationService_AuthorizeOrganization_WhenUserIsNotMember_ShouldReturnErrPermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationRepositoryMock := mock.NewMockOrganizationRepository(ctrl)
+	authorizationService := &authorizationService{
+		organizationRepository: organizationRepositoryMock,
+	}
+
+	session := &domain.Session{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	organizationID := uuid.New()
+
+	organizationRepositoryMock.EXPECT().GetByID(gomock.Any(), organizationID).Return(&domain.Organization{ID: organizationID}, nil)
+	organizationRepositoryMock.EXPECT().GetMember(gomock.Any(), organizationID, session.UserID).Return(nil, nil)
+
+	err := authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionOrganizationRead)
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
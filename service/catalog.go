@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/utils"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+const (
+	nowShowingSearchBoost = 1.5
+	maxTypoCandidates     = 200
+	minTrigramSimilarity  = 0.3
+)
+
+type catalogService struct {
+	i                 *do.Injector
+	catalogRepository domain.CatalogRepository
+	movieRepository   domain.MovieRepository
+}
+
+func NewCatalogService(i *do.Injector) (domain.CatalogService, error) {
+	catalogRepository, err := do.Invoke[domain.CatalogRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize catalog repository: %w", err)
+	}
+
+	movieRepository, err := do.Invoke[domain.MovieRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize movie repository: %w", err)
+	}
+
+	return &catalogService{
+		i:                 i,
+		catalogRepository: catalogRepository,
+		movieRepository:   movieRepository,
+	}, nil
+}
+
+// GetNowShowing lists movies with sessions starting in the next days. Days
+// outside 1..MaxNowShowingDays fall back to DefaultNowShowingDays.
+func (c *catalogService) GetNowShowing(ctx context.Context, filter domain.CatalogFilter, days int, pagination *domain.Pagination) (*domain.Pagination, error) {
+	if days <= 0 || days > domain.MaxNowShowingDays {
+		days = domain.DefaultNowShowingDays
+	}
+
+	if err := c.preparePagination(pagination, "title"); err != nil {
+		return nil, err
+	}
+
+	filter.From = time.Now().UTC()
+	filter.To = filter.From.AddDate(0, 0, days)
+
+	moviesPagination, err := c.catalogRepository.GetNowShowing(ctx, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error to get movies now showing: %w", err)
+	}
+
+	return c.toResponse(moviesPagination)
+}
+
+func (c *catalogService) GetComingSoon(ctx context.Context, filter domain.CatalogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+	if err := c.preparePagination(pagination, "releaseDate"); err != nil {
+		return nil, err
+	}
+
+	filter.From = time.Now().UTC()
+	filter.To = time.Time{}
+
+	moviesPagination, err := c.catalogRepository.GetComingSoon(ctx, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error to get movies coming soon: %w", err)
+	}
+
+	return c.toResponse(moviesPagination)
+}
+
+func (c *catalogService) GetMovieByID(ctx context.Context, movieID uuid.UUID) (*domain.MovieResponse, error) {
+	movie, err := c.movieRepository.GetByID(ctx, movieID, true)
+	if err != nil {
+		return nil, fmt.Errorf("error to get movie by id: %w", err)
+	}
+
+	if movie == nil {
+		return nil, domain.ErrMoviesNotFound
+	}
+
+	return movie.ToMovieResponse(), nil
+}
+
+// Search ranks movies with the full-text indexes and, when nothing matches,
+// falls back to trigram and edit distance matching on the titles so typos
+// like "vingadoras" still find "Vingadores".
+func (c *catalogService) Search(ctx context.Context, payload domain.MovieSearchPayload, pagination *domain.Pagination) (*domain.Pagination, error) {
+	normalized := utils.NormalizeSearchText(payload.Query)
+	if normalized == "" {
+		return nil, domain.ErrCatalogMoviesNotFound
+	}
+
+	if pagination.GetLimit() > domain.MaxCatalogPageSize {
+		pagination.Limit = domain.MaxCatalogPageSize
+	}
+
+	now := time.Now().UTC()
+	query := domain.MovieSearchQuery{
+		Terms:           booleanSearchTerms(normalized),
+		NowShowingFrom:  now,
+		NowShowingTo:    now.AddDate(0, 0, domain.DefaultNowShowingDays),
+		NowShowingBoost: nowShowingSearchBoost,
+	}
+
+	results, total, err := c.catalogRepository.Search(ctx, query, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error to search movies: %w", err)
+	}
+
+	if total == 0 {
+		results, total, err = c.searchTypos(ctx, query, normalized, pagination)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	movieIDs := make([]uuid.UUID, 0, len(results))
+	for _, result := range results {
+		movieIDs = append(movieIDs, result.MovieID)
+	}
+
+	movies, err := c.catalogRepository.GetMoviesByIDs(ctx, movieIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error to get searched movies: %w", err)
+	}
+
+	moviesByID := make(map[uuid.UUID]*domain.Movie, len(movies))
+	for _, movie := range movies {
+		moviesByID[movie.ID] = movie
+	}
+
+	ranked := make([]*domain.Movie, 0, len(movies))
+	for _, movieID := range movieIDs {
+		if movie, ok := moviesByID[movieID]; ok {
+			ranked = append(ranked, movie)
+		}
+	}
+
+	pagination.TotalRows = total
+	pagination.TotalPages = int(math.Ceil(float64(total) / float64(pagination.GetLimit())))
+	pagination.Rows = ranked
+
+	return c.toResponse(pagination)
+}
+
+func (c *catalogService) searchTypos(ctx context.Context, query domain.MovieSearchQuery, normalized string, pagination *domain.Pagination) ([]*domain.MovieSearchResult, int64, error) {
+	var trigrams []string
+	for _, trigram := range utils.SearchTrigrams(normalized) {
+		if !strings.Contains(trigram, " ") {
+			trigrams = append(trigrams, trigram)
+		}
+	}
+
+	candidates, err := c.catalogRepository.GetSearchCandidates(ctx, query, trigrams, maxTypoCandidates)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error to get search candidates: %w", err)
+	}
+
+	var results []*domain.MovieSearchResult
+	for _, candidate := range candidates {
+		score := utils.TrigramSimilarity(normalized, candidate.SearchTitle)
+		if wordsWithinEditDistance(normalized, candidate.SearchTitle) {
+			score += 0.5
+		} else if score < minTrigramSimilarity {
+			continue
+		}
+
+		if candidate.NowShowing {
+			score *= nowShowingSearchBoost
+		}
+
+		results = append(results, &domain.MovieSearchResult{MovieID: candidate.MovieID, Score: score})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	total := int64(len(results))
+	start := min(pagination.GetOffset(), len(results))
+	end := min(start+pagination.GetLimit(), len(results))
+
+	return results[start:end], total, nil
+}
+
+// booleanSearchTerms turns every word into a prefix match so "vingador"
+// finds "vingadores". Normalized text only has letters, digits and spaces,
+// so no boolean operator from the user reaches MySQL.
+func booleanSearchTerms(normalized string) string {
+	words := strings.Fields(normalized)
+	for index, word := range words {
+		words[index] = word + "*"
+	}
+
+	return strings.Join(words, " ")
+}
+
+// wordsWithinEditDistance reports whether every query word is close to some
+// title word: one edit for words up to five letters, two for longer ones.
+func wordsWithinEditDistance(query, title string) bool {
+	titleWords := strings.Fields(title)
+	for _, word := range strings.Fields(query) {
+		allowed := 1
+		if len([]rune(word)) > 5 {
+			allowed = 2
+		}
+
+		matched := false
+		for _, titleWord := range titleWords {
+			if utils.LevenshteinDistance(word, titleWord) <= allowed {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *catalogService) preparePagination(pagination *domain.Pagination, defaultSort string) error {
+	if pagination.Sort == "" {
+		pagination.Sort = defaultSort
+	}
+
+	orderBy, ok := domain.CatalogSorts[pagination.Sort]
+	if !ok {
+		return domain.ErrInvalidCatalogSort
+	}
+	pagination.Sort = orderBy
+
+	if pagination.GetLimit() > domain.MaxCatalogPageSize {
+		pagination.Limit = domain.MaxCatalogPageSize
+	}
+
+	return nil
+}
+
+func (c *catalogService) toResponse(moviesPagination *domain.Pagination) (*domain.Pagination, error) {
+	movies, _ := moviesPagination.Rows.([]*domain.Movie)
+	if len(movies) == 0 {
+		return nil, domain.ErrCatalogMoviesNotFound
+	}
+
+	moviesResponse := make([]*domain.MovieResponse, 0, len(movies))
+	for _, movie := range movies {
+		moviesResponse = append(moviesResponse, movie.ToMovieResponse())
+	}
+	moviesPagination.Rows = moviesResponse
+
+	return moviesPagination, nil
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogService_GetNowShowing_WhenSortIsNotAllowed_ShouldReturnErrInvalidCatalogSort(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepositoryMock := mock.NewMockCatalogRepository(ctrl)
+	catalogService := &catalogService{catalogRepository: catalogRepositoryMock}
+
+	pagination := &domain.Pagination{Sort: "title; DROP TABLE Movie"}
+	response, err := catalogService.GetNowShowing(context.Background(), domain.CatalogFilter{}, 0, pagination)
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, domain.ErrInvalidCatalogSort)
+}
+
+func TestCatalogService_GetNowShowing_WhenDaysAreOutOfRange_ShouldUseDefaultWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepositoryMock := mock.NewMockCatalogRepository(ctrl)
+	catalogService := &catalogService{catalogRepository: catalogRepositoryMock}
+
+	cinemaID := uuid.New()
+	movie := &domain.Movie{ID: uuid.New(), Title: "Bacurau"}
+
+	catalogRepositoryMock.EXPECT().GetNowShowing(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter domain.CatalogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+			assert.Equal(t, &cinemaID, filter.CinemaID)
+			assert.WithinDuration(t, filter.From.AddDate(0, 0, domain.DefaultNowShowingDays), filter.To, time.Second)
+			assert.Equal(t, "title ASC", pagination.Sort)
+			assert.Equal(t, domain.MaxCatalogPageSize, pagination.Limit)
+			pagination.Rows = []*domain.Movie{movie}
+			return pagination, nil
+		})
+
+	pagination := &domain.Pagination{Limit: 500}
+	response, err := catalogService.GetNowShowing(context.Background(), domain.CatalogFilter{CinemaID: &cinemaID}, 365, pagination)
+
+	assert.NoError(t, err)
+	rows := response.Rows.([]*domain.MovieResponse)
+	assert.Equal(t, movie.ID, rows[0].ID)
+}
+
+func TestCatalogService_GetComingSoon_WhenNoMoviesMatch_ShouldReturnErrCatalogMoviesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepositoryMock := mock.NewMockCatalogRepository(ctrl)
+	catalogService := &catalogService{catalogRepository: catalogRepositoryMock}
+
+	catalogRepositoryMock.EXPECT().GetComingSoon(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter domain.CatalogFilter, pagination *domain.Pagination) (*domain.Pagination, error) {
+			assert.True(t, filter.To.IsZero())
+			assert.Equal(t, "releaseDate ASC", pagination.Sort)
+			pagination.Rows = []*domain.Movie{}
+			return pagination, nil
+		})
+
+	response, err := catalogService.GetComingSoon(context.Background(), domain.CatalogFilter{}, &domain.Pagination{})
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, domain.ErrCatalogMoviesNotFound)
+}
+
+func TestCatalogService_GetMovieByID_WhenMovieDoesNotExist_ShouldReturnErrMoviesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	catalogService := &catalogService{movieRepository: movieRepositoryMock}
+
+	movieID := uuid.New()
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movieID, true).Return(nil, nil)
+
+	response, err := catalogService.GetMovieByID(context.Background(), movieID)
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, domain.ErrMoviesNotFound)
+}
+
+func TestCatalogService_Search_WhenFullTextMatches_ShouldReturnMoviesInRelevanceOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepositoryMock := mock.NewMockCatalogRepository(ctrl)
+	catalogService := &catalogService{catalogRepository: catalogRepositoryMock}
+
+	first := &domain.Movie{ID: uuid.New(), Title: "Homem-Aranha: Através do Aranhaverso"}
+	second := &domain.Movie{ID: uuid.New(), Title: "Homem de Ferro"}
+
+	catalogRepositoryMock.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, query domain.MovieSearchQuery, _ *domain.Pagination) ([]*domain.MovieSearchResult, int64, error) {
+			assert.Equal(t, "homem* aranha*", query.Terms)
+			assert.Equal(t, nowShowingSearchBoost, query.NowShowingBoost)
+			return []*domain.MovieSearchResult{{MovieID: first.ID, Score: 3}, {MovieID: second.ID, Score: 1}}, 2, nil
+		})
+	catalogRepositoryMock.EXPECT().GetMoviesByIDs(gomock.Any(), []uuid.UUID{first.ID, second.ID}).Return([]*domain.Movie{second, first}, nil)
+
+	response, err := catalogService.Search(context.Background(), domain.MovieSearchPayload{Query: "Homem Aranha"}, &domain.Pagination{})
+
+	assert.NoError(t, err)
+	rows := response.Rows.([]*domain.MovieResponse)
+	assert.Equal(t, first.ID, rows[0].ID)
+	assert.Equal(t, second.ID, rows[1].ID)
+	assert.Equal(t, int64(2), response.TotalRows)
+}
+
+func TestCatalogService_Search_WhenQueryHasTypo_ShouldFallBackToTitleSimilarity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepositoryMock := mock.NewMockCatalogRepository(ctrl)
+	catalogService := &catalogService{catalogRepository: catalogRepositoryMock}
+
+	avengers := &domain.Movie{ID: uuid.New(), Title: "Vingadores: Ultimato"}
+	unrelated := uuid.New()
+
+	catalogRepositoryMock.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, int64(0), nil)
+	catalogRepositoryMock.EXPECT().GetSearchCandidates(gomock.Any(), gomock.Any(), gomock.Any(), maxTypoCandidates).Return([]*domain.MovieSearchCandidate{
+		{MovieID: unrelated, SearchTitle: "ainda estou aqui"},
+		{MovieID: avengers.ID, SearchTitle: "vingadores ultimato", NowShowing: true},
+	}, nil)
+	catalogRepositoryMock.EXPECT().GetMoviesByIDs(gomock.Any(), []uuid.UUID{avengers.ID}).Return([]*domain.Movie{avengers}, nil)
+
+	response, err := catalogService.Search(context.Background(), domain.MovieSearchPayload{Query: "vingadoras"}, &domain.Pagination{})
+
+	assert.NoError(t, err)
+	rows := response.Rows.([]*domain.MovieResponse)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, avengers.ID, rows[0].ID)
+}
+
+func TestCatalogService_Search_WhenQueryHasAccents_ShouldSearchNormalizedTerms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalogRepositoryMock := mock.NewMockCatalogRepository(ctrl)
+	catalogService := &catalogService{catalogRepository: catalogRepositoryMock}
+
+	catalogRepositoryMock.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, query domain.MovieSearchQuery, _ *domain.Pagination) ([]*domain.MovieSearchResult, int64, error) {
+			assert.Equal(t, "acao* coracao*", query.Terms)
+			return nil, 0, nil
+		})
+	catalogRepositoryMock.EXPECT().GetSearchCandidates(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	catalogRepositoryMock.EXPECT().GetMoviesByIDs(gomock.Any(), gomock.Len(0)).Return(nil, nil)
+
+	response, err := catalogService.Search(context.Background(), domain.MovieSearchPayload{Query: "Ação + \"Coração\""}, &domain.Pagination{})
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, domain.ErrCatalogMoviesNotFound)
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/GSVillas/movie-pass-api/domain"
 	"github.com/google/uuid"
@@ -10,8 +11,10 @@ import (
 )
 
 type cinemaService struct {
-	i                *do.Injector
-	cinemaRepository domain.CinemaRepository
+	i                    *do.Injector
+	cinemaRepository     domain.CinemaRepository
+	authorizationService domain.AuthorizationService
+	auditService         domain.AuditService
 }
 
 func NewCinemaSevice(i *do.Injector) (domain.CinemaService, error) {
@@ -20,9 +23,21 @@ func NewCinemaSevice(i *do.Injector) (domain.CinemaService, error) {
 		return nil, fmt.Errorf("error to initialize CinemaRepository: %w", err)
 	}
 
+	authorizationService, err := do.Invoke[domain.AuthorizationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuthorizationService: %w", err)
+	}
+
+	auditService, err := do.Invoke[domain.AuditService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuditService: %w", err)
+	}
+
 	return &cinemaService{
-		i:                i,
-		cinemaRepository: cinemaRepository,
+		i:                    i,
+		cinemaRepository:     cinemaRepository,
+		authorizationService: authorizationService,
+		auditService:         auditService,
 	}, nil
 }
 
@@ -32,6 +47,10 @@ func (c *cinemaService) Create(ctx context.Context, payload domain.CinemaPayload
 		return nil, domain.ErrUserNotFoundInContext
 	}
 
+	if err := c.authorizationService.AuthorizeOrganization(ctx, payload.OrganizationID, domain.PermissionCinemaCreate); err != nil {
+		return nil, err
+	}
+
 	cinema := payload.ToCinema(session.UserID)
 	if err := c.cinemaRepository.Create(ctx, *cinema); err != nil {
 		return nil, fmt.Errorf("error to create cinema for user ID %s: %w", session.UserID, err)
@@ -41,6 +60,10 @@ func (c *cinemaService) Create(ctx context.Context, payload domain.CinemaPayload
 }
 
 func (c *cinemaService) GetByID(ctx context.Context, cinemaID uuid.UUID) (*domain.CinemaResponse, error) {
+	if err := c.authorizationService.Authorize(ctx, cinemaID, domain.PermissionCinemaRead); err != nil {
+		return nil, err
+	}
+
 	cinema, err := c.cinemaRepository.GetByID(ctx, cinemaID)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching cinema by ID %s: %w", cinemaID.String(), err)
@@ -64,12 +87,13 @@ func (c *cinemaService) GetAll(ctx context.Context, pagination *domain.Paginatio
 		return nil, fmt.Errorf("error to fetch cinemas for user ID %s: %w", session.UserID, err)
 	}
 
-	if cinemasPagination.Rows == nil {
+	cinemas, _ := cinemasPagination.Rows.([]domain.Cinema)
+	if len(cinemas) == 0 {
 		return nil, domain.ErrCinemaNotFound
 	}
 
 	var cinemasResponse []domain.CinemaResponse
-	for _, cinema := range cinemasPagination.Rows.([]domain.Cinema) {
+	for _, cinema := range cinemas {
 		cinemasResponse = append(cinemasResponse, *cinema.ToCinemaResponse())
 	}
 
@@ -78,7 +102,46 @@ func (c *cinemaService) GetAll(ctx context.Context, pagination *domain.Paginatio
 	return cinemasPagination, nil
 }
 
+func (c *cinemaService) UpdatePolicy(ctx context.Context, cinemaID uuid.UUID, payload domain.CinemaPolicyPayload) (*domain.CinemaResponse, error) {
+	if err := c.authorizationService.Authorize(ctx, cinemaID, domain.PermissionCinemaUpdate); err != nil {
+		return nil, err
+	}
+
+	cinema, err := c.cinemaRepository.GetByID(ctx, cinemaID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve cinema by ID %s: %w", cinemaID.String(), err)
+	}
+
+	if cinema == nil {
+		return nil, domain.ErrCinemaNotFound
+	}
+
+	before := cinema.ToCinemaResponse()
+	cinema.AllowAccompaniedMinors = *payload.AllowAccompaniedMinors
+	cinema.UpdatedAt = time.Now().UTC()
+
+	if err := c.cinemaRepository.Update(ctx, *cinema); err != nil {
+		return nil, fmt.Errorf("error to update policy of cinema ID %s: %w", cinemaID.String(), err)
+	}
+
+	response := cinema.ToCinemaResponse()
+	c.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &cinema.OrganizationID,
+		Action:         domain.AuditActionCinemaPolicyUpdate,
+		TargetType:     domain.AuditTargetCinema,
+		TargetID:       cinema.ID,
+		Before:         before,
+		After:          response,
+	})
+
+	return response, nil
+}
+
 func (c *cinemaService) Delete(ctx context.Context, cinemaID uuid.UUID) error {
+	if err := c.authorizationService.Authorize(ctx, cinemaID, domain.PermissionCinemaDelete); err != nil {
+		return err
+	}
+
 	cinema, err := c.cinemaRepository.GetByID(ctx, cinemaID)
 	if err != nil {
 		return fmt.Errorf("error to retrieve cinema by ID %s: %w", cinemaID.String(), err)
@@ -92,5 +155,13 @@ func (c *cinemaService) Delete(ctx context.Context, cinemaID uuid.UUID) error {
 		return fmt.Errorf("error to delete cinema with ID %s: %w", cinemaID.String(), err)
 	}
 
+	c.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &cinema.OrganizationID,
+		Action:         domain.AuditActionCinemaDelete,
+		TargetType:     domain.AuditTargetCinema,
+		TargetID:       cinema.ID,
+		Before:         cinema.ToCinemaResponse(),
+	})
+
 	return nil
 }
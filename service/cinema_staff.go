@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type cinemaStaffService struct {
+	i                     *do.Injector
+	cinemaStaffRepository domain.CinemaStaffRepository
+	userRepository        domain.UserRepository
+	authorizationService  domain.AuthorizationService
+	sessionService        domain.SessionService
+	cinemaRepository      domain.CinemaRepository
+	auditService          domain.AuditService
+}
+
+func NewCinemaStaffService(i *do.Injector) (domain.CinemaStaffService, error) {
+	cinemaStaffRepository, err := do.Invoke[domain.CinemaStaffRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize CinemaStaffRepository: %w", err)
+	}
+
+	userRepository, err := do.Invoke[domain.UserRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize UserRepository: %w", err)
+	}
+
+	authorizationService, err := do.Invoke[domain.AuthorizationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuthorizationService: %w", err)
+	}
+
+	sessionService, err := do.Invoke[domain.SessionService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize SessionService: %w", err)
+	}
+
+	cinemaRepository, err := do.Invoke[domain.CinemaRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize CinemaRepository: %w", err)
+	}
+
+	auditService, err := do.Invoke[domain.AuditService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuditService: %w", err)
+	}
+
+	return &cinemaStaffService{
+		i:                     i,
+		cinemaStaffRepository: cinemaStaffRepository,
+		userRepository:        userRepository,
+		authorizationService:  authorizationService,
+		sessionService:        sessionService,
+		cinemaRepository:      cinemaRepository,
+		auditService:          auditService,
+	}, nil
+}
+
+func (c *cinemaStaffService) Create(ctx context.Context, cinemaID uuid.UUID, payload domain.CinemaStaffPayload) (*domain.CinemaStaffResponse, error) {
+	if err := c.authorizeRole(ctx, cinemaID, payload.Role); err != nil {
+		return nil, err
+	}
+
+	user, err := c.userRepository.GetByEmail(ctx, payload.Email)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve user by email %s: %w", payload.Email, err)
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	existingStaff, err := c.cinemaStaffRepository.GetByCinemaAndUser(ctx, cinemaID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve cinema staff for user ID %s: %w", user.ID, err)
+	}
+
+	if existingStaff != nil {
+		return nil, domain.ErrCinemaStaffExists
+	}
+
+	staff := payload.ToCinemaStaff(cinemaID, user.ID)
+	staff.User = *user
+
+	if err := c.cinemaStaffRepository.Create(ctx, *staff); err != nil {
+		return nil, fmt.Errorf("error to add user ID %s to cinema ID %s: %w", user.ID, cinemaID, err)
+	}
+
+	response := staff.ToCinemaStaffResponse()
+	c.recordAudit(ctx, cinemaID, domain.AuditActionCinemaStaffGrant, staff.ID, nil, response)
+
+	return response, nil
+}
+
+func (c *cinemaStaffService) GetAll(ctx context.Context, cinemaID uuid.UUID) ([]*domain.CinemaStaffResponse, error) {
+	if err := c.authorizationService.Authorize(ctx, cinemaID, domain.PermissionStaffManage); err != nil {
+		return nil, err
+	}
+
+	staff, err := c.cinemaStaffRepository.GetAllByCinemaID(ctx, cinemaID)
+	if err != nil {
+		return nil, fmt.Errorf("error to fetch staff of cinema ID %s: %w", cinemaID, err)
+	}
+
+	var staffResponse []*domain.CinemaStaffResponse
+	for _, member := range staff {
+		staffResponse = append(staffResponse, member.ToCinemaStaffResponse())
+	}
+
+	return staffResponse, nil
+}
+
+func (c *cinemaStaffService) Delete(ctx context.Context, cinemaID, staffID uuid.UUID) error {
+	if err := c.authorizationService.Authorize(ctx, cinemaID, domain.PermissionStaffManage); err != nil {
+		return err
+	}
+
+	staff, err := c.cinemaStaffRepository.GetByID(ctx, staffID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve cinema staff by ID %s: %w", staffID, err)
+	}
+
+	if staff == nil || staff.CinemaID != cinemaID {
+		return domain.ErrCinemaStaffNotFound
+	}
+
+	if staff.Role == domain.CinemaRoleManager {
+		if err := c.authorizationService.Authorize(ctx, cinemaID, domain.PermissionManagerManage); err != nil {
+			return err
+		}
+	}
+
+	if err := c.cinemaStaffRepository.Delete(ctx, staffID); err != nil {
+		return fmt.Errorf("error to remove staff ID %s from cinema ID %s: %w", staffID, cinemaID, err)
+	}
+
+	if err := c.sessionService.RevokeAll(ctx, staff.UserID); err != nil {
+		return fmt.Errorf("error to revoke sessions of user ID %s: %w", staff.UserID, err)
+	}
+
+	c.recordAudit(ctx, cinemaID, domain.AuditActionCinemaStaffRevoke, staff.ID, staff.ToCinemaStaffResponse(), nil)
+
+	return nil
+}
+
+// recordAudit resolves the organization of the cinema so staff changes show
+// up in the organization audit log.
+func (c *cinemaStaffService) recordAudit(ctx context.Context, cinemaID uuid.UUID, action domain.AuditAction, staffID uuid.UUID, before, after *domain.CinemaStaffResponse) {
+	entry := domain.AuditEntry{
+		Action:     action,
+		TargetType: domain.AuditTargetCinemaStaff,
+		TargetID:   staffID,
+		Before:     before,
+		After:      after,
+	}
+
+	cinema, err := c.cinemaRepository.GetByID(ctx, cinemaID)
+	if err != nil {
+		slog.Error("Failed to resolve cinema organization for audit", slog.String("cinemaId", cinemaID.String()), slog.String("error", err.Error()))
+	} else if cinema != nil {
+		entry.OrganizationID = &cinema.OrganizationID
+	}
+
+	c.auditService.Record(ctx, entry)
+}
+
+func (c *cinemaStaffService) authorizeRole(ctx context.Context, cinemaID uuid.UUID, role domain.CinemaRole) error {
+	if err := c.authorizationService.Authorize(ctx, cinemaID, domain.PermissionStaffManage); err != nil {
+		return err
+	}
+
+	if role == domain.CinemaRoleManager {
+		return c.authorizationService.Authorize(ctx, cinemaID, domain.PermissionManagerManage)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type dependentService struct {
+	i                   *do.Injector
+	dependentRepository domain.DependentRepository
+	auditService        domain.AuditService
+}
+
+func NewDependentService(i *do.Injector) (domain.DependentService, error) {
+	dependentRepository, err := do.Invoke[domain.DependentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DependentRepository: %w", err)
+	}
+
+	auditService, err := do.Invoke[domain.AuditService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuditService: %w", err)
+	}
+
+	return &dependentService{
+		i:                   i,
+		dependentRepository: dependentRepository,
+		auditService:        auditService,
+	}, nil
+}
+
+func (d *dependentService) Create(ctx context.Context, payload domain.DependentPayload) (*domain.DependentResponse, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	dependent := payload.ToDependent(session.UserID)
+	if err := d.dependentRepository.Create(ctx, *dependent); err != nil {
+		return nil, fmt.Errorf("error to create dependent for user ID %s: %w", session.UserID, err)
+	}
+
+	response := dependent.ToDependentResponse()
+	d.auditService.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditActionDependentCreate,
+		TargetType: domain.AuditTargetDependent,
+		TargetID:   dependent.ID,
+		After:      response,
+	})
+
+	return response, nil
+}
+
+func (d *dependentService) GetAll(ctx context.Context) ([]*domain.DependentResponse, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	dependents, err := d.dependentRepository.GetAllByUserID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to fetch dependents of user ID %s: %w", session.UserID, err)
+	}
+
+	response := make([]*domain.DependentResponse, 0, len(dependents))
+	for _, dependent := range dependents {
+		response = append(response, dependent.ToDependentResponse())
+	}
+
+	return response, nil
+}
+
+// Update changes the name of the dependent. The birth date is fixed once the
+// dependent is created, as it drives the age checks of ticket sales.
+func (d *dependentService) Update(ctx context.Context, dependentID uuid.UUID, payload domain.UpdateDependentPayload) (*domain.DependentResponse, error) {
+	dependent, err := d.getOwnedDependent(ctx, dependentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.BirthDate != nil && !sameDate(dependent.BirthDate, *payload.BirthDate) {
+		return nil, domain.ErrBirthDateLocked
+	}
+
+	if payload.FirstName != nil {
+		dependent.FirstName = *payload.FirstName
+	}
+
+	if payload.LastName != nil {
+		dependent.LastName = *payload.LastName
+	}
+
+	dependent.UpdatedAt = time.Now().UTC()
+
+	if err := d.dependentRepository.Update(ctx, *dependent); err != nil {
+		return nil, fmt.Errorf("error to update dependent ID %s: %w", dependentID, err)
+	}
+
+	return dependent.ToDependentResponse(), nil
+}
+
+func (d *dependentService) Delete(ctx context.Context, dependentID uuid.UUID) error {
+	if _, err := d.getOwnedDependent(ctx, dependentID); err != nil {
+		return err
+	}
+
+	if err := d.dependentRepository.Delete(ctx, dependentID); err != nil {
+		return fmt.Errorf("error to delete dependent ID %s: %w", dependentID, err)
+	}
+
+	return nil
+}
+
+func (d *dependentService) getOwnedDependent(ctx context.Context, dependentID uuid.UUID) (*domain.Dependent, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	dependent, err := d.dependentRepository.GetByID(ctx, dependentID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve dependent by ID %s: %w", dependentID, err)
+	}
+
+	if dependent == nil || dependent.UserID != session.UserID {
+		return nil, domain.ErrDependentNotFound
+	}
+
+	return dependent, nil
+}
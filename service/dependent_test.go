@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependentService_Create_WhenPayloadIsValid_ShouldCreateDependentForGuardian(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dependentRepositoryMock := mock.NewMockDependentRepository(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+	dependentService := &dependentService{dependentRepository: dependentRepositoryMock, auditService: auditServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	payload := domain.DependentPayload{FirstName: "Pedro", LastName: "Souza", BirthDate: time.Date(2016, time.May, 10, 0, 0, 0, 0, time.UTC)}
+
+	dependentRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, dependent domain.Dependent) error {
+			assert.Equal(t, session.UserID, dependent.UserID)
+			return nil
+		})
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry domain.AuditEntry) {
+		assert.Equal(t, domain.AuditActionDependentCreate, entry.Action)
+	})
+
+	response, err := dependentService.Create(ctx, payload)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Pedro", response.FirstName)
+	assert.Equal(t, payload.BirthDate, response.BirthDate)
+}
+
+func TestDependentService_Update_WhenDependentBelongsToAnotherUser_ShouldReturnErrDependentNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dependentRepositoryMock := mock.NewMockDependentRepository(ctrl)
+	dependentService := &dependentService{dependentRepository: dependentRepositoryMock}
+
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: uuid.New()})
+	dependent := &domain.Dependent{ID: uuid.New(), UserID: uuid.New()}
+	firstName := "Other"
+
+	dependentRepositoryMock.EXPECT().GetByID(gomock.Any(), dependent.ID).Return(dependent, nil)
+
+	response, err := dependentService.Update(ctx, dependent.ID, domain.UpdateDependentPayload{FirstName: &firstName})
+
+	assert.ErrorIs(t, err, domain.ErrDependentNotFound)
+	assert.Nil(t, response)
+}
+
+func TestDependentService_Update_WhenBirthDateChanges_ShouldReturnErrBirthDateLocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dependentRepositoryMock := mock.NewMockDependentRepository(ctrl)
+	dependentService := &dependentService{dependentRepository: dependentRepositoryMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	dependent := &domain.Dependent{ID: uuid.New(), UserID: session.UserID, BirthDate: time.Date(2016, time.May, 10, 0, 0, 0, 0, time.UTC)}
+	birthDate := time.Date(2000, time.May, 10, 0, 0, 0, 0, time.UTC)
+
+	dependentRepositoryMock.EXPECT().GetByID(gomock.Any(), dependent.ID).Return(dependent, nil)
+
+	response, err := dependentService.Update(ctx, dependent.ID, domain.UpdateDependentPayload{BirthDate: &birthDate})
+
+	assert.ErrorIs(t, err, domain.ErrBirthDateLocked)
+	assert.Nil(t, response)
+}
+
+func TestDependentService_Update_WhenBirthDateIsUnchanged_ShouldUpdateName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dependentRepositoryMock := mock.NewMockDependentRepository(ctrl)
+	dependentService := &dependentService{dependentRepository: dependentRepositoryMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	birthDate := time.Date(2016, time.May, 10, 0, 0, 0, 0, time.UTC)
+	dependent := &domain.Dependent{ID: uuid.New(), UserID: session.UserID, FirstName: "Pedro", BirthDate: birthDate}
+	firstName := "Paulo"
+
+	dependentRepositoryMock.EXPECT().GetByID(gomock.Any(), dependent.ID).Return(dependent, nil)
+	dependentRepositoryMock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	response, err := dependentService.Update(ctx, dependent.ID, domain.UpdateDependentPayload{FirstName: &firstName, BirthDate: &birthDate})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Paulo", response.FirstName)
+	assert.Equal(t, birthDate, response.BirthDate)
+}
+
+func TestDependentService_Delete_WhenDependentIsOwned_ShouldDeleteDependent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dependentRepositoryMock := mock.NewMockDependentRepository(ctrl)
+	dependentService := &dependentService{dependentRepository: dependentRepositoryMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	dependent := &domain.Dependent{ID: uuid.New(), UserID: session.UserID}
+
+	dependentRepositoryMock.EXPECT().GetByID(gomock.Any(), dependent.ID).Return(dependent, nil)
+	dependentRepositoryMock.EXPECT().Delete(gomock.Any(), dependent.ID).Return(nil)
+
+	err := dependentService.Delete(ctx, dependent.ID)
+
+	assert.NoError(t, err)
+}
@@ -1,9 +1,16 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"image"
+	"image/jpeg"
+	_ "image/png"
 	"log/slog"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/GSVillas/movie-pass-api/client"
@@ -13,10 +20,20 @@ import (
 	"github.com/samber/do"
 )
 
+const (
+	maxImageDeleteAttempts = 5
+	orphanImageBatchSize   = 100
+	maxImportedDirectors   = 20
+	maxImportedCast        = 100
+)
+
 type movieService struct {
-	i                 *do.Injector
-	movieRepository   domain.MovieRepository
-	cloudFlareService client.CloudFlareService
+	i                    *do.Injector
+	movieRepository      domain.MovieRepository
+	imageStorage         client.ImageStorage
+	metadataProvider     client.MetadataProvider
+	authorizationService domain.AuthorizationService
+	auditService         domain.AuditService
 }
 
 func NewMovieService(i *do.Injector) (domain.MovieService, error) {
@@ -25,15 +42,33 @@ func NewMovieService(i *do.Injector) (domain.MovieService, error) {
 		return nil, err
 	}
 
-	cloudFlareService, err := do.Invoke[client.CloudFlareService](i)
+	imageStorage, err := do.Invoke[client.ImageStorage](i)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataProvider, err := do.Invoke[client.MetadataProvider](i)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationService, err := do.Invoke[domain.AuthorizationService](i)
+	if err != nil {
+		return nil, err
+	}
+
+	auditService, err := do.Invoke[domain.AuditService](i)
 	if err != nil {
 		return nil, err
 	}
 
 	return &movieService{
-		i:                 i,
-		movieRepository:   movieRepository,
-		cloudFlareService: cloudFlareService,
+		i:                    i,
+		movieRepository:      movieRepository,
+		imageStorage:         imageStorage,
+		metadataProvider:     metadataProvider,
+		authorizationService: authorizationService,
+		auditService:         auditService,
 	}, nil
 }
 
@@ -55,6 +90,24 @@ func (m *movieService) GetAllIndicativeRatings(ctx context.Context) ([]*domain.I
 	return indicativeRatingsResponse, nil
 }
 
+func (m *movieService) GetAllGenres(ctx context.Context) ([]*domain.GenreResponse, error) {
+	genres, err := m.movieRepository.GetAllGenres(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error to get all genres %w", err)
+	}
+
+	if len(genres) == 0 {
+		return nil, domain.ErrGenresNotFound
+	}
+
+	var genresResponse []*domain.GenreResponse
+	for _, genre := range genres {
+		genresResponse = append(genresResponse, genre.ToGenreResponse())
+	}
+
+	return genresResponse, nil
+}
+
 func (m *movieService) Create(ctx context.Context, payload domain.MoviePayload) (*domain.MovieResponse, error) {
 	log := slog.With(
 		slog.String("service", "movie"),
@@ -66,6 +119,10 @@ func (m *movieService) Create(ctx context.Context, payload domain.MoviePayload)
 		return nil, domain.ErrUserNotFoundInContext
 	}
 
+	if err := m.authorizationService.AuthorizeOrganization(ctx, payload.OrganizationID, domain.PermissionMovieManage); err != nil {
+		return nil, err
+	}
+
 	indicativeRating, err := m.movieRepository.GetIndicativeRatingByID(ctx, payload.IndicativeRatingID)
 	if err != nil {
 		return nil, fmt.Errorf("error to get indicative rating by id %w", err)
@@ -76,6 +133,7 @@ func (m *movieService) Create(ctx context.Context, payload domain.MoviePayload)
 	}
 
 	movie := payload.ToMovie(session.UserID)
+	movie.RefreshSearchFields()
 
 	if err := m.movieRepository.Create(ctx, *movie); err != nil {
 		return nil, fmt.Errorf("error to create movie %w", err)
@@ -103,6 +161,14 @@ func (m *movieService) Create(ctx context.Context, payload domain.MoviePayload)
 	movieResponse := movie.ToMovieResponse()
 	movieResponse.IndicativeRating = indicativeRatingResponse
 
+	m.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &movie.OrganizationID,
+		Action:         domain.AuditActionMovieCreate,
+		TargetType:     domain.AuditTargetMovie,
+		TargetID:       movie.ID,
+		After:          movieResponse,
+	})
+
 	return movieResponse, nil
 }
 
@@ -132,23 +198,12 @@ func (m *movieService) GetAllByUserID(ctx context.Context, pagination *domain.Pa
 }
 
 func (m *movieService) Update(ctx context.Context, ID uuid.UUID, payload domain.MovieUpdatePayload) (*domain.MovieResponse, error) {
-	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
-	if !ok || session == nil {
-		return nil, domain.ErrUserNotFoundInContext
-	}
-
-	movie, err := m.movieRepository.GetByID(ctx, ID, true)
+	movie, err := m.getOwnedMovie(ctx, ID)
 	if err != nil {
-		return nil, fmt.Errorf("error to get movie by id: %w", err)
+		return nil, err
 	}
 
-	if movie == nil {
-		return nil, domain.ErrMoviesNotFound
-	}
-
-	if movie.UserID != session.UserID {
-		return nil, domain.ErrMovieNotBelongUser
-	}
+	before := movie.ToMovieResponse()
 
 	if payload.IndicativeRatingID != nil {
 		indicativeRating, err := m.movieRepository.GetIndicativeRatingByID(ctx, *payload.IndicativeRatingID)
@@ -170,79 +225,810 @@ func (m *movieService) Update(ctx context.Context, ID uuid.UUID, payload domain.
 		movie.Duration = *payload.Duration
 	}
 
+	if payload.OriginalTitle != nil {
+		movie.OriginalTitle = *payload.OriginalTitle
+	}
+
+	if payload.Synopsis != nil {
+		movie.Synopsis = *payload.Synopsis
+	}
+
+	if payload.ReleaseDate != nil {
+		movie.ReleaseDate = payload.ReleaseDate
+	}
+
+	if payload.Country != nil {
+		movie.Country = *payload.Country
+	}
+
+	if payload.OriginalLanguage != nil {
+		movie.OriginalLanguage = *payload.OriginalLanguage
+	}
+
+	if payload.TrailerURL != nil {
+		movie.TrailerURL = *payload.TrailerURL
+	}
+
+	var genres []*domain.Genre
+	if payload.GenreIDs != nil {
+		genres, err = m.movieRepository.GetGenresByIDs(ctx, *payload.GenreIDs)
+		if err != nil {
+			return nil, fmt.Errorf("error to get genres: %w", err)
+		}
+
+		if len(genres) != len(*payload.GenreIDs) {
+			return nil, domain.ErrGenreNotFound
+		}
+	}
+
+	replacedCredits := make(map[domain.MovieCreditRole][]domain.MovieCredit)
+	if payload.Directors != nil {
+		credits := make([]domain.MovieCredit, 0, len(*payload.Directors))
+		for position, director := range *payload.Directors {
+			credits = append(credits, newMovieCredit(movie.ID, domain.MovieCreditRoleDirector, director, "", position))
+		}
+		replacedCredits[domain.MovieCreditRoleDirector] = credits
+	}
+
+	if payload.Cast != nil {
+		credits := make([]domain.MovieCredit, 0, len(*payload.Cast))
+		for position, member := range *payload.Cast {
+			credits = append(credits, newMovieCredit(movie.ID, domain.MovieCreditRoleCast, member.Name, member.Character, position))
+		}
+		replacedCredits[domain.MovieCreditRoleCast] = credits
+	}
+
+	movie.Credits = mergeCredits(movie.Credits, replacedCredits)
+	movie.RefreshSearchFields()
+
+	movie.UpdatedAt = time.Now().UTC()
 	if err := m.movieRepository.Update(ctx, *movie); err != nil {
 		return nil, fmt.Errorf("error to update movie: %w", err)
 	}
 
-	return movie.ToMovieResponse(), nil
+	if payload.GenreIDs != nil {
+		if err := m.movieRepository.ReplaceGenres(ctx, movie.ID, genres); err != nil {
+			return nil, fmt.Errorf("error to update genres of movie ID %s: %w", movie.ID, err)
+		}
+
+		movie.Genres = make([]domain.Genre, 0, len(genres))
+		for _, genre := range genres {
+			movie.Genres = append(movie.Genres, *genre)
+		}
+	}
+
+	for _, role := range []domain.MovieCreditRole{domain.MovieCreditRoleDirector, domain.MovieCreditRoleCast} {
+		credits, ok := replacedCredits[role]
+		if !ok {
+			continue
+		}
+
+		if err := m.movieRepository.ReplaceCredits(ctx, movie.ID, role, credits); err != nil {
+			return nil, fmt.Errorf("error to update %s credits of movie ID %s: %w", role, movie.ID, err)
+		}
+	}
+
+	response := movie.ToMovieResponse()
+	m.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &movie.OrganizationID,
+		Action:         domain.AuditActionMovieUpdate,
+		TargetType:     domain.AuditTargetMovie,
+		TargetID:       movie.ID,
+		Before:         before,
+		After:          response,
+	})
+
+	return response, nil
 }
 
-func (m *movieService) Delete(ctx context.Context, ID uuid.UUID) error {
+// Delete soft deletes the movie. Movies with sessions still to happen are
+// only deleted when forced; those sessions and their tickets are kept but no
+// new tickets can be sold for them.
+func (m *movieService) Delete(ctx context.Context, ID uuid.UUID, force bool) error {
 	log := slog.With(
 		slog.String("service", "movie"),
 		slog.String("func", "delete"),
 	)
 
-	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
-	if !ok || session == nil {
-		return domain.ErrUserNotFoundInContext
-	}
-
-	movie, err := m.movieRepository.GetByID(ctx, ID, true)
+	movie, err := m.getOwnedMovie(ctx, ID)
 	if err != nil {
-		return fmt.Errorf("error to get movies by id error:%w", err)
+		return err
 	}
 
-	if movie == nil {
-		return domain.ErrMoviesNotFound
+	if !force {
+		futureSessions, err := m.movieRepository.CountFutureSessions(ctx, ID, time.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("error to count future sessions of movie ID %s: %w", ID, err)
+		}
+
+		if futureSessions > 0 {
+			return domain.ErrMovieHasFutureSessions
+		}
 	}
 
-	if movie.UserID != session.UserID {
-		return domain.ErrMovieNotBelongUser
+	if err := m.movieRepository.Delete(ctx, ID); err != nil {
+		return fmt.Errorf("error to delete movie ID %s: %w", ID, err)
 	}
 
+	// Images left behind when queueing fails are picked up by
+	// EnqueueOrphanImageDeletes.
 	for _, image := range movie.Images {
-		task := domain.MovieImageDeleteTask{
-			CloudFlareID: image.CloudFlareID,
-		}
-
-		if err := m.movieRepository.AddDeleteTaskToQueue(ctx, task); err != nil {
-			log.Error(err.Error())
-		}
+		m.queueImageDeletes(ctx, log, image.DeleteTasks())
 	}
 
+	m.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &movie.OrganizationID,
+		Action:         domain.AuditActionMovieDelete,
+		TargetType:     domain.AuditTargetMovie,
+		TargetID:       movie.ID,
+		Before:         movie.ToMovieResponse(),
+	})
+
 	return nil
 }
 
+// ProcessUploadQueue normalizes the image and uploads it with its
+// thumbnail. Files that are not images, or are too large, are dropped, as
+// are images queued once the movie already reached MaxImagesPerMovie.
 func (m *movieService) ProcessUploadQueue(ctx context.Context, task domain.MovieImageUploadTask) error {
-	filename := fmt.Sprintf("movie_%s_image_%d.jpg", task.MovieID.String(), time.Now().Unix())
-	response, err := m.cloudFlareService.UploadImage(task.Image, filename)
+	log := slog.With(
+		slog.String("service", "movie"),
+		slog.String("func", "processUploadQueue"),
+	)
+
+	variants, err := processMovieImage(task.Image)
+	if err != nil {
+		return fmt.Errorf("error to process image of movie ID %s: %w", task.MovieID, err)
+	}
+
+	imageCount, err := m.movieRepository.CountMovieImages(ctx, task.MovieID)
 	if err != nil {
-		return fmt.Errorf("error to upload image to Cloudflare %w", err)
+		return fmt.Errorf("error to count images of movie ID %s: %w", task.MovieID, err)
 	}
 
+	// AddImages checks the limit when queueing, but concurrent requests can
+	// queue more images than are left.
+	if imageCount >= domain.MaxImagesPerMovie {
+		return fmt.Errorf("error to add image to movie ID %s: %w", task.MovieID, domain.ErrMovieImageLimitReached)
+	}
+
+	position, err := m.movieRepository.GetNextImagePosition(ctx, task.MovieID)
+	if err != nil {
+		return fmt.Errorf("error to get the next image position of movie ID %s: %w", task.MovieID, err)
+	}
+
+	// The first image of a movie becomes its cover so list views always
+	// have one to show.
 	movieImage := domain.MovieImage{
-		ID:           uuid.New(),
-		MovieID:      task.MovieID,
-		ImageURL:     response.URL,
-		CloudFlareID: response.ID,
+		ID:        uuid.New(),
+		MovieID:   task.MovieID,
+		Position:  position,
+		IsCover:   imageCount == 0,
+		AltText:   task.AltText,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	var uploaded []domain.MovieImageDeleteTask
+	for index, variant := range variants {
+		filename := fmt.Sprintf("movie_%s_%s_%d.jpg", task.MovieID.String(), variant.variant, time.Now().Unix())
+		response, err := m.imageStorage.Upload(ctx, variant.data, filename)
+		if err != nil {
+			m.queueImageDeletes(ctx, log, uploaded)
+			return fmt.Errorf("error to upload image: %w", err)
+		}
+		uploaded = append(uploaded, domain.MovieImageDeleteTask{StorageID: response.ID, Storage: m.imageStorage.Name()})
+
+		if index == 0 {
+			movieImage.Variant = variant.variant
+			movieImage.ImageURL = response.URL
+			movieImage.StorageID = response.ID
+			movieImage.Storage = m.imageStorage.Name()
+			movieImage.Width = variant.width
+			movieImage.Height = variant.height
+			continue
+		}
+
+		movieImage.Variants = append(movieImage.Variants, domain.MovieImage{
+			ID:        uuid.New(),
+			MovieID:   task.MovieID,
+			ParentID:  &movieImage.ID,
+			Variant:   variant.variant,
+			ImageURL:  response.URL,
+			StorageID: response.ID,
+			Storage:   m.imageStorage.Name(),
+			Width:     variant.width,
+			Height:    variant.height,
+			Position:  movieImage.Position,
+			AltText:   task.AltText,
+			CreatedAt: movieImage.CreatedAt,
+		})
 	}
 
 	if err := m.movieRepository.CreateMovieImage(ctx, movieImage); err != nil {
+		m.queueImageDeletes(ctx, log, uploaded)
 		return fmt.Errorf("error to save movie image to the database error:%w", err)
 	}
 
 	return nil
 }
 
+// queueImageDeletes cleans up files uploaded for an image that could not be
+// saved, since no row points to them for EnqueueOrphanImageDeletes to find.
+func (m *movieService) queueImageDeletes(ctx context.Context, log *slog.Logger, tasks []domain.MovieImageDeleteTask) {
+	for _, task := range tasks {
+		if err := m.movieRepository.AddDeleteTaskToQueue(ctx, task); err != nil {
+			log.Error(err.Error())
+		}
+	}
+}
+
+// ProcessDeleteQueue deletes the file from the backend that stored it. The
+// other backends report missing files as deleted, so tasks of another
+// backend are pushed back onto the queue for a worker running with that
+// backend.
 func (m *movieService) ProcessDeleteQueue(ctx context.Context, task domain.MovieImageDeleteTask) error {
-	if err := m.cloudFlareService.DeleteImage(task.CloudFlareID); err != nil {
-		return fmt.Errorf("error to delete image to Cloudflare %w", err)
+	// Tasks queued before images recorded their backend are all Cloudflare.
+	if task.Storage == "" {
+		task.Storage = client.ImageStorageCloudFlare
+	}
+
+	if task.Storage != m.imageStorage.Name() {
+		if err := m.movieRepository.AddDeleteTaskToQueue(ctx, task); err != nil {
+			return fmt.Errorf("error to requeue image delete task of %s: %w", task.Storage, err)
+		}
+
+		return fmt.Errorf("error to delete stored image ID %s from %s, it is stored in %s: %w", task.StorageID, m.imageStorage.Name(), task.Storage, domain.ErrImageStorageMismatch)
+	}
+
+	if err := m.imageStorage.Delete(ctx, task.StorageID); err != nil {
+		task.Attempts++
+		if task.Attempts < maxImageDeleteAttempts {
+			if queueErr := m.movieRepository.AddDeleteTaskToQueue(ctx, task); queueErr != nil {
+				return fmt.Errorf("error to requeue image delete task: %w", queueErr)
+			}
+		}
+
+		return fmt.Errorf("error to delete stored image (attempt %d) %w", task.Attempts, err)
 	}
 
-	if err := m.movieRepository.DeleteMovieImage(ctx, task.CloudFlareID); err != nil {
+	if err := m.movieRepository.DeleteMovieImage(ctx, task.StorageID); err != nil {
 		return fmt.Errorf("error to delete movie image to the database error:%w", err)
 	}
 
 	return nil
 }
+
+// EnqueueOrphanImageDeletes queues removed images and images of deleted
+// movies that are still stored, so a lost task or a failed queue push is
+// eventually retried.
+func (m *movieService) EnqueueOrphanImageDeletes(ctx context.Context) error {
+	movieImages, err := m.movieRepository.GetImagesPendingDeletion(ctx, m.imageStorage.Name(), orphanImageBatchSize)
+	if err != nil {
+		return fmt.Errorf("error to retrieve images pending deletion: %w", err)
+	}
+
+	for _, movieImage := range movieImages {
+		task := domain.MovieImageDeleteTask{
+			StorageID: movieImage.StorageID,
+			Storage:   movieImage.Storage,
+		}
+
+		if err := m.movieRepository.AddDeleteTaskToQueue(ctx, task); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *movieService) AddImages(ctx context.Context, movieID uuid.UUID, payload domain.MovieImagesPayload) error {
+	log := slog.With(
+		slog.String("service", "movie"),
+		slog.String("func", "addImages"),
+	)
+
+	movie, err := m.getOwnedMovie(ctx, movieID)
+	if err != nil {
+		return err
+	}
+
+	imageCount, err := m.movieRepository.CountMovieImages(ctx, movie.ID)
+	if err != nil {
+		return fmt.Errorf("error to count images of movie ID %s: %w", movie.ID, err)
+	}
+
+	if int(imageCount)+len(payload.Images) > domain.MaxImagesPerMovie {
+		return domain.ErrMovieImageLimitReached
+	}
+
+	for _, image := range payload.Images {
+		imageBytes, err := utils.ConvertImageToBytes(image)
+		if err != nil {
+			log.Error("error to convert image to bytes", slog.String("error", err.Error()))
+			continue
+		}
+
+		task := domain.MovieImageUploadTask{
+			MovieID: movie.ID,
+			Image:   imageBytes,
+			UserID:  movie.UserID,
+			AltText: payload.AltText,
+		}
+
+		if err := m.movieRepository.AddUploadTaskToQueue(ctx, task); err != nil {
+			return fmt.Errorf("error to queue image upload of movie ID %s: %w", movie.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveImage soft deletes the image and queues the file deletion. When the
+// cover is removed the next image in order takes its place.
+func (m *movieService) RemoveImage(ctx context.Context, movieID, imageID uuid.UUID) error {
+	log := slog.With(
+		slog.String("service", "movie"),
+		slog.String("func", "removeImage"),
+	)
+
+	movie, err := m.getOwnedMovie(ctx, movieID)
+	if err != nil {
+		return err
+	}
+
+	before := movie.ToMovieResponse()
+
+	var removed *domain.MovieImage
+	var remaining []domain.MovieImage
+	for index := range movie.Images {
+		if movie.Images[index].ID == imageID {
+			removed = &movie.Images[index]
+			continue
+		}
+		remaining = append(remaining, movie.Images[index])
+	}
+
+	if removed == nil {
+		return domain.ErrMovieImageNotFound
+	}
+
+	if err := m.movieRepository.RemoveMovieImage(ctx, removed.ID); err != nil {
+		return fmt.Errorf("error to remove image ID %s: %w", removed.ID, err)
+	}
+
+	if removed.IsCover && len(remaining) > 0 {
+		if err := m.movieRepository.SetMovieCover(ctx, movie.ID, remaining[0].ID); err != nil {
+			return fmt.Errorf("error to set cover of movie ID %s: %w", movie.ID, err)
+		}
+		remaining[0].IsCover = true
+	}
+
+	// A failed push is picked up by EnqueueOrphanImageDeletes.
+	m.queueImageDeletes(ctx, log, removed.DeleteTasks())
+
+	movie.Images = remaining
+	m.recordImageChange(ctx, movie, before)
+
+	return nil
+}
+
+func (m *movieService) ReorderImages(ctx context.Context, movieID uuid.UUID, payload domain.MovieImageOrderPayload) ([]*domain.MovieImageResponse, error) {
+	movie, err := m.getOwnedMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payload.ImageIDs) != len(movie.Images) {
+		return nil, domain.ErrMovieImageOrderMismatch
+	}
+
+	imagesByID := make(map[uuid.UUID]domain.MovieImage, len(movie.Images))
+	for _, image := range movie.Images {
+		imagesByID[image.ID] = image
+	}
+
+	before := movie.ToMovieResponse()
+
+	ordered := make([]domain.MovieImage, 0, len(payload.ImageIDs))
+	for position, imageID := range payload.ImageIDs {
+		image, ok := imagesByID[imageID]
+		if !ok {
+			return nil, domain.ErrMovieImageOrderMismatch
+		}
+
+		image.Position = position
+		ordered = append(ordered, image)
+	}
+
+	if err := m.movieRepository.ReorderMovieImages(ctx, movie.ID, payload.ImageIDs); err != nil {
+		return nil, fmt.Errorf("error to reorder images of movie ID %s: %w", movie.ID, err)
+	}
+
+	movie.Images = ordered
+	m.recordImageChange(ctx, movie, before)
+
+	return movie.ToMovieResponse().MovieImages, nil
+}
+
+func (m *movieService) SetCoverImage(ctx context.Context, movieID, imageID uuid.UUID) ([]*domain.MovieImageResponse, error) {
+	movie, err := m.getOwnedMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := movie.ToMovieResponse()
+
+	found := false
+	for index := range movie.Images {
+		movie.Images[index].IsCover = movie.Images[index].ID == imageID
+		found = found || movie.Images[index].IsCover
+	}
+
+	if !found {
+		return nil, domain.ErrMovieImageNotFound
+	}
+
+	if err := m.movieRepository.SetMovieCover(ctx, movie.ID, imageID); err != nil {
+		return nil, fmt.Errorf("error to set cover of movie ID %s: %w", movie.ID, err)
+	}
+
+	m.recordImageChange(ctx, movie, before)
+
+	return movie.ToMovieResponse().MovieImages, nil
+}
+
+func (m *movieService) UpdateImage(ctx context.Context, movieID, imageID uuid.UUID, payload domain.MovieImageUpdatePayload) (*domain.MovieImageResponse, error) {
+	movie, err := m.getOwnedMovie(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := movie.ToMovieResponse()
+
+	var image *domain.MovieImage
+	for index := range movie.Images {
+		if movie.Images[index].ID == imageID {
+			image = &movie.Images[index]
+			break
+		}
+	}
+
+	if image == nil {
+		return nil, domain.ErrMovieImageNotFound
+	}
+
+	image.AltText = *payload.AltText
+	image.UpdatedAt = time.Now().UTC()
+
+	if err := m.movieRepository.UpdateMovieImage(ctx, *image); err != nil {
+		return nil, fmt.Errorf("error to update image ID %s: %w", image.ID, err)
+	}
+
+	m.recordImageChange(ctx, movie, before)
+
+	return image.ToMovieImageResponse(), nil
+}
+
+func (m *movieService) SearchMetadata(ctx context.Context, payload domain.MovieSearchPayload) ([]*domain.MovieMetadataResponse, error) {
+	movies, err := m.metadataProvider.SearchMovies(ctx, payload.Query)
+	if err != nil {
+		return nil, fmt.Errorf("error to search movies in %s: %w", m.metadataProvider.Name(), err)
+	}
+
+	if len(movies) == 0 {
+		return nil, domain.ErrMetadataMovieNotFound
+	}
+
+	response := make([]*domain.MovieMetadataResponse, 0, len(movies))
+	for _, movie := range movies {
+		response = append(response, &domain.MovieMetadataResponse{
+			ExternalID:    movie.ExternalID,
+			Source:        m.metadataProvider.Name(),
+			Title:         movie.Title,
+			OriginalTitle: movie.OriginalTitle,
+			ReleaseDate:   movie.ReleaseDate,
+			PosterURL:     movie.PosterURL,
+		})
+	}
+
+	return response, nil
+}
+
+// Import creates a movie from the metadata provider. The poster is queued
+// before the backdrop so it becomes the cover; images that fail to download
+// are only logged, they can still be added by hand.
+func (m *movieService) Import(ctx context.Context, payload domain.MovieImportPayload) (*domain.MovieResponse, error) {
+	log := slog.With(
+		slog.String("service", "movie"),
+		slog.String("func", "import"),
+	)
+
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	if err := m.authorizationService.AuthorizeOrganization(ctx, payload.OrganizationID, domain.PermissionMovieManage); err != nil {
+		return nil, err
+	}
+
+	source := m.metadataProvider.Name()
+	existing, err := m.movieRepository.GetByExternalID(ctx, source, payload.ExternalID)
+	if err != nil {
+		return nil, fmt.Errorf("error to get movie by external id: %w", err)
+	}
+
+	if existing != nil {
+		return nil, domain.ErrMovieAlreadyImported
+	}
+
+	metadata, err := m.metadataProvider.GetMovie(ctx, payload.ExternalID)
+	if err != nil {
+		if errors.Is(err, client.ErrMetadataMovieNotFound) {
+			return nil, domain.ErrMetadataMovieNotFound
+		}
+
+		return nil, fmt.Errorf("error to get movie %s from %s: %w", payload.ExternalID, source, err)
+	}
+
+	if metadata.Runtime <= 0 {
+		return nil, domain.ErrMovieMetadataIncomplete
+	}
+
+	indicativeRating, err := m.importIndicativeRating(ctx, payload.IndicativeRatingID, metadata.Certification)
+	if err != nil {
+		return nil, err
+	}
+
+	genres, err := m.movieRepository.GetGenresByNames(ctx, metadata.Genres)
+	if err != nil {
+		return nil, fmt.Errorf("error to get genres: %w", err)
+	}
+
+	movie := &domain.Movie{
+		ID:                 uuid.New(),
+		IndicativeRatingID: indicativeRating.ID,
+		UserID:             session.UserID,
+		OrganizationID:     payload.OrganizationID,
+		Title:              utils.TruncateString(metadata.Title, 255),
+		Duration:           metadata.Runtime,
+		OriginalTitle:      utils.TruncateString(metadata.OriginalTitle, 255),
+		Synopsis:           utils.TruncateString(metadata.Synopsis, 5000),
+		ReleaseDate:        metadata.ReleaseDate,
+		OriginalLanguage:   utils.TruncateString(metadata.OriginalLanguage, 35),
+		ExternalSource:     source,
+		ExternalID:         metadata.ExternalID,
+		CreatedAt:          time.Now().UTC(),
+	}
+
+	if country := strings.ToUpper(metadata.Country); len(country) == 2 {
+		movie.Country = country
+	}
+
+	if len(metadata.TrailerURL) <= 255 {
+		movie.TrailerURL = metadata.TrailerURL
+	}
+
+	for _, genre := range genres {
+		movie.Genres = append(movie.Genres, *genre)
+	}
+
+	for position, director := range metadata.Directors[:min(len(metadata.Directors), maxImportedDirectors)] {
+		movie.Credits = append(movie.Credits, newMovieCredit(movie.ID, domain.MovieCreditRoleDirector, utils.TruncateString(director, 255), "", position))
+	}
+
+	for position, member := range metadata.Cast[:min(len(metadata.Cast), maxImportedCast)] {
+		movie.Credits = append(movie.Credits, newMovieCredit(movie.ID, domain.MovieCreditRoleCast, utils.TruncateString(member.Name, 255), utils.TruncateString(member.Character, 255), position))
+	}
+
+	movie.RefreshSearchFields()
+
+	if err := m.movieRepository.Create(ctx, *movie); err != nil {
+		return nil, fmt.Errorf("error to create movie %w", err)
+	}
+
+	images := []struct {
+		url     string
+		altText string
+	}{
+		{url: metadata.PosterURL, altText: "Poster of " + movie.Title},
+		{url: metadata.BackdropURL, altText: "Backdrop of " + movie.Title},
+	}
+
+	for _, image := range images {
+		if image.url == "" {
+			continue
+		}
+
+		imageBytes, err := m.metadataProvider.DownloadImage(ctx, image.url)
+		if err != nil {
+			log.Error("error to download image", slog.String("url", image.url), slog.String("error", err.Error()))
+			continue
+		}
+
+		task := domain.MovieImageUploadTask{
+			MovieID: movie.ID,
+			Image:   imageBytes,
+			UserID:  session.UserID,
+			AltText: utils.TruncateString(image.altText, 255),
+		}
+
+		if err := m.movieRepository.AddUploadTaskToQueue(ctx, task); err != nil {
+			log.Error(err.Error())
+		}
+	}
+
+	movie.IndicativeRating = *indicativeRating
+	movieResponse := movie.ToMovieResponse()
+
+	m.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &movie.OrganizationID,
+		Action:         domain.AuditActionMovieCreate,
+		TargetType:     domain.AuditTargetMovie,
+		TargetID:       movie.ID,
+		After:          movieResponse,
+	})
+
+	return movieResponse, nil
+}
+
+// importIndicativeRating uses the rating chosen by the admin, falling back to
+// the one matching the provider certification.
+func (m *movieService) importIndicativeRating(ctx context.Context, indicativeRatingID *uuid.UUID, certification string) (*domain.IndicativeRating, error) {
+	if indicativeRatingID != nil {
+		indicativeRating, err := m.movieRepository.GetIndicativeRatingByID(ctx, *indicativeRatingID)
+		if err != nil {
+			return nil, fmt.Errorf("error to get indicative rating by id %w", err)
+		}
+
+		if indicativeRating == nil {
+			return nil, domain.ErrIndicativeRatingNotFound
+		}
+
+		return indicativeRating, nil
+	}
+
+	description, ok := domain.IndicativeRatingDescription(certification)
+	if !ok {
+		return nil, domain.ErrIndicativeRatingNotMapped
+	}
+
+	indicativeRating, err := m.movieRepository.GetIndicativeRatingByDescription(ctx, description)
+	if err != nil {
+		return nil, fmt.Errorf("error to get indicative rating by description %w", err)
+	}
+
+	if indicativeRating == nil {
+		return nil, domain.ErrIndicativeRatingNotMapped
+	}
+
+	return indicativeRating, nil
+}
+
+// getOwnedMovie loads the movie once the caller may manage the movies of
+// its organization.
+func (m *movieService) getOwnedMovie(ctx context.Context, movieID uuid.UUID) (*domain.Movie, error) {
+	movie, err := m.movieRepository.GetByID(ctx, movieID, true)
+	if err != nil {
+		return nil, fmt.Errorf("error to get movie by id: %w", err)
+	}
+
+	if movie == nil {
+		return nil, domain.ErrMoviesNotFound
+	}
+
+	if err := m.authorizationService.AuthorizeOrganization(ctx, movie.OrganizationID, domain.PermissionMovieManage); err != nil {
+		return nil, err
+	}
+
+	return movie, nil
+}
+
+func (m *movieService) recordImageChange(ctx context.Context, movie *domain.Movie, before *domain.MovieResponse) {
+	m.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &movie.OrganizationID,
+		Action:         domain.AuditActionMovieUpdate,
+		TargetType:     domain.AuditTargetMovie,
+		TargetID:       movie.ID,
+		Before:         before,
+		After:          movie.ToMovieResponse(),
+	})
+}
+
+// mergeCredits keeps the current credits of every role that is not replaced.
+func mergeCredits(current []domain.MovieCredit, replaced map[domain.MovieCreditRole][]domain.MovieCredit) []domain.MovieCredit {
+	var merged []domain.MovieCredit
+	for _, credit := range current {
+		if _, ok := replaced[credit.Role]; !ok {
+			merged = append(merged, credit)
+		}
+	}
+
+	for _, role := range []domain.MovieCreditRole{domain.MovieCreditRoleDirector, domain.MovieCreditRoleCast} {
+		merged = append(merged, replaced[role]...)
+	}
+
+	return merged
+}
+
+func newMovieCredit(movieID uuid.UUID, role domain.MovieCreditRole, name, character string, position int) domain.MovieCredit {
+	return domain.MovieCredit{
+		ID:        uuid.New(),
+		MovieID:   movieID,
+		Role:      role,
+		Name:      name,
+		Character: character,
+		Position:  position,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+type processedMovieImage struct {
+	variant domain.MovieImageVariant
+	data    []byte
+	width   int
+	height  int
+}
+
+// processMovieImage checks the real content of the file and re-encodes it
+// upright as JPEG, which drops EXIF (GPS included) and any other metadata.
+// The main variant comes first, followed by its thumbnail.
+func processMovieImage(data []byte) ([]processedMovieImage, error) {
+	contentType := http.DetectContentType(data)
+	if !domain.AllowedImageContentTypes[contentType] {
+		return nil, fmt.Errorf("%w: detected %s", domain.ErrInvalidMovieImage, contentType)
+	}
+
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidMovieImage, err.Error())
+	}
+
+	if imageConfig.Width > domain.MaxImageDimension || imageConfig.Height > domain.MaxImageDimension ||
+		imageConfig.Width*imageConfig.Height > domain.MaxImagePixels {
+		return nil, fmt.Errorf("%w: %dx%d", domain.ErrMovieImageTooLarge, imageConfig.Width, imageConfig.Height)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidMovieImage, err.Error())
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = utils.JPEGOrientation(data)
+	}
+	normalized := utils.NormalizeImage(decoded, orientation)
+
+	variant := domain.MovieImageVariantPoster
+	if normalized.Bounds().Dx() > normalized.Bounds().Dy() {
+		variant = domain.MovieImageVariantBackdrop
+	}
+
+	sizes := []struct {
+		variant domain.MovieImageVariant
+		size    domain.ImageSize
+	}{
+		{variant: variant, size: domain.MovieImageVariantSizes[variant]},
+		{variant: domain.MovieImageVariantThumbnail, size: domain.MovieImageThumbnailSizes[variant]},
+	}
+
+	processed := make([]processedMovieImage, 0, len(sizes))
+	for _, size := range sizes {
+		resized := utils.ResizeToFill(normalized, size.size.Width, size.size.Height)
+
+		var buffer bytes.Buffer
+		if err := jpeg.Encode(&buffer, resized, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, fmt.Errorf("error to encode %s image: %w", size.variant, err)
+		}
+
+		processed = append(processed, processedMovieImage{
+			variant: size.variant,
+			data:    buffer.Bytes(),
+			width:   resized.Bounds().Dx(),
+			height:  resized.Bounds().Dy(),
+		})
+	}
+
+	return processed, nil
+}
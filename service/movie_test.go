@@ -0,0 +1,588 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"testing"
+
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newOwnedMovie(userID uuid.UUID) *domain.Movie {
+	return &domain.Movie{
+		ID:             uuid.New(),
+		UserID:         userID,
+		OrganizationID: uuid.New(),
+		Title:          "Central do Brasil",
+		Images:         []domain.MovieImage{{ID: uuid.New(), StorageID: uuid.New()}},
+	}
+}
+
+func TestMovieService_Delete_WhenMovieHasFutureSessions_ShouldReturnErrMovieHasFutureSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+	movieRepositoryMock.EXPECT().CountFutureSessions(gomock.Any(), movie.ID, gomock.Any()).Return(int64(2), nil)
+
+	err := movieService.Delete(ctx, movie.ID, false)
+
+	assert.ErrorIs(t, err, domain.ErrMovieHasFutureSessions)
+}
+
+func TestMovieService_Delete_WhenForced_ShouldSoftDeleteMovieAndQueueImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock, auditService: auditServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+	movieRepositoryMock.EXPECT().Delete(gomock.Any(), movie.ID).Return(nil)
+	movieRepositoryMock.EXPECT().AddDeleteTaskToQueue(gomock.Any(), domain.MovieImageDeleteTask{StorageID: movie.Images[0].StorageID}).Return(nil)
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any())
+
+	err := movieService.Delete(ctx, movie.ID, true)
+
+	assert.NoError(t, err)
+}
+
+func TestMovieService_Delete_WhenCallerCannotManageOrganizationMovies_ShouldReturnErrPermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock}
+
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: uuid.New()})
+	movie := newOwnedMovie(uuid.New())
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(domain.ErrPermissionDenied)
+
+	err := movieService.Delete(ctx, movie.ID, true)
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestMovieService_ProcessDeleteQueue_WhenCloudflareFails_ShouldRequeueWithNextAttempt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	task := domain.MovieImageDeleteTask{StorageID: uuid.New(), Storage: client.ImageStorageCloudFlare, Attempts: 1}
+
+	imageStorageMock.EXPECT().Name().Return(client.ImageStorageCloudFlare).AnyTimes()
+	imageStorageMock.EXPECT().Delete(gomock.Any(), task.StorageID).Return(errors.New("status 503"))
+	movieRepositoryMock.EXPECT().AddDeleteTaskToQueue(gomock.Any(), domain.MovieImageDeleteTask{StorageID: task.StorageID, Storage: client.ImageStorageCloudFlare, Attempts: 2}).Return(nil)
+
+	err := movieService.ProcessDeleteQueue(context.Background(), task)
+
+	assert.Error(t, err)
+}
+
+func TestMovieService_ProcessDeleteQueue_WhenAttemptsAreExhausted_ShouldNotRequeue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	task := domain.MovieImageDeleteTask{StorageID: uuid.New(), Storage: client.ImageStorageCloudFlare, Attempts: maxImageDeleteAttempts - 1}
+
+	imageStorageMock.EXPECT().Name().Return(client.ImageStorageCloudFlare).AnyTimes()
+	imageStorageMock.EXPECT().Delete(gomock.Any(), task.StorageID).Return(errors.New("status 503"))
+
+	err := movieService.ProcessDeleteQueue(context.Background(), task)
+
+	assert.Error(t, err)
+}
+
+func TestMovieService_ProcessDeleteQueue_WhenImageIsInAnotherStorage_ShouldRequeueTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	task := domain.MovieImageDeleteTask{StorageID: uuid.New(), Storage: client.ImageStorageCloudFlare}
+
+	imageStorageMock.EXPECT().Name().Return(client.ImageStorageLocal).AnyTimes()
+	movieRepositoryMock.EXPECT().AddDeleteTaskToQueue(gomock.Any(), task).Return(nil)
+
+	err := movieService.ProcessDeleteQueue(context.Background(), task)
+
+	assert.ErrorIs(t, err, domain.ErrImageStorageMismatch)
+}
+
+func TestMovieService_EnqueueOrphanImageDeletes_WhenImagesOfDeletedMoviesRemain_ShouldQueueThem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	images := []*domain.MovieImage{
+		{ID: uuid.New(), StorageID: uuid.New(), Storage: client.ImageStorageS3},
+		{ID: uuid.New(), StorageID: uuid.New(), Storage: client.ImageStorageS3},
+	}
+
+	imageStorageMock.EXPECT().Name().Return(client.ImageStorageS3).AnyTimes()
+	movieRepositoryMock.EXPECT().GetImagesPendingDeletion(gomock.Any(), client.ImageStorageS3, orphanImageBatchSize).Return(images, nil)
+	movieRepositoryMock.EXPECT().AddDeleteTaskToQueue(gomock.Any(), domain.MovieImageDeleteTask{StorageID: images[0].StorageID, Storage: client.ImageStorageS3}).Return(nil)
+	movieRepositoryMock.EXPECT().AddDeleteTaskToQueue(gomock.Any(), domain.MovieImageDeleteTask{StorageID: images[1].StorageID, Storage: client.ImageStorageS3}).Return(nil)
+
+	err := movieService.EnqueueOrphanImageDeletes(context.Background())
+
+	assert.NoError(t, err)
+}
+
+func TestMovieService_AddImages_WhenLimitIsExceeded_ShouldReturnErrMovieImageLimitReached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+	movieRepositoryMock.EXPECT().CountMovieImages(gomock.Any(), movie.ID).Return(int64(domain.MaxImagesPerMovie), nil)
+
+	payload := domain.MovieImagesPayload{Images: []*multipart.FileHeader{{Filename: "poster.png"}}}
+	err := movieService.AddImages(ctx, movie.ID, payload)
+
+	assert.ErrorIs(t, err, domain.ErrMovieImageLimitReached)
+}
+
+func TestMovieService_RemoveImage_WhenImageIsCover_ShouldPromoteNextImageAndQueueDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock, auditService: auditServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+	movie.Images[0].IsCover = true
+	movie.Images = append(movie.Images, domain.MovieImage{ID: uuid.New(), StorageID: uuid.New(), Position: 1})
+	cover := movie.Images[0]
+	next := movie.Images[1]
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+	movieRepositoryMock.EXPECT().RemoveMovieImage(gomock.Any(), cover.ID).Return(nil)
+	movieRepositoryMock.EXPECT().SetMovieCover(gomock.Any(), movie.ID, next.ID).Return(nil)
+	movieRepositoryMock.EXPECT().AddDeleteTaskToQueue(gomock.Any(), domain.MovieImageDeleteTask{StorageID: cover.StorageID}).Return(nil)
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any())
+
+	err := movieService.RemoveImage(ctx, movie.ID, cover.ID)
+
+	assert.NoError(t, err)
+}
+
+func TestMovieService_RemoveImage_WhenImageBelongsToAnotherMovie_ShouldReturnErrMovieImageNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+
+	err := movieService.RemoveImage(ctx, movie.ID, uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrMovieImageNotFound)
+}
+
+func TestMovieService_ReorderImages_WhenImagesAreMissing_ShouldReturnErrMovieImageOrderMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+	movie.Images = append(movie.Images, domain.MovieImage{ID: uuid.New(), StorageID: uuid.New(), Position: 1})
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+
+	payload := domain.MovieImageOrderPayload{ImageIDs: []uuid.UUID{movie.Images[1].ID, uuid.New()}}
+	response, err := movieService.ReorderImages(ctx, movie.ID, payload)
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, domain.ErrMovieImageOrderMismatch)
+}
+
+func TestMovieService_ReorderImages_WhenOrderIsValid_ShouldReturnImagesInNewOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock, auditService: auditServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+	movie.Images = append(movie.Images, domain.MovieImage{ID: uuid.New(), StorageID: uuid.New(), Position: 1})
+	imageIDs := []uuid.UUID{movie.Images[1].ID, movie.Images[0].ID}
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+	movieRepositoryMock.EXPECT().ReorderMovieImages(gomock.Any(), movie.ID, imageIDs).Return(nil)
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any())
+
+	response, err := movieService.ReorderImages(ctx, movie.ID, domain.MovieImageOrderPayload{ImageIDs: imageIDs})
+
+	assert.NoError(t, err)
+	assert.Equal(t, imageIDs[0], response[0].ID)
+	assert.Equal(t, 0, response[0].Position)
+	assert.Equal(t, 1, response[1].Position)
+}
+
+func TestMovieService_ProcessUploadQueue_WhenMovieHasNoImages_ShouldSaveImageAsCover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	task := domain.MovieImageUploadTask{MovieID: uuid.New(), Image: newTestJPEG(t, 1000, 1500, nil), AltText: "Poster"}
+
+	imageStorageMock.EXPECT().Name().Return(client.ImageStorageLocal).AnyTimes()
+	imageStorageMock.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(&client.UploadImageResponse{ID: uuid.New(), URL: "https://images.test/poster"}, nil).Times(2)
+	movieRepositoryMock.EXPECT().CountMovieImages(gomock.Any(), task.MovieID).Return(int64(0), nil)
+	movieRepositoryMock.EXPECT().GetNextImagePosition(gomock.Any(), task.MovieID).Return(0, nil)
+	movieRepositoryMock.EXPECT().CreateMovieImage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, movieImage domain.MovieImage) error {
+		assert.True(t, movieImage.IsCover)
+		assert.Equal(t, client.ImageStorageLocal, movieImage.Storage)
+		assert.Equal(t, client.ImageStorageLocal, movieImage.Variants[0].Storage)
+		assert.Equal(t, 0, movieImage.Position)
+		assert.Equal(t, "Poster", movieImage.AltText)
+		assert.Equal(t, domain.MovieImageVariantPoster, movieImage.Variant)
+		assert.Equal(t, 780, movieImage.Width)
+		assert.Equal(t, 1170, movieImage.Height)
+		assert.Len(t, movieImage.Variants, 1)
+		assert.Equal(t, domain.MovieImageVariantThumbnail, movieImage.Variants[0].Variant)
+		assert.Equal(t, movieImage.ID, *movieImage.Variants[0].ParentID)
+		assert.Equal(t, 200, movieImage.Variants[0].Width)
+		return nil
+	})
+
+	err := movieService.ProcessUploadQueue(context.Background(), task)
+
+	assert.NoError(t, err)
+}
+
+func TestMovieService_ProcessUploadQueue_WhenFileIsNotAnImage_ShouldReturnErrInvalidMovieImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	task := domain.MovieImageUploadTask{MovieID: uuid.New(), Image: []byte("<html><script>alert(1)</script></html>")}
+
+	err := movieService.ProcessUploadQueue(context.Background(), task)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidMovieImage)
+}
+
+func TestMovieService_ProcessUploadQueue_WhenImageIsTooWide_ShouldReturnErrMovieImageTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, png.Encode(&buffer, image.NewGray(image.Rect(0, 0, domain.MaxImageDimension+1, 1))))
+	task := domain.MovieImageUploadTask{MovieID: uuid.New(), Image: buffer.Bytes()}
+
+	err := movieService.ProcessUploadQueue(context.Background(), task)
+
+	assert.ErrorIs(t, err, domain.ErrMovieImageTooLarge)
+}
+
+func TestMovieService_ProcessUploadQueue_WhenJPEGIsRotated_ShouldUploadUprightImageWithoutExif(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	// Stored landscape, displayed portrait once rotated by 90 degrees.
+	exif := []byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08\x00\x01\x01\x12\x00\x03\x00\x00\x00\x01\x00\x06\x00\x00\x00\x00\x00\x00")
+	task := domain.MovieImageUploadTask{MovieID: uuid.New(), Image: newTestJPEG(t, 900, 600, exif)}
+
+	imageStorageMock.EXPECT().Name().Return(client.ImageStorageLocal).AnyTimes()
+	imageStorageMock.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, imageBytes []byte, _ string) (*client.UploadImageResponse, error) {
+		assert.False(t, bytes.Contains(imageBytes, []byte("Exif")))
+		return &client.UploadImageResponse{ID: uuid.New(), URL: "https://images.test/poster"}, nil
+	}).Times(2)
+	movieRepositoryMock.EXPECT().CountMovieImages(gomock.Any(), task.MovieID).Return(int64(1), nil)
+	movieRepositoryMock.EXPECT().GetNextImagePosition(gomock.Any(), task.MovieID).Return(3, nil)
+	movieRepositoryMock.EXPECT().CreateMovieImage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, movieImage domain.MovieImage) error {
+		assert.False(t, movieImage.IsCover)
+		assert.Equal(t, 3, movieImage.Position)
+		assert.Equal(t, domain.MovieImageVariantPoster, movieImage.Variant)
+		assert.Equal(t, 600, movieImage.Width)
+		assert.Equal(t, 900, movieImage.Height)
+		return nil
+	})
+
+	err := movieService.ProcessUploadQueue(context.Background(), task)
+
+	assert.NoError(t, err)
+}
+
+func TestMovieService_ProcessUploadQueue_WhenMovieReachedImageLimit_ShouldDropImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	imageStorageMock := mock.NewMockImageStorage(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, imageStorage: imageStorageMock}
+
+	task := domain.MovieImageUploadTask{MovieID: uuid.New(), Image: newTestJPEG(t, 1000, 1500, nil)}
+
+	movieRepositoryMock.EXPECT().CountMovieImages(gomock.Any(), task.MovieID).Return(int64(domain.MaxImagesPerMovie), nil)
+
+	err := movieService.ProcessUploadQueue(context.Background(), task)
+
+	assert.ErrorIs(t, err, domain.ErrMovieImageLimitReached)
+}
+
+// newTestJPEG encodes a blank JPEG, inserting exif as an APP1 segment right
+// after the start of image marker when given.
+func newTestJPEG(t *testing.T, width, height int, exif []byte) []byte {
+	var buffer bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+
+	data := buffer.Bytes()
+	if exif == nil {
+		return data
+	}
+
+	segment := []byte{0xFF, 0xE1, byte((len(exif) + 2) >> 8), byte(len(exif) + 2)}
+	return append(append(append([]byte{}, data[:2]...), append(segment, exif...)...), data[2:]...)
+}
+
+func TestMovieService_Update_WhenGenreDoesNotExist_ShouldReturnErrGenreNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+	genreIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+	movieRepositoryMock.EXPECT().GetGenresByIDs(gomock.Any(), genreIDs).Return([]*domain.Genre{{ID: genreIDs[0], Name: "Drama"}}, nil)
+
+	response, err := movieService.Update(ctx, movie.ID, domain.MovieUpdatePayload{GenreIDs: &genreIDs})
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, domain.ErrGenreNotFound)
+}
+
+func TestMovieService_Update_WhenMetadataIsProvided_ShouldReplaceGenresAndCredits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, authorizationService: authorizationServiceMock, auditService: auditServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	movie := newOwnedMovie(session.UserID)
+	movie.Credits = []domain.MovieCredit{{ID: uuid.New(), MovieID: movie.ID, Role: domain.MovieCreditRoleDirector, Name: "Walter Salles"}}
+	genre := &domain.Genre{ID: uuid.New(), Name: "Drama"}
+	genreIDs := []uuid.UUID{genre.ID}
+	synopsis := "A former schoolteacher helps a boy look for his father."
+	cast := []domain.MovieCastPayload{{Name: "Fernanda Montenegro", Character: "Dora"}, {Name: "Vinícius de Oliveira", Character: "Josué"}}
+
+	movieRepositoryMock.EXPECT().GetByID(gomock.Any(), movie.ID, true).Return(movie, nil)
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), movie.OrganizationID, domain.PermissionMovieManage).Return(nil)
+	movieRepositoryMock.EXPECT().GetGenresByIDs(gomock.Any(), genreIDs).Return([]*domain.Genre{genre}, nil)
+	movieRepositoryMock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	movieRepositoryMock.EXPECT().ReplaceGenres(gomock.Any(), movie.ID, []*domain.Genre{genre}).Return(nil)
+	movieRepositoryMock.EXPECT().ReplaceCredits(gomock.Any(), movie.ID, domain.MovieCreditRoleCast, gomock.Len(2)).Return(nil)
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any())
+
+	payload := domain.MovieUpdatePayload{Synopsis: &synopsis, GenreIDs: &genreIDs, Cast: &cast}
+	response, err := movieService.Update(ctx, movie.ID, payload)
+
+	assert.NoError(t, err)
+	assert.Equal(t, synopsis, response.Synopsis)
+	assert.Equal(t, "Drama", response.Genres[0].Name)
+	assert.Equal(t, []string{"Walter Salles"}, response.Directors)
+	assert.Len(t, response.Cast, 2)
+	assert.Equal(t, "Dora", response.Cast[0].Character)
+}
+
+func TestMovieService_Import_WhenCertificationMaps_ShouldCreateMovieAndQueueImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	metadataProviderMock := mock.NewMockMetadataProvider(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, metadataProvider: metadataProviderMock, authorizationService: authorizationServiceMock, auditService: auditServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	organizationID := uuid.New()
+	indicativeRating := &domain.IndicativeRating{ID: uuid.New(), Description: "A14"}
+	genre := &domain.Genre{ID: uuid.New(), Name: "Drama"}
+	metadata := &client.MetadataMovie{
+		ExternalID:    "666",
+		Title:         "Central do Brasil",
+		Runtime:       113,
+		Country:       "br",
+		Genres:        []string{"Drama"},
+		Directors:     []string{"Walter Salles"},
+		Cast:          []client.MetadataCastMember{{Name: "Fernanda Montenegro", Character: "Dora"}},
+		Certification: "14",
+		PosterURL:     "https://images.local/poster.jpg",
+		BackdropURL:   "https://images.local/backdrop.jpg",
+	}
+
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), organizationID, domain.PermissionMovieManage).Return(nil)
+	metadataProviderMock.EXPECT().Name().Return(client.MetadataProviderTMDB).AnyTimes()
+	movieRepositoryMock.EXPECT().GetByExternalID(gomock.Any(), client.MetadataProviderTMDB, "666").Return(nil, nil)
+	metadataProviderMock.EXPECT().GetMovie(gomock.Any(), "666").Return(metadata, nil)
+	movieRepositoryMock.EXPECT().GetIndicativeRatingByDescription(gomock.Any(), "A14").Return(indicativeRating, nil)
+	movieRepositoryMock.EXPECT().GetGenresByNames(gomock.Any(), []string{"Drama"}).Return([]*domain.Genre{genre}, nil)
+	movieRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	metadataProviderMock.EXPECT().DownloadImage(gomock.Any(), metadata.PosterURL).Return([]byte("poster"), nil)
+	metadataProviderMock.EXPECT().DownloadImage(gomock.Any(), metadata.BackdropURL).Return(nil, errors.New("timeout"))
+	movieRepositoryMock.EXPECT().AddUploadTaskToQueue(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, task domain.MovieImageUploadTask) error {
+		assert.Equal(t, []byte("poster"), task.Image)
+		assert.Equal(t, "Poster of Central do Brasil", task.AltText)
+		return nil
+	})
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any())
+
+	response, err := movieService.Import(ctx, domain.MovieImportPayload{ExternalID: "666", OrganizationID: organizationID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Central do Brasil", response.Title)
+	assert.Equal(t, organizationID, response.OrganizationID)
+	assert.Equal(t, 113, response.Duration)
+	assert.Equal(t, "BR", response.Country)
+	assert.Equal(t, "A14", response.IndicativeRating.Description)
+	assert.Equal(t, []string{"Walter Salles"}, response.Directors)
+	assert.Equal(t, "Drama", response.Genres[0].Name)
+}
+
+func TestMovieService_Import_WhenAlreadyImported_ShouldReturnErrMovieAlreadyImported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	metadataProviderMock := mock.NewMockMetadataProvider(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, metadataProvider: metadataProviderMock, authorizationService: authorizationServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	organizationID := uuid.New()
+
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), organizationID, domain.PermissionMovieManage).Return(nil)
+	metadataProviderMock.EXPECT().Name().Return(client.MetadataProviderTMDB)
+	movieRepositoryMock.EXPECT().GetByExternalID(gomock.Any(), client.MetadataProviderTMDB, "666").Return(newOwnedMovie(session.UserID), nil)
+
+	response, err := movieService.Import(ctx, domain.MovieImportPayload{ExternalID: "666", OrganizationID: organizationID})
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, domain.ErrMovieAlreadyImported)
+}
+
+func TestMovieService_Import_WhenCertificationIsUnknown_ShouldReturnErrIndicativeRatingNotMapped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieRepositoryMock := mock.NewMockMovieRepository(ctrl)
+	metadataProviderMock := mock.NewMockMetadataProvider(ctrl)
+	authorizationServiceMock := mock.NewMockAuthorizationService(ctrl)
+	movieService := &movieService{movieRepository: movieRepositoryMock, metadataProvider: metadataProviderMock, authorizationService: authorizationServiceMock}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+	organizationID := uuid.New()
+
+	authorizationServiceMock.EXPECT().AuthorizeOrganization(gomock.Any(), organizationID, domain.PermissionMovieManage).Return(nil)
+	metadataProviderMock.EXPECT().Name().Return(client.MetadataProviderTMDB)
+	movieRepositoryMock.EXPECT().GetByExternalID(gomock.Any(), client.MetadataProviderTMDB, "666").Return(nil, nil)
+	metadataProviderMock.EXPECT().GetMovie(gomock.Any(), "666").Return(&client.MetadataMovie{ExternalID: "666", Title: "Central do Brasil", Runtime: 113}, nil)
+
+	response, err := movieService.Import(ctx, domain.MovieImportPayload{ExternalID: "666", OrganizationID: organizationID})
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, domain.ErrIndicativeRatingNotMapped)
+}
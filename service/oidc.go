@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/config/model"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/GSVillas/movie-pass-api/utils"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+const (
+	oidcStateSize        = 32
+	oidcNonceSize        = 32
+	oidcCodeVerifierSize = 48
+)
+
+type oidcService struct {
+	i                      *do.Injector
+	userRepository         domain.UserRepository
+	userIdentityRepository domain.UserIdentityRepository
+	sessionService         domain.SessionService
+	twoFactorService       domain.TwoFactorService
+	oidcClient             client.OIDCClient
+}
+
+func NewOIDCService(i *do.Injector) (domain.OIDCService, error) {
+	userRepository, err := do.Invoke[domain.UserRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize UserRepository: %w", err)
+	}
+
+	userIdentityRepository, err := do.Invoke[domain.UserIdentityRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize UserIdentityRepository: %w", err)
+	}
+
+	sessionService, err := do.Invoke[domain.SessionService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize SessionService: %w", err)
+	}
+
+	twoFactorService, err := do.Invoke[domain.TwoFactorService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize TwoFactorService: %w", err)
+	}
+
+	oidcClient, err := do.Invoke[client.OIDCClient](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize OIDCClient: %w", err)
+	}
+
+	return &oidcService{
+		i:                      i,
+		userRepository:         userRepository,
+		userIdentityRepository: userIdentityRepository,
+		sessionService:         sessionService,
+		twoFactorService:       twoFactorService,
+		oidcClient:             oidcClient,
+	}, nil
+}
+
+func (o *oidcService) Authorize(ctx context.Context, providerName string) (*domain.OIDCAuthorizationResponse, error) {
+	provider, err := o.getProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := secure.GenerateToken(oidcStateSize)
+	if err != nil {
+		return nil, fmt.Errorf("error to generate OIDC state: %w", err)
+	}
+
+	nonce, err := secure.GenerateToken(oidcNonceSize)
+	if err != nil {
+		return nil, fmt.Errorf("error to generate OIDC nonce: %w", err)
+	}
+
+	codeVerifier, err := secure.GenerateToken(oidcCodeVerifierSize)
+	if err != nil {
+		return nil, fmt.Errorf("error to generate PKCE code verifier: %w", err)
+	}
+
+	authorizationURL, err := o.oidcClient.AuthorizationURL(ctx, *provider, state, nonce, secure.CodeChallengeS256(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("error to build authorization URL for provider %s: %w", provider.Name, err)
+	}
+
+	oidcState := domain.OIDCState{
+		Provider:     provider.Name,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+	}
+
+	if err := o.userIdentityRepository.CreateState(ctx, secure.HashToken(state), oidcState); err != nil {
+		return nil, fmt.Errorf("error to store OIDC state for provider %s: %w", provider.Name, err)
+	}
+
+	return &domain.OIDCAuthorizationResponse{
+		AuthorizationURL: authorizationURL,
+	}, nil
+}
+
+func (o *oidcService) Callback(ctx context.Context, providerName string, payload domain.OIDCCallbackPayload) (*domain.SignInResponse, error) {
+	provider, err := o.getProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	oidcState, err := o.userIdentityRepository.ConsumeState(ctx, secure.HashToken(payload.State))
+	if err != nil {
+		return nil, fmt.Errorf("error to consume OIDC state: %w", err)
+	}
+
+	if oidcState == nil || oidcState.Provider != provider.Name {
+		return nil, domain.ErrOIDCStateInvalid
+	}
+
+	idToken, err := o.oidcClient.ExchangeCode(ctx, *provider, payload.Code, oidcState.CodeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrOIDCAuthenticationFailed, err.Error())
+	}
+
+	claims, err := o.oidcClient.VerifyIDToken(ctx, *provider, idToken, oidcState.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrOIDCAuthenticationFailed, err.Error())
+	}
+
+	user, err := o.resolveUser(ctx, provider.Name, *claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TwoFactorEnabledAt != nil {
+		twoFactorToken, err := o.twoFactorService.CreateSignInToken(*user)
+		if err != nil {
+			return nil, err
+		}
+
+		return &domain.SignInResponse{
+			TwoFactorRequired: true,
+			TwoFactorToken:    twoFactorToken,
+		}, nil
+	}
+
+	tokens, err := o.sessionService.Create(ctx, *user, payload.ToSessionMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("error to create session for user ID %s: %w", user.ID, err)
+	}
+
+	return &domain.SignInResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
+	}, nil
+}
+
+// resolveUser finds the user linked to the provider subject, links an
+// existing account with the same verified email or creates a new one.
+func (o *oidcService) resolveUser(ctx context.Context, providerName string, claims client.OIDCClaims) (*domain.User, error) {
+	identity, err := o.userIdentityRepository.GetByProviderSubject(ctx, providerName, claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve identity for provider %s: %w", providerName, err)
+	}
+
+	if identity != nil {
+		user, err := o.userRepository.GetByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("error to retrieve user by ID %s: %w", identity.UserID, err)
+		}
+
+		if user == nil {
+			return nil, domain.ErrUserNotFound
+		}
+
+		return user, nil
+	}
+
+	email := strings.ToLower(strings.TrimSpace(claims.Email))
+	if email == "" || !claims.EmailVerified {
+		return nil, domain.ErrOIDCEmailNotVerified
+	}
+
+	now := time.Now().UTC()
+	identity = &domain.UserIdentity{
+		ID:        uuid.New(),
+		Provider:  providerName,
+		Subject:   claims.Subject,
+		Email:     email,
+		CreatedAt: now,
+	}
+
+	user, err := o.userRepository.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve user by email %s: %w", email, err)
+	}
+
+	if user != nil {
+		if err := o.linkUnverifiedUser(ctx, user, now); err != nil {
+			return nil, err
+		}
+
+		identity.UserID = user.ID
+		if err := o.userIdentityRepository.Create(ctx, *identity); err != nil {
+			return nil, fmt.Errorf("error to link identity for user ID %s: %w", user.ID, err)
+		}
+
+		return user, nil
+	}
+
+	firstName, lastName := claims.GivenName, claims.FamilyName
+	if firstName == "" && lastName == "" {
+		firstName, lastName, _ = strings.Cut(strings.TrimSpace(claims.Name), " ")
+	}
+
+	user = &domain.User{
+		ID:              uuid.New(),
+		FirstName:       utils.TruncateString(strings.TrimSpace(firstName), 255),
+		LastName:        utils.TruncateString(strings.TrimSpace(lastName), 255),
+		Email:           email,
+		EmailVerifiedAt: &now,
+		CreatedAt:       now,
+	}
+
+	identity.UserID = user.ID
+	if err := o.userIdentityRepository.CreateWithUser(ctx, *user, *identity); err != nil {
+		return nil, fmt.Errorf("error to create user with email %s: %w", email, err)
+	}
+
+	return user, nil
+}
+
+// linkUnverifiedUser handles accounts whose owner never proved access to the
+// mailbox: the password is discarded and sessions revoked, otherwise whoever
+// registered the email first would keep access to the linked account.
+func (o *oidcService) linkUnverifiedUser(ctx context.Context, user *domain.User, now time.Time) error {
+	if user.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	if err := o.userRepository.UpdatePassword(ctx, user.ID, ""); err != nil {
+		return fmt.Errorf("error to clear password for user ID %s: %w", user.ID, err)
+	}
+
+	if err := o.sessionService.RevokeAll(ctx, user.ID); err != nil {
+		return fmt.Errorf("error to revoke sessions for user ID %s: %w", user.ID, err)
+	}
+
+	if err := o.userRepository.MarkEmailVerified(ctx, user.ID, now); err != nil {
+		return fmt.Errorf("error to mark email as verified for user ID %s: %w", user.ID, err)
+	}
+
+	user.PasswordHash = ""
+	user.EmailVerifiedAt = &now
+	return nil
+}
+
+func (o *oidcService) getProvider(name string) (*model.OIDCProvider, error) {
+	for _, provider := range config.Env.OIDCProviders {
+		if provider.Name == name {
+			return &provider, nil
+		}
+	}
+
+	return nil, domain.ErrOIDCProviderNotFound
+}
@@ -0,0 +1,392 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/config/model"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mockOIDCClientID = "movie-pass-client"
+	mockOIDCCode     = "authorization-code"
+)
+
+type mockOIDCIssuer struct {
+	server        *httptest.Server
+	privateKey    *rsa.PrivateKey
+	mutex         sync.Mutex
+	codeChallenge string
+	nonce         string
+	subject       string
+	email         string
+	emailVerified bool
+}
+
+func newMockOIDCIssuer(t *testing.T) *mockOIDCIssuer {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+
+	issuer := &mockOIDCIssuer{
+		privateKey:    privateKey,
+		subject:       "provider-subject",
+		email:         "social@example.com",
+		emailVerified: true,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeMockOIDCJSON(w, http.StatusOK, map[string]string{
+			"issuer":                 issuer.server.URL,
+			"authorization_endpoint": issuer.server.URL + "/authorize",
+			"token_endpoint":         issuer.server.URL + "/token",
+			"jwks_uri":               issuer.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeMockOIDCJSON(w, http.StatusOK, map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "mock",
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", issuer.handleToken)
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+// authorize simulates the user consenting on the provider page, which
+// binds the PKCE challenge and nonce to the issued authorization code.
+func (m *mockOIDCIssuer) authorize(t *testing.T, authorizationURL string) string {
+	parsedURL, err := url.Parse(authorizationURL)
+	assert.NoError(t, err)
+
+	query := parsedURL.Query()
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(t, mockOIDCClientID, query.Get("client_id"))
+
+	m.mutex.Lock()
+	m.codeChallenge = query.Get("code_challenge")
+	m.nonce = query.Get("nonce")
+	m.mutex.Unlock()
+
+	return query.Get("state")
+}
+
+func (m *mockOIDCIssuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeMockOIDCJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.PostForm.Get("code") != mockOIDCCode || secure.CodeChallengeS256(r.PostForm.Get("code_verifier")) != m.codeChallenge {
+		writeMockOIDCJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            m.server.URL,
+		"sub":            m.subject,
+		"aud":            []string{mockOIDCClientID},
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          m.nonce,
+		"email":          m.email,
+		"email_verified": m.emailVerified,
+		"given_name":     "Social",
+		"family_name":    "User",
+	})
+	token.Header["kid"] = "mock"
+
+	idToken, err := token.SignedString(m.privateKey)
+	if err != nil {
+		writeMockOIDCJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeMockOIDCJSON(w, http.StatusOK, map[string]string{"id_token": idToken, "token_type": "Bearer"})
+}
+
+func writeMockOIDCJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = jsoniter.NewEncoder(w).Encode(body)
+}
+
+type oidcTestEnvironment struct {
+	issuer                     *mockOIDCIssuer
+	service                    *oidcService
+	userRepositoryMock         *mock.MockUserRepository
+	userIdentityRepositoryMock *mock.MockUserIdentityRepository
+	sessionServiceMock         *mock.MockSessionService
+	twoFactorServiceMock       *mock.MockTwoFactorService
+	states                     map[string]domain.OIDCState
+}
+
+func setupOIDCEnvironment(t *testing.T, ctrl *gomock.Controller) *oidcTestEnvironment {
+	issuer := newMockOIDCIssuer(t)
+
+	config.Env.OIDCProviders = []model.OIDCProvider{{
+		Name:        "mock",
+		Issuer:      issuer.server.URL,
+		ClientID:    mockOIDCClientID,
+		RedirectURL: "http://localhost/callback",
+	}}
+
+	oidcClient, err := client.NewOIDCClient(nil)
+	assert.NoError(t, err)
+
+	env := &oidcTestEnvironment{
+		issuer:                     issuer,
+		userRepositoryMock:         mock.NewMockUserRepository(ctrl),
+		userIdentityRepositoryMock: mock.NewMockUserIdentityRepository(ctrl),
+		sessionServiceMock:         mock.NewMockSessionService(ctrl),
+		twoFactorServiceMock:       mock.NewMockTwoFactorService(ctrl),
+		states:                     make(map[string]domain.OIDCState),
+	}
+
+	env.service = &oidcService{
+		userRepository:         env.userRepositoryMock,
+		userIdentityRepository: env.userIdentityRepositoryMock,
+		sessionService:         env.sessionServiceMock,
+		twoFactorService:       env.twoFactorServiceMock,
+		oidcClient:             oidcClient,
+	}
+
+	env.userIdentityRepositoryMock.EXPECT().CreateState(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, state string, oidcState domain.OIDCState) error {
+			env.states[state] = oidcState
+			return nil
+		}).AnyTimes()
+	env.userIdentityRepositoryMock.EXPECT().ConsumeState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, state string) (*domain.OIDCState, error) {
+			oidcState, ok := env.states[state]
+			if !ok {
+				return nil, nil
+			}
+			delete(env.states, state)
+			return &oidcState, nil
+		}).AnyTimes()
+
+	return env
+}
+
+func (e *oidcTestEnvironment) startSignIn(t *testing.T) string {
+	response, err := e.service.Authorize(context.Background(), "mock")
+	assert.NoError(t, err)
+
+	return e.issuer.authorize(t, response.AuthorizationURL)
+}
+
+func TestOIDCService_Callback_WhenUserIsNew_ShouldCreateUserAndSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+	state := env.startSignIn(t)
+
+	var createdUser domain.User
+	env.userIdentityRepositoryMock.EXPECT().GetByProviderSubject(gomock.Any(), "mock", "provider-subject").Return(nil, nil)
+	env.userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), "social@example.com").Return(nil, nil)
+	env.userIdentityRepositoryMock.EXPECT().CreateWithUser(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, user domain.User, identity domain.UserIdentity) error {
+			createdUser = user
+			assert.Equal(t, user.ID, identity.UserID)
+			assert.Equal(t, "provider-subject", identity.Subject)
+			return nil
+		})
+	env.sessionServiceMock.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.SessionTokens{AccessToken: "access", RefreshToken: "refresh", ExpiresIn: 900}, nil)
+
+	response, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access", response.Token)
+	assert.Equal(t, "Social", createdUser.FirstName)
+	assert.Equal(t, "User", createdUser.LastName)
+	assert.Empty(t, createdUser.PasswordHash)
+	assert.Nil(t, createdUser.BirthDate)
+	assert.NotNil(t, createdUser.EmailVerifiedAt)
+}
+
+func TestOIDCService_Callback_WhenVerifiedEmailMatchesUser_ShouldLinkIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+	state := env.startSignIn(t)
+
+	verifiedAt := time.Now().UTC()
+	user := &domain.User{ID: uuid.New(), Email: "social@example.com", PasswordHash: "hash", EmailVerifiedAt: &verifiedAt}
+
+	env.userIdentityRepositoryMock.EXPECT().GetByProviderSubject(gomock.Any(), "mock", "provider-subject").Return(nil, nil)
+	env.userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), "social@example.com").Return(user, nil)
+	env.userIdentityRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, identity domain.UserIdentity) error {
+			assert.Equal(t, user.ID, identity.UserID)
+			return nil
+		})
+	env.sessionServiceMock.EXPECT().Create(gomock.Any(), *user, gomock.Any()).Return(&domain.SessionTokens{AccessToken: "access"}, nil)
+
+	response, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access", response.Token)
+}
+
+func TestOIDCService_Callback_WhenMatchedUserIsUnverified_ShouldClearPasswordAndRevokeSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+	state := env.startSignIn(t)
+
+	user := &domain.User{ID: uuid.New(), Email: "social@example.com", PasswordHash: "hash"}
+
+	env.userIdentityRepositoryMock.EXPECT().GetByProviderSubject(gomock.Any(), "mock", "provider-subject").Return(nil, nil)
+	env.userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), "social@example.com").Return(user, nil)
+	env.userRepositoryMock.EXPECT().UpdatePassword(gomock.Any(), user.ID, "").Return(nil)
+	env.sessionServiceMock.EXPECT().RevokeAll(gomock.Any(), user.ID).Return(nil)
+	env.userRepositoryMock.EXPECT().MarkEmailVerified(gomock.Any(), user.ID, gomock.Any()).Return(nil)
+	env.userIdentityRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	env.sessionServiceMock.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.SessionTokens{AccessToken: "access"}, nil)
+
+	_, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+
+	assert.NoError(t, err)
+	assert.Empty(t, user.PasswordHash)
+}
+
+func TestOIDCService_Callback_WhenIdentityIsLinkedWithTwoFactor_ShouldRequireTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+	state := env.startSignIn(t)
+
+	enabledAt := time.Now().UTC()
+	user := &domain.User{ID: uuid.New(), Email: "social@example.com", TwoFactorEnabledAt: &enabledAt}
+
+	env.userIdentityRepositoryMock.EXPECT().GetByProviderSubject(gomock.Any(), "mock", "provider-subject").Return(&domain.UserIdentity{UserID: user.ID}, nil)
+	env.userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	env.twoFactorServiceMock.EXPECT().CreateSignInToken(*user).Return("two-factor-token", nil)
+
+	response, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+
+	assert.NoError(t, err)
+	assert.True(t, response.TwoFactorRequired)
+	assert.Equal(t, "two-factor-token", response.TwoFactorToken)
+	assert.Empty(t, response.Token)
+}
+
+func TestOIDCService_Callback_WhenEmailIsNotVerified_ShouldReturnErrOIDCEmailNotVerified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+	env.issuer.emailVerified = false
+	state := env.startSignIn(t)
+
+	env.userIdentityRepositoryMock.EXPECT().GetByProviderSubject(gomock.Any(), "mock", "provider-subject").Return(nil, nil)
+
+	response, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+
+	assert.ErrorIs(t, err, domain.ErrOIDCEmailNotVerified)
+	assert.Nil(t, response)
+}
+
+func TestOIDCService_Callback_WhenCodeVerifierDoesNotMatch_ShouldReturnErrOIDCAuthenticationFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+	state := env.startSignIn(t)
+
+	stateKey := secure.HashToken(state)
+	oidcState := env.states[stateKey]
+	oidcState.CodeVerifier = "tampered-verifier"
+	env.states[stateKey] = oidcState
+
+	response, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+
+	assert.ErrorIs(t, err, domain.ErrOIDCAuthenticationFailed)
+	assert.Nil(t, response)
+}
+
+func TestOIDCService_Callback_WhenNonceDoesNotMatch_ShouldReturnErrOIDCAuthenticationFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+	state := env.startSignIn(t)
+	env.issuer.nonce = "replayed-nonce"
+
+	response, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+
+	assert.ErrorIs(t, err, domain.ErrOIDCAuthenticationFailed)
+	assert.Nil(t, response)
+}
+
+func TestOIDCService_Callback_WhenStateIsReused_ShouldReturnErrOIDCStateInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+	state := env.startSignIn(t)
+
+	env.userIdentityRepositoryMock.EXPECT().GetByProviderSubject(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.UserIdentity{}, nil)
+	env.userRepositoryMock.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&domain.User{}, nil)
+	env.sessionServiceMock.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.SessionTokens{AccessToken: "access"}, nil)
+
+	_, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+	assert.NoError(t, err)
+
+	response, err := env.service.Callback(context.Background(), "mock", domain.OIDCCallbackPayload{Code: mockOIDCCode, State: state})
+
+	assert.ErrorIs(t, err, domain.ErrOIDCStateInvalid)
+	assert.Nil(t, response)
+}
+
+func TestOIDCService_Authorize_WhenProviderIsUnknown_ShouldReturnErrOIDCProviderNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupOIDCEnvironment(t, ctrl)
+
+	response, err := env.service.Authorize(context.Background(), "unknown")
+
+	assert.ErrorIs(t, err, domain.ErrOIDCProviderNotFound)
+	assert.Nil(t, response)
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type organizationService struct {
+	i                      *do.Injector
+	organizationRepository domain.OrganizationRepository
+	userRepository         domain.UserRepository
+	authorizationService   domain.AuthorizationService
+	sessionService         domain.SessionService
+	auditService           domain.AuditService
+}
+
+func NewOrganizationService(i *do.Injector) (domain.OrganizationService, error) {
+	organizationRepository, err := do.Invoke[domain.OrganizationRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize OrganizationRepository: %w", err)
+	}
+
+	userRepository, err := do.Invoke[domain.UserRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize UserRepository: %w", err)
+	}
+
+	authorizationService, err := do.Invoke[domain.AuthorizationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuthorizationService: %w", err)
+	}
+
+	sessionService, err := do.Invoke[domain.SessionService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize SessionService: %w", err)
+	}
+
+	auditService, err := do.Invoke[domain.AuditService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuditService: %w", err)
+	}
+
+	return &organizationService{
+		i:                      i,
+		organizationRepository: organizationRepository,
+		userRepository:         userRepository,
+		authorizationService:   authorizationService,
+		sessionService:         sessionService,
+		auditService:           auditService,
+	}, nil
+}
+
+func (o *organizationService) Create(ctx context.Context, payload domain.OrganizationPayload) (*domain.OrganizationResponse, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	organization := payload.ToOrganization(session.UserID)
+	if err := o.organizationRepository.Create(ctx, *organization); err != nil {
+		return nil, fmt.Errorf("error to create organization for user ID %s: %w", session.UserID, err)
+	}
+
+	return organization.ToOrganizationResponse(domain.OrganizationRoleOwner), nil
+}
+
+func (o *organizationService) GetAll(ctx context.Context) ([]*domain.OrganizationResponse, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	members, err := o.organizationRepository.GetAllByUserID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to fetch organizations for user ID %s: %w", session.UserID, err)
+	}
+
+	if len(members) == 0 {
+		return nil, domain.ErrOrganizationNotFound
+	}
+
+	var organizationsResponse []*domain.OrganizationResponse
+	for _, member := range members {
+		organizationsResponse = append(organizationsResponse, member.Organization.ToOrganizationResponse(member.Role))
+	}
+
+	return organizationsResponse, nil
+}
+
+func (o *organizationService) UpdateSecurity(ctx context.Context, organizationID uuid.UUID, payload domain.OrganizationSecurityPayload) (*domain.OrganizationResponse, error) {
+	if err := o.authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionOrganizationSecurity); err != nil {
+		return nil, err
+	}
+
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	if *payload.RequireTwoFactor && !session.TwoFactorVerified {
+		return nil, domain.ErrTwoFactorRequired
+	}
+
+	organization, err := o.organizationRepository.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve organization by ID %s: %w", organizationID, err)
+	}
+
+	if organization == nil {
+		return nil, domain.ErrOrganizationNotFound
+	}
+
+	member, err := o.organizationRepository.GetMember(ctx, organizationID, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve organization member for user ID %s: %w", session.UserID, err)
+	}
+
+	if member == nil {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	before := organization.ToOrganizationResponse(member.Role)
+	organization.RequireTwoFactor = *payload.RequireTwoFactor
+	organization.UpdatedAt = time.Now().UTC()
+
+	if err := o.organizationRepository.Update(ctx, *organization); err != nil {
+		return nil, fmt.Errorf("error to update organization ID %s: %w", organizationID, err)
+	}
+
+	response := organization.ToOrganizationResponse(member.Role)
+	o.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &organizationID,
+		Action:         domain.AuditActionOrganizationSecurity,
+		TargetType:     domain.AuditTargetOrganization,
+		TargetID:       organizationID,
+		Before:         before,
+		After:          response,
+	})
+
+	return response, nil
+}
+
+func (o *organizationService) AddMember(ctx context.Context, organizationID uuid.UUID, payload domain.OrganizationMemberPayload) (*domain.OrganizationMemberResponse, error) {
+	if err := o.authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionMemberManage); err != nil {
+		return nil, err
+	}
+
+	user, err := o.userRepository.GetByEmail(ctx, payload.Email)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve user by email %s: %w", payload.Email, err)
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	existingMember, err := o.organizationRepository.GetMember(ctx, organizationID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve organization member for user ID %s: %w", user.ID, err)
+	}
+
+	if existingMember != nil {
+		return nil, domain.ErrOrganizationMemberExists
+	}
+
+	member := &domain.OrganizationMember{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		UserID:         user.ID,
+		User:           *user,
+		Role:           payload.Role,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	if err := o.organizationRepository.CreateMember(ctx, *member); err != nil {
+		return nil, fmt.Errorf("error to add user ID %s to organization ID %s: %w", user.ID, organizationID, err)
+	}
+
+	response := member.ToOrganizationMemberResponse()
+	o.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &organizationID,
+		Action:         domain.AuditActionOrganizationMemberAdd,
+		TargetType:     domain.AuditTargetOrganizationMember,
+		TargetID:       member.ID,
+		After:          response,
+	})
+
+	return response, nil
+}
+
+func (o *organizationService) GetAllMembers(ctx context.Context, organizationID uuid.UUID) ([]*domain.OrganizationMemberResponse, error) {
+	if err := o.authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionOrganizationRead); err != nil {
+		return nil, err
+	}
+
+	members, err := o.organizationRepository.GetAllMembers(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("error to fetch members of organization ID %s: %w", organizationID, err)
+	}
+
+	var membersResponse []*domain.OrganizationMemberResponse
+	for _, member := range members {
+		membersResponse = append(membersResponse, member.ToOrganizationMemberResponse())
+	}
+
+	return membersResponse, nil
+}
+
+func (o *organizationService) RemoveMember(ctx context.Context, organizationID, memberID uuid.UUID) error {
+	if err := o.authorizationService.AuthorizeOrganization(ctx, organizationID, domain.PermissionMemberManage); err != nil {
+		return err
+	}
+
+	member, err := o.organizationRepository.GetMemberByID(ctx, memberID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve organization member by ID %s: %w", memberID, err)
+	}
+
+	if member == nil || member.OrganizationID != organizationID {
+		return domain.ErrOrganizationMemberNotFound
+	}
+
+	if member.Role == domain.OrganizationRoleOwner {
+		return domain.ErrCannotRemoveOwner
+	}
+
+	if err := o.organizationRepository.DeleteMember(ctx, memberID); err != nil {
+		return fmt.Errorf("error to remove member ID %s from organization ID %s: %w", memberID, organizationID, err)
+	}
+
+	if err := o.sessionService.RevokeAll(ctx, member.UserID); err != nil {
+		return fmt.Errorf("error to revoke sessions of user ID %s: %w", member.UserID, err)
+	}
+
+	o.auditService.Record(ctx, domain.AuditEntry{
+		OrganizationID: &organizationID,
+		Action:         domain.AuditActionOrganizationMemberRemove,
+		TargetType:     domain.AuditTargetOrganizationMember,
+		TargetID:       member.ID,
+		Before:         member.ToOrganizationMemberResponse(),
+	})
+
+	return nil
+}
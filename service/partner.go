@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type partnerService struct {
+	i                 *do.Injector
+	partnerRepository domain.PartnerRepository
+	cinemaRepository  domain.CinemaRepository
+	ticketRepository  domain.TicketRepository
+	seatRepository    domain.SeatRepository
+}
+
+func NewPartnerService(i *do.Injector) (domain.PartnerService, error) {
+	partnerRepository, err := do.Invoke[domain.PartnerRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize PartnerRepository: %w", err)
+	}
+
+	cinemaRepository, err := do.Invoke[domain.CinemaRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize CinemaRepository: %w", err)
+	}
+
+	ticketRepository, err := do.Invoke[domain.TicketRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize TicketRepository: %w", err)
+	}
+
+	seatRepository, err := do.Invoke[domain.SeatRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize SeatRepository: %w", err)
+	}
+
+	return &partnerService{
+		i:                 i,
+		partnerRepository: partnerRepository,
+		cinemaRepository:  cinemaRepository,
+		ticketRepository:  ticketRepository,
+		seatRepository:    seatRepository,
+	}, nil
+}
+
+func (p *partnerService) GetShowtimes(ctx context.Context, cinemaID uuid.UUID) ([]*domain.ShowtimeResponse, error) {
+	apiKey, ok := ctx.Value(domain.APIKeyKey).(*domain.APIKey)
+	if !ok || apiKey == nil {
+		return nil, domain.ErrAPIKeyNotFoundInContext
+	}
+
+	cinema, err := p.cinemaRepository.GetByID(ctx, cinemaID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve cinema by ID %s: %w", cinemaID, err)
+	}
+
+	// Cinemas of other organizations are reported as missing so keys cannot
+	// probe for them.
+	if cinema == nil || cinema.OrganizationID != apiKey.OrganizationID {
+		return nil, domain.ErrCinemaNotFound
+	}
+
+	sessions, err := p.partnerRepository.GetUpcomingSessions(ctx, cinemaID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("error to fetch upcoming sessions for cinema ID %s: %w", cinemaID, err)
+	}
+
+	response := make([]*domain.ShowtimeResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, session.ToShowtimeResponse())
+	}
+
+	return response, nil
+}
+
+func (p *partnerService) CreateTicket(ctx context.Context, cinemaSessionID uuid.UUID, payload domain.PartnerTicketPayload) (*domain.TicketResponse, error) {
+	apiKey, ok := ctx.Value(domain.APIKeyKey).(*domain.APIKey)
+	if !ok || apiKey == nil {
+		return nil, domain.ErrAPIKeyNotFoundInContext
+	}
+
+	cinemaSession, err := p.ticketRepository.GetCinemaSessionByID(ctx, cinemaSessionID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve cinema session by ID %s: %w", cinemaSessionID, err)
+	}
+
+	if cinemaSession == nil || cinemaSession.CinemaRoom.Cinema.OrganizationID != apiKey.OrganizationID {
+		return nil, domain.ErrCinemaSessionNotFound
+	}
+
+	now := time.Now().UTC()
+	if !cinemaSession.StartTime.After(now) {
+		return nil, domain.ErrCinemaSessionStarted
+	}
+
+	seat, err := p.seatRepository.GetByID(ctx, payload.SeatID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve seat by ID %s: %w", payload.SeatID, err)
+	}
+
+	if seat == nil || seat.CinemaRoomID != cinemaSession.CinemaRoomID {
+		return nil, domain.ErrSeatNotFound
+	}
+
+	reservation := domain.SeatReservation{
+		ID:              uuid.New(),
+		CinemaSessionID: cinemaSession.ID,
+		SeatID:          seat.ID,
+		UserID:          apiKey.CreatedByID,
+		APIKeyID:        &apiKey.ID,
+		HolderName:      payload.HolderName,
+		HolderBirthDate: payload.HolderBirthDate,
+		MinimumAge:      cinemaSession.Movie.IndicativeRating.MinimumAge(),
+		CreatedAt:       now,
+	}
+
+	reservation.RequiresGuardian, err = checkAgeRating(payload.HolderBirthDate, reservation.MinimumAge, cinemaSession, payload.AccompaniedByGuardian)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.ticketRepository.Create(ctx, reservation); err != nil {
+		if errors.Is(err, domain.ErrSeatUnavailable) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("error to reserve seat ID %s for API key ID %s: %w", seat.ID, apiKey.ID, err)
+	}
+
+	reservation.CinemaSession = *cinemaSession
+	reservation.Seat = *seat
+
+	return reservation.ToTicketResponse(), nil
+}
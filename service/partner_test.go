@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartnerService_GetShowtimes_WhenCinemaBelongsToAnotherOrganization_ShouldReturnNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cinemaRepositoryMock := mock.NewMockCinemaRepository(ctrl)
+	partnerRepositoryMock := mock.NewMockPartnerRepository(ctrl)
+	service := &partnerService{
+		cinemaRepository:  cinemaRepositoryMock,
+		partnerRepository: partnerRepositoryMock,
+	}
+
+	apiKey := &domain.APIKey{ID: uuid.New(), OrganizationID: uuid.New()}
+	cinema := &domain.Cinema{ID: uuid.New(), OrganizationID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.APIKeyKey, apiKey)
+
+	cinemaRepositoryMock.EXPECT().GetByID(gomock.Any(), cinema.ID).Return(cinema, nil)
+
+	response, err := service.GetShowtimes(ctx, cinema.ID)
+
+	assert.ErrorIs(t, err, domain.ErrCinemaNotFound)
+	assert.Nil(t, response)
+}
+
+func TestPartnerService_CreateTicket_WhenSessionBelongsToAnotherOrganization_ShouldReturnNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ticketRepositoryMock := mock.NewMockTicketRepository(ctrl)
+	service := &partnerService{
+		ticketRepository: ticketRepositoryMock,
+	}
+
+	apiKey := &domain.APIKey{ID: uuid.New(), OrganizationID: uuid.New()}
+	cinemaSession := newRatedCinemaSession("AL", false)
+	ctx := context.WithValue(context.Background(), domain.APIKeyKey, apiKey)
+
+	ticketRepositoryMock.EXPECT().GetCinemaSessionByID(gomock.Any(), cinemaSession.ID).Return(cinemaSession, nil)
+
+	response, err := service.CreateTicket(ctx, cinemaSession.ID, domain.PartnerTicketPayload{SeatID: uuid.New(), HolderName: "Jane Doe"})
+
+	assert.ErrorIs(t, err, domain.ErrCinemaSessionNotFound)
+	assert.Nil(t, response)
+}
+
+func TestPartnerService_CreateTicket_WhenSessionBelongsToKeyOrganization_ShouldReserveSeatForHolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ticketRepositoryMock := mock.NewMockTicketRepository(ctrl)
+	seatRepositoryMock := mock.NewMockSeatRepository(ctrl)
+	service := &partnerService{
+		ticketRepository: ticketRepositoryMock,
+		seatRepository:   seatRepositoryMock,
+	}
+
+	cinemaSession := newRatedCinemaSession("AL", false)
+	apiKey := &domain.APIKey{ID: uuid.New(), OrganizationID: uuid.New(), CreatedByID: uuid.New()}
+	cinemaSession.CinemaRoom.Cinema.OrganizationID = apiKey.OrganizationID
+	seat := &domain.Seat{ID: uuid.New(), CinemaRoomID: cinemaSession.CinemaRoomID, SeatIdentifier: "A1"}
+	ctx := context.WithValue(context.Background(), domain.APIKeyKey, apiKey)
+
+	ticketRepositoryMock.EXPECT().GetCinemaSessionByID(gomock.Any(), cinemaSession.ID).Return(cinemaSession, nil)
+	seatRepositoryMock.EXPECT().GetByID(gomock.Any(), seat.ID).Return(seat, nil)
+	ticketRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reservation domain.SeatReservation) error {
+		assert.Equal(t, apiKey.CreatedByID, reservation.UserID)
+		assert.Equal(t, &apiKey.ID, reservation.APIKeyID)
+		assert.Equal(t, "Jane Doe", reservation.HolderName)
+		return nil
+	})
+
+	response, err := service.CreateTicket(ctx, cinemaSession.ID, domain.PartnerTicketPayload{SeatID: seat.ID, HolderName: "Jane Doe"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", response.HolderName)
+}
@@ -0,0 +1,537 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/samber/do"
+)
+
+const (
+	exportRateLimit       = 3
+	exportRateLimitWindow = 24 * time.Hour
+	exportArchiveEntry    = "account.json"
+)
+
+type privacyService struct {
+	i                      *do.Injector
+	privacyRepository      domain.PrivacyRepository
+	userRepository         domain.UserRepository
+	userIdentityRepository domain.UserIdentityRepository
+	dependentRepository    domain.DependentRepository
+	organizationRepository domain.OrganizationRepository
+	sessionRepository      domain.SessionRepository
+	sessionService         domain.SessionService
+	mailSender             client.MailSender
+	rateLimiter            domain.RateLimiter
+}
+
+func NewPrivacyService(i *do.Injector) (domain.PrivacyService, error) {
+	privacyRepository, err := do.Invoke[domain.PrivacyRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize PrivacyRepository: %w", err)
+	}
+
+	userRepository, err := do.Invoke[domain.UserRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize UserRepository: %w", err)
+	}
+
+	userIdentityRepository, err := do.Invoke[domain.UserIdentityRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize UserIdentityRepository: %w", err)
+	}
+
+	dependentRepository, err := do.Invoke[domain.DependentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DependentRepository: %w", err)
+	}
+
+	organizationRepository, err := do.Invoke[domain.OrganizationRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize OrganizationRepository: %w", err)
+	}
+
+	sessionRepository, err := do.Invoke[domain.SessionRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize SessionRepository: %w", err)
+	}
+
+	sessionService, err := do.Invoke[domain.SessionService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize SessionService: %w", err)
+	}
+
+	mailSender, err := do.Invoke[client.MailSender](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize MailSender: %w", err)
+	}
+
+	rateLimiter, err := do.Invoke[domain.RateLimiter](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize RateLimiter: %w", err)
+	}
+
+	return &privacyService{
+		i:                      i,
+		privacyRepository:      privacyRepository,
+		userRepository:         userRepository,
+		userIdentityRepository: userIdentityRepository,
+		dependentRepository:    dependentRepository,
+		organizationRepository: organizationRepository,
+		sessionRepository:      sessionRepository,
+		sessionService:         sessionService,
+		mailSender:             mailSender,
+		rateLimiter:            rateLimiter,
+	}, nil
+}
+
+func (p *privacyService) RequestExport(ctx context.Context) (*domain.PrivacyRequestResponse, error) {
+	user, err := p.getSessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.rateLimiter.Allow(ctx, fmt.Sprintf("privacy_export_%s", user.ID), exportRateLimit, exportRateLimitWindow); err != nil {
+		return nil, err
+	}
+
+	return p.createRequest(ctx, user.ID, domain.PrivacyRequestTypeExport)
+}
+
+func (p *privacyService) GetExportFile(ctx context.Context, requestID uuid.UUID) (string, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return "", domain.ErrUserNotFoundInContext
+	}
+
+	request, err := p.privacyRepository.GetByID(ctx, requestID)
+	if err != nil {
+		return "", fmt.Errorf("error to retrieve privacy request ID %s: %w", requestID, err)
+	}
+
+	if request == nil || request.UserID != session.UserID || request.Type != domain.PrivacyRequestTypeExport {
+		return "", domain.ErrPrivacyRequestNotFound
+	}
+
+	if request.Status != domain.PrivacyRequestStatusCompleted {
+		return "", domain.ErrExportNotReady
+	}
+
+	if request.FilePath == "" || request.ExpiresAt == nil || time.Now().UTC().After(*request.ExpiresAt) {
+		return "", domain.ErrExportExpired
+	}
+
+	return request.FilePath, nil
+}
+
+func (p *privacyService) RequestDeletion(ctx context.Context, payload domain.DeleteAccountPayload) (*domain.PrivacyRequestResponse, error) {
+	user, err := p.getSessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.PasswordHash != "" {
+		if err := p.rateLimiter.Allow(ctx, fmt.Sprintf("password_check_%s", user.ID), passwordCheckRateLimit, passwordCheckRateLimitWindow); err != nil {
+			return nil, err
+		}
+
+		if err := secure.CheckPassword(user.PasswordHash, payload.Password); err != nil {
+			return nil, domain.ErrInvalidPassword
+		}
+	}
+
+	memberships, err := p.organizationRepository.GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve organizations of user ID %s: %w", user.ID, err)
+	}
+
+	for _, membership := range memberships {
+		if membership.Role == domain.OrganizationRoleOwner {
+			return nil, domain.ErrAccountOwnsOrganization
+		}
+	}
+
+	return p.createRequest(ctx, user.ID, domain.PrivacyRequestTypeDeletion)
+}
+
+func (p *privacyService) GetAll(ctx context.Context) ([]*domain.PrivacyRequestResponse, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	requests, err := p.privacyRepository.GetAllByUserID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve privacy requests of user ID %s: %w", session.UserID, err)
+	}
+
+	responses := make([]*domain.PrivacyRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		responses = append(responses, request.ToPrivacyRequestResponse())
+	}
+
+	return responses, nil
+}
+
+func (p *privacyService) ProcessQueue(ctx context.Context, task domain.PrivacyTask) error {
+	request, err := p.privacyRepository.GetByID(ctx, task.RequestID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve privacy request ID %s: %w", task.RequestID, err)
+	}
+
+	if request == nil {
+		return domain.ErrPrivacyRequestNotFound
+	}
+
+	if request.Status == domain.PrivacyRequestStatusCompleted {
+		return nil
+	}
+
+	request.Status = domain.PrivacyRequestStatusProcessing
+	request.UpdatedAt = time.Now().UTC()
+	if err := p.privacyRepository.Update(ctx, *request); err != nil {
+		return fmt.Errorf("error to update privacy request ID %s: %w", request.ID, err)
+	}
+
+	switch request.Type {
+	case domain.PrivacyRequestTypeExport:
+		err = p.processExport(ctx, request)
+	case domain.PrivacyRequestTypeDeletion:
+		err = p.processDeletion(ctx, request)
+	default:
+		err = domain.ErrUnsupportedPrivacyRequestType
+	}
+
+	now := time.Now().UTC()
+	request.UpdatedAt = now
+	if err != nil {
+		request.Status = domain.PrivacyRequestStatusFailed
+		if updateErr := p.privacyRepository.Update(ctx, *request); updateErr != nil {
+			return errors.Join(err, fmt.Errorf("error to update privacy request ID %s: %w", request.ID, updateErr))
+		}
+
+		return fmt.Errorf("error to process privacy request ID %s: %w", request.ID, err)
+	}
+
+	request.Status = domain.PrivacyRequestStatusCompleted
+	request.CompletedAt = &now
+	if err := p.privacyRepository.Update(ctx, *request); err != nil {
+		return fmt.Errorf("error to update privacy request ID %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+func (p *privacyService) PurgeExpiredExports(ctx context.Context) error {
+	requests, err := p.privacyRepository.GetExpiredExports(ctx, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("error to retrieve expired exports: %w", err)
+	}
+
+	for _, request := range requests {
+		if err := p.removeExportFile(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *privacyService) createRequest(ctx context.Context, userID uuid.UUID, requestType domain.PrivacyRequestType) (*domain.PrivacyRequestResponse, error) {
+	openRequest, err := p.privacyRepository.GetOpenByUserID(ctx, userID, requestType)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve open %s request of user ID %s: %w", requestType, userID, err)
+	}
+
+	if openRequest != nil {
+		return nil, domain.ErrPrivacyRequestInProgress
+	}
+
+	request := domain.PrivacyRequest{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Type:      requestType,
+		Status:    domain.PrivacyRequestStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := p.privacyRepository.Create(ctx, request); err != nil {
+		return nil, fmt.Errorf("error to create %s request for user ID %s: %w", requestType, userID, err)
+	}
+
+	task := domain.PrivacyTask{
+		RequestID: request.ID,
+		UserID:    userID,
+		Type:      requestType,
+	}
+
+	if err := p.privacyRepository.AddTaskToQueue(ctx, task); err != nil {
+		return nil, fmt.Errorf("error to enqueue %s request ID %s: %w", requestType, request.ID, err)
+	}
+
+	return request.ToPrivacyRequestResponse(), nil
+}
+
+func (p *privacyService) processExport(ctx context.Context, request *domain.PrivacyRequest) error {
+	user, err := p.userRepository.GetByID(ctx, request.UserID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by ID %s: %w", request.UserID, err)
+	}
+
+	if user == nil || user.AnonymizedAt != nil {
+		return domain.ErrUserNotFound
+	}
+
+	export, err := p.buildExport(ctx, *user)
+	if err != nil {
+		return err
+	}
+
+	data, err := jsoniter.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error to serialize export of user ID %s: %w", user.ID, err)
+	}
+
+	// The API serves this file on download, so the export directory must be a
+	// volume shared by the API and the privacy worker.
+	path := filepath.Join(config.Env.ExportDir, fmt.Sprintf("%s.zip", request.ID))
+	if err := writeExportArchive(path, data); err != nil {
+		return fmt.Errorf("error to write export archive of user ID %s: %w", user.ID, err)
+	}
+
+	expiresAt := time.Now().UTC().Add(time.Duration(config.Env.ExportExp) * time.Hour)
+	request.FilePath = path
+	request.ExpiresAt = &expiresAt
+
+	p.notify(ctx, client.MailMessage{
+		To:      user.Email,
+		Subject: "Your Movie Pass data export is ready",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nThe copy of your personal data is ready. Download it within %d hours at:\n\n%s/account/privacy/exports/%s\n",
+			user.FirstName, config.Env.ExportExp, config.Env.FrontURL, request.ID,
+		),
+	})
+
+	return nil
+}
+
+func (p *privacyService) processDeletion(ctx context.Context, request *domain.PrivacyRequest) error {
+	user, err := p.userRepository.GetByID(ctx, request.UserID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by ID %s: %w", request.UserID, err)
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	if user.AnonymizedAt != nil {
+		return nil
+	}
+
+	requests, err := p.privacyRepository.GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve privacy requests of user ID %s: %w", user.ID, err)
+	}
+
+	for _, previousRequest := range requests {
+		if previousRequest.ID == request.ID || previousRequest.FilePath == "" {
+			continue
+		}
+
+		if err := p.removeExportFile(ctx, previousRequest); err != nil {
+			return err
+		}
+	}
+
+	if err := p.privacyRepository.AnonymizeUser(ctx, user.ID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error to anonymize user ID %s: %w", user.ID, err)
+	}
+
+	if err := p.sessionService.RevokeAll(ctx, user.ID); err != nil {
+		return fmt.Errorf("error to revoke sessions for user ID %s: %w", user.ID, err)
+	}
+
+	p.notify(ctx, client.MailMessage{
+		To:      user.Email,
+		Subject: "Your Movie Pass account was deleted",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nYour Movie Pass account was deleted and your personal data was removed. Records we are legally required to keep, such as purchases, no longer identify you.\n",
+			user.FirstName,
+		),
+	})
+
+	return nil
+}
+
+func (p *privacyService) buildExport(ctx context.Context, user domain.User) (*domain.AccountDataExport, error) {
+	export := &domain.AccountDataExport{
+		GeneratedAt:             time.Now().UTC(),
+		Profile:                 user.ToUserResponse(),
+		Identities:              []domain.IdentityExport{},
+		Dependents:              []domain.DependentResponse{},
+		Tickets:                 []domain.TicketExport{},
+		Sessions:                []domain.SessionExport{},
+		OrganizationMemberships: []domain.MembershipExport{},
+		PrivacyRequests:         []domain.PrivacyRequestResponse{},
+	}
+
+	identities, err := p.userIdentityRepository.GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve identities of user ID %s: %w", user.ID, err)
+	}
+
+	for _, identity := range identities {
+		export.Identities = append(export.Identities, domain.IdentityExport{
+			Provider:  identity.Provider,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	dependents, err := p.dependentRepository.GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve dependents of user ID %s: %w", user.ID, err)
+	}
+
+	for _, dependent := range dependents {
+		export.Dependents = append(export.Dependents, *dependent.ToDependentResponse())
+	}
+
+	tickets, err := p.privacyRepository.GetTicketsByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve tickets of user ID %s: %w", user.ID, err)
+	}
+
+	for _, ticket := range tickets {
+		export.Tickets = append(export.Tickets, domain.TicketExport{
+			ID:              ticket.ID,
+			CinemaSessionID: ticket.CinemaSessionID,
+			SeatID:          ticket.SeatID,
+			DependentID:     ticket.DependentID,
+			CreatedAt:       ticket.CreatedAt,
+		})
+	}
+
+	sessions, err := p.sessionRepository.GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve sessions of user ID %s: %w", user.ID, err)
+	}
+
+	for _, session := range sessions {
+		export.Sessions = append(export.Sessions, domain.SessionExport{
+			DeviceName: session.DeviceName,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+		})
+	}
+
+	memberships, err := p.organizationRepository.GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve organizations of user ID %s: %w", user.ID, err)
+	}
+
+	for _, membership := range memberships {
+		export.OrganizationMemberships = append(export.OrganizationMemberships, domain.MembershipExport{
+			OrganizationID:   membership.OrganizationID,
+			OrganizationName: membership.Organization.Name,
+			Role:             membership.Role,
+			CreatedAt:        membership.CreatedAt,
+		})
+	}
+
+	requests, err := p.privacyRepository.GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve privacy requests of user ID %s: %w", user.ID, err)
+	}
+
+	for _, request := range requests {
+		export.PrivacyRequests = append(export.PrivacyRequests, *request.ToPrivacyRequestResponse())
+	}
+
+	return export, nil
+}
+
+func (p *privacyService) removeExportFile(ctx context.Context, request *domain.PrivacyRequest) error {
+	if err := os.Remove(request.FilePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error to remove export file of request ID %s: %w", request.ID, err)
+	}
+
+	request.FilePath = ""
+	request.UpdatedAt = time.Now().UTC()
+	if err := p.privacyRepository.Update(ctx, *request); err != nil {
+		return fmt.Errorf("error to update privacy request ID %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+func (p *privacyService) notify(ctx context.Context, message client.MailMessage) {
+	if err := p.mailSender.Send(ctx, message); err != nil {
+		slog.Warn("error to send privacy notification",
+			slog.String("service", "privacy"),
+			slog.String("func", "notify"),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func (p *privacyService) getSessionUser(ctx context.Context) (*domain.User, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	user, err := p.userRepository.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve user by ID %s: %w", session.UserID, err)
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func writeExportArchive(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+	entry, err := archive.Create(exportArchiveEntry)
+	if err != nil {
+		return err
+	}
+
+	if _, err := entry.Write(data); err != nil {
+		return err
+	}
+
+	if err := archive.Close(); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
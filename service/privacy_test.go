@@ -0,0 +1,242 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+)
+
+type privacyTestEnvironment struct {
+	service                    *privacyService
+	privacyRepositoryMock      *mock.MockPrivacyRepository
+	userRepositoryMock         *mock.MockUserRepository
+	userIdentityRepositoryMock *mock.MockUserIdentityRepository
+	dependentRepositoryMock    *mock.MockDependentRepository
+	organizationRepositoryMock *mock.MockOrganizationRepository
+	sessionRepositoryMock      *mock.MockSessionRepository
+	sessionServiceMock         *mock.MockSessionService
+	mailSenderMock             *mock.MockMailSender
+	rateLimiterMock            *mock.MockRateLimiter
+}
+
+func setupPrivacyEnvironment(t *testing.T, ctrl *gomock.Controller) *privacyTestEnvironment {
+	config.Env.ExportDir = t.TempDir()
+	config.Env.ExportExp = 72
+
+	env := &privacyTestEnvironment{
+		privacyRepositoryMock:      mock.NewMockPrivacyRepository(ctrl),
+		userRepositoryMock:         mock.NewMockUserRepository(ctrl),
+		userIdentityRepositoryMock: mock.NewMockUserIdentityRepository(ctrl),
+		dependentRepositoryMock:    mock.NewMockDependentRepository(ctrl),
+		organizationRepositoryMock: mock.NewMockOrganizationRepository(ctrl),
+		sessionRepositoryMock:      mock.NewMockSessionRepository(ctrl),
+		sessionServiceMock:         mock.NewMockSessionService(ctrl),
+		mailSenderMock:             mock.NewMockMailSender(ctrl),
+		rateLimiterMock:            mock.NewMockRateLimiter(ctrl),
+	}
+
+	env.service = &privacyService{
+		privacyRepository:      env.privacyRepositoryMock,
+		userRepository:         env.userRepositoryMock,
+		userIdentityRepository: env.userIdentityRepositoryMock,
+		dependentRepository:    env.dependentRepositoryMock,
+		organizationRepository: env.organizationRepositoryMock,
+		sessionRepository:      env.sessionRepositoryMock,
+		sessionService:         env.sessionServiceMock,
+		mailSender:             env.mailSenderMock,
+		rateLimiter:            env.rateLimiterMock,
+	}
+
+	return env
+}
+
+func TestPrivacyService_ProcessQueue_WhenExportIsRequested_ShouldWriteArchiveAndNotifyUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupPrivacyEnvironment(t, ctrl)
+
+	user := &domain.User{ID: uuid.New(), FirstName: "test", Email: "test@example.com"}
+	request := &domain.PrivacyRequest{ID: uuid.New(), UserID: user.ID, Type: domain.PrivacyRequestTypeExport, Status: domain.PrivacyRequestStatusPending}
+	dependent := &domain.Dependent{ID: uuid.New(), UserID: user.ID, FirstName: "kid"}
+	ticket := &domain.SeatReservation{ID: uuid.New(), UserID: user.ID, DependentID: &dependent.ID}
+
+	var finalRequest domain.PrivacyRequest
+	env.privacyRepositoryMock.EXPECT().GetByID(gomock.Any(), request.ID).Return(request, nil)
+	env.privacyRepositoryMock.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, updated domain.PrivacyRequest) error {
+			finalRequest = updated
+			return nil
+		}).Times(2)
+	env.userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	env.userIdentityRepositoryMock.EXPECT().GetAllByUserID(gomock.Any(), user.ID).Return([]*domain.UserIdentity{{Provider: "google", Email: user.Email}}, nil)
+	env.dependentRepositoryMock.EXPECT().GetAllByUserID(gomock.Any(), user.ID).Return([]*domain.Dependent{dependent}, nil)
+	env.privacyRepositoryMock.EXPECT().GetTicketsByUserID(gomock.Any(), user.ID).Return([]*domain.SeatReservation{ticket}, nil)
+	env.sessionRepositoryMock.EXPECT().GetAllByUserID(gomock.Any(), user.ID).Return([]*domain.Session{{DeviceName: "iPhone"}}, nil)
+	env.organizationRepositoryMock.EXPECT().GetAllByUserID(gomock.Any(), user.ID).Return(nil, nil)
+	env.privacyRepositoryMock.EXPECT().GetAllByUserID(gomock.Any(), user.ID).Return([]*domain.PrivacyRequest{request}, nil)
+	env.mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message client.MailMessage) error {
+			assert.Equal(t, user.Email, message.To)
+			assert.Contains(t, message.Body, request.ID.String())
+			return nil
+		})
+
+	err := env.service.ProcessQueue(context.Background(), domain.PrivacyTask{RequestID: request.ID, UserID: user.ID, Type: domain.PrivacyRequestTypeExport})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PrivacyRequestStatusCompleted, finalRequest.Status)
+	assert.NotNil(t, finalRequest.ExpiresAt)
+
+	archive, err := zip.OpenReader(finalRequest.FilePath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer archive.Close()
+
+	assert.Len(t, archive.File, 1)
+	entry, err := archive.File[0].Open()
+	assert.NoError(t, err)
+	defer entry.Close()
+
+	data, err := io.ReadAll(entry)
+	assert.NoError(t, err)
+
+	var export domain.AccountDataExport
+	assert.NoError(t, jsoniter.Unmarshal(data, &export))
+	assert.Equal(t, user.Email, export.Profile.Email)
+	assert.Len(t, export.Tickets, 1)
+	assert.Equal(t, ticket.ID, export.Tickets[0].ID)
+	assert.Equal(t, &dependent.ID, export.Tickets[0].DependentID)
+	assert.Len(t, export.Dependents, 1)
+	assert.Len(t, export.Identities, 1)
+	assert.Len(t, export.Sessions, 1)
+}
+
+func TestPrivacyService_ProcessQueue_WhenDeletionIsRequested_ShouldAnonymizeUserAndRevokeSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupPrivacyEnvironment(t, ctrl)
+
+	user := &domain.User{ID: uuid.New(), FirstName: "test", Email: "test@example.com"}
+	request := &domain.PrivacyRequest{ID: uuid.New(), UserID: user.ID, Type: domain.PrivacyRequestTypeDeletion, Status: domain.PrivacyRequestStatusPending}
+
+	var finalRequest domain.PrivacyRequest
+	env.privacyRepositoryMock.EXPECT().GetByID(gomock.Any(), request.ID).Return(request, nil)
+	env.privacyRepositoryMock.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, updated domain.PrivacyRequest) error {
+			finalRequest = updated
+			return nil
+		}).Times(2)
+	env.userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	env.privacyRepositoryMock.EXPECT().GetAllByUserID(gomock.Any(), user.ID).Return([]*domain.PrivacyRequest{request}, nil)
+	env.privacyRepositoryMock.EXPECT().AnonymizeUser(gomock.Any(), user.ID, gomock.Any()).Return(nil)
+	env.sessionServiceMock.EXPECT().RevokeAll(gomock.Any(), user.ID).Return(nil)
+	env.mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message client.MailMessage) error {
+			assert.Equal(t, "test@example.com", message.To)
+			return nil
+		})
+
+	err := env.service.ProcessQueue(context.Background(), domain.PrivacyTask{RequestID: request.ID, UserID: user.ID, Type: domain.PrivacyRequestTypeDeletion})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PrivacyRequestStatusCompleted, finalRequest.Status)
+}
+
+func TestPrivacyService_RequestDeletion_WhenPasswordIsWrong_ShouldReturnErrInvalidPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupPrivacyEnvironment(t, ctrl)
+
+	passwordHash, err := secure.HashPassword("Str0ngP@ssw0rd!")
+	assert.NoError(t, err)
+
+	user := &domain.User{ID: uuid.New(), PasswordHash: string(passwordHash)}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	env.userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	env.rateLimiterMock.EXPECT().Allow(gomock.Any(), "password_check_"+user.ID.String(), gomock.Any(), gomock.Any()).Return(nil)
+
+	response, err := env.service.RequestDeletion(ctx, domain.DeleteAccountPayload{Password: "WrongP@ssw0rd!", Confirmation: "DELETE"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidPassword)
+	assert.Nil(t, response)
+}
+
+func TestPrivacyService_RequestDeletion_WhenUserOwnsOrganization_ShouldReturnErrAccountOwnsOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupPrivacyEnvironment(t, ctrl)
+
+	user := &domain.User{ID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	env.userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	env.organizationRepositoryMock.EXPECT().GetAllByUserID(gomock.Any(), user.ID).Return([]*domain.OrganizationMember{{Role: domain.OrganizationRoleOwner}}, nil)
+
+	response, err := env.service.RequestDeletion(ctx, domain.DeleteAccountPayload{Confirmation: "DELETE"})
+
+	assert.ErrorIs(t, err, domain.ErrAccountOwnsOrganization)
+	assert.Nil(t, response)
+}
+
+func TestPrivacyService_RequestExport_WhenExportIsInProgress_ShouldReturnErrPrivacyRequestInProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupPrivacyEnvironment(t, ctrl)
+
+	user := &domain.User{ID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	env.userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	env.rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	env.privacyRepositoryMock.EXPECT().GetOpenByUserID(gomock.Any(), user.ID, domain.PrivacyRequestTypeExport).Return(&domain.PrivacyRequest{ID: uuid.New()}, nil)
+
+	response, err := env.service.RequestExport(ctx)
+
+	assert.ErrorIs(t, err, domain.ErrPrivacyRequestInProgress)
+	assert.Nil(t, response)
+}
+
+func TestPrivacyService_GetExportFile_WhenExportExpired_ShouldReturnErrExportExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupPrivacyEnvironment(t, ctrl)
+
+	userID := uuid.New()
+	expiresAt := time.Now().UTC().Add(-time.Hour)
+	request := &domain.PrivacyRequest{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Type:      domain.PrivacyRequestTypeExport,
+		Status:    domain.PrivacyRequestStatusCompleted,
+		FilePath:  "export.zip",
+		ExpiresAt: &expiresAt,
+	}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: userID})
+
+	env.privacyRepositoryMock.EXPECT().GetByID(gomock.Any(), request.ID).Return(request, nil)
+
+	path, err := env.service.GetExportFile(ctx, request.ID)
+
+	assert.ErrorIs(t, err, domain.ErrExportExpired)
+	assert.Empty(t, path)
+}
@@ -2,20 +2,37 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"time"
 
 	"github.com/GSVillas/movie-pass-api/config"
 	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/secure"
 	"github.com/golang-jwt/jwt"
-	jsoniter "github.com/json-iterator/go"
+	"github.com/google/uuid"
 	"github.com/samber/do"
 )
 
+const (
+	sessionLastSeenInterval = time.Minute
+	refreshTokenSize        = 32
+)
+
 type sessionService struct {
 	i                 *do.Injector
 	sessionRepository domain.SessionRepository
 }
 
+type sessionClaims struct {
+	jwt.StandardClaims
+	UserID    uuid.UUID `json:"moviePassId"`
+	SessionID uuid.UUID `json:"sessionId"`
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	Email     string    `json:"email"`
+}
+
 func NewSessionService(i *do.Injector) (domain.SessionService, error) {
 	sessionRepository, err := do.Invoke[domain.SessionRepository](i)
 	if err != nil {
@@ -28,95 +45,350 @@ func NewSessionService(i *do.Injector) (domain.SessionService, error) {
 	}, nil
 }
 
-func (s *sessionService) Create(ctx context.Context, user domain.User) (string, error) {
-	token, err := s.createToken(user)
+func (s *sessionService) Create(ctx context.Context, user domain.User, metadata domain.SessionMetadata) (*domain.SessionTokens, error) {
+	now := time.Now().UTC()
+	session := &domain.Session{
+		ID:                uuid.New(),
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		UserID:            user.ID,
+		Email:             user.Email,
+		DeviceName:        metadata.DeviceName,
+		IPAddress:         metadata.IPAddress,
+		CreatedAt:         now,
+		LastSeenAt:        now,
+		TwoFactorVerified: user.TwoFactorEnabledAt != nil,
+	}
+
+	if err := s.sessionRepository.Create(ctx, *session); err != nil {
+		return nil, fmt.Errorf("failed to create session for user ID %s: %w", user.ID, err)
+	}
+
+	tokens, err := s.issueTokens(ctx, *session)
 	if err != nil {
-		return "", fmt.Errorf("failed to create token for user ID %s: %w", user.ID, err)
+		return nil, fmt.Errorf("failed to issue tokens for user ID %s: %w", user.ID, err)
 	}
 
-	session := &domain.Session{
-		Token:     token,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		UserID:    user.ID,
-		Email:     user.Email,
+	return tokens, nil
+}
+
+// Refresh rotates the refresh token of a session. A refresh token can only be
+// used once: presenting it again means it leaked, so the whole session (the
+// token family) is revoked.
+func (s *sessionService) Refresh(ctx context.Context, refreshToken string) (*domain.SessionTokens, error) {
+	tokenHash := secure.HashToken(refreshToken)
+
+	storedToken, err := s.sessionRepository.GetRefreshToken(ctx, tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
 	}
 
-	if err := s.sessionRepository.Create(ctx, *session); err != nil {
-		return "", fmt.Errorf("failed to create session for user ID %s: %w", user.ID, err)
+	if storedToken == nil {
+		return nil, domain.ErrRefreshTokenInvalid
+	}
+
+	firstUse, err := s.sessionRepository.ConsumeRefreshToken(ctx, tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume refresh token of session ID %s: %w", storedToken.SessionID, err)
+	}
+
+	if !firstUse {
+		if err := s.sessionRepository.Delete(ctx, storedToken.UserID, storedToken.SessionID); err != nil {
+			return nil, fmt.Errorf("failed to revoke session ID %s after refresh token reuse: %w", storedToken.SessionID, err)
+		}
+
+		return nil, domain.ErrRefreshTokenReused
+	}
+
+	session, err := s.sessionRepository.GetSession(ctx, storedToken.UserID, storedToken.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session ID %s for user ID %s: %w", storedToken.SessionID, storedToken.UserID, err)
+	}
+
+	if session == nil {
+		return nil, domain.ErrRefreshTokenInvalid
+	}
+
+	session.LastSeenAt = time.Now().UTC()
+	if err := s.sessionRepository.Update(ctx, *session); err != nil {
+		return nil, fmt.Errorf("failed to update last seen of session ID %s: %w", session.ID, err)
+	}
+
+	tokens, err := s.issueTokens(ctx, *session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue tokens for user ID %s: %w", session.UserID, err)
 	}
 
-	return token, nil
+	return tokens, nil
 }
 
 func (s *sessionService) GetSession(ctx context.Context, token string) (*domain.Session, error) {
-	sessionToken, err := s.extractSessionFromToken(token)
+	claims, err := s.extractClaimsFromToken(token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract session from token: %w", err)
 	}
 
-	session, err := s.sessionRepository.GetSession(ctx, sessionToken.UserID)
+	revokedBefore, err := s.sessionRepository.GetRevokedBefore(ctx, claims.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revocation for user ID %s: %w", claims.UserID, err)
+	}
+
+	if revokedBefore != nil && claims.IssuedAt < revokedBefore.Unix() {
+		return nil, domain.ErrSessionRevoked
+	}
+
+	session, err := s.sessionRepository.GetSession(ctx, claims.UserID, claims.SessionID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get session for user ID %s: %w", sessionToken.UserID, err)
+		return nil, fmt.Errorf("failed to get session ID %s for user ID %s: %w", claims.SessionID, claims.UserID, err)
 	}
 
 	if session == nil {
 		return nil, domain.ErrSessionNotFound
 	}
 
-	if token != session.Token {
+	if session.UserID != claims.UserID {
 		return nil, domain.ErrSessionMismatch
 	}
 
+	if now := time.Now().UTC(); now.Sub(session.LastSeenAt) > sessionLastSeenInterval {
+		session.LastSeenAt = now
+		if err := s.sessionRepository.Update(ctx, *session); err != nil {
+			return nil, fmt.Errorf("failed to update last seen of session ID %s: %w", session.ID, err)
+		}
+	}
+
 	return session, nil
 }
 
-func (s *sessionService) createToken(user domain.User) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
-		"moviePassId": user.ID,
-		"firstName":   user.FirstName,
-		"lastName":    user.LastName,
-		"email":       user.Email,
-	})
+func (s *sessionService) GetAll(ctx context.Context) ([]*domain.SessionResponse, error) {
+	currentSession, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || currentSession == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
 
-	tokenString, err := token.SignedString(config.Env.PrivateKey)
+	sessions, err := s.sessionRepository.GetAllByUserID(ctx, currentSession.UserID)
 	if err != nil {
-		return "", fmt.Errorf("failed to sign token for user ID %s: %w", user.ID, err)
+		return nil, fmt.Errorf("failed to get sessions for user ID %s: %w", currentSession.UserID, err)
 	}
 
-	return tokenString, nil
+	var sessionsResponse []*domain.SessionResponse
+	for _, session := range sessions {
+		sessionsResponse = append(sessionsResponse, session.ToSessionResponse(currentSession.ID))
+	}
+
+	return sessionsResponse, nil
+}
+
+func (s *sessionService) SignOut(ctx context.Context) error {
+	currentSession, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || currentSession == nil {
+		return domain.ErrUserNotFoundInContext
+	}
+
+	if err := s.sessionRepository.Delete(ctx, currentSession.UserID, currentSession.ID); err != nil {
+		return fmt.Errorf("failed to delete session ID %s for user ID %s: %w", currentSession.ID, currentSession.UserID, err)
+	}
+
+	return nil
 }
 
-func (s *sessionService) extractSessionFromToken(tokenString string) (*domain.Session, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
-			return nil, domain.ErrorUnexpectedMethod
+func (s *sessionService) Delete(ctx context.Context, sessionID uuid.UUID) error {
+	currentSession, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || currentSession == nil {
+		return domain.ErrUserNotFoundInContext
+	}
+
+	session, err := s.sessionRepository.GetSession(ctx, currentSession.UserID, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get session ID %s for user ID %s: %w", sessionID, currentSession.UserID, err)
+	}
+
+	if session == nil {
+		return domain.ErrSessionNotFound
+	}
+
+	if err := s.sessionRepository.Delete(ctx, currentSession.UserID, sessionID); err != nil {
+		return fmt.Errorf("failed to delete session ID %s for user ID %s: %w", sessionID, currentSession.UserID, err)
+	}
+
+	return nil
+}
+
+func (s *sessionService) DeleteAll(ctx context.Context) error {
+	currentSession, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || currentSession == nil {
+		return domain.ErrUserNotFoundInContext
+	}
+
+	return s.RevokeAll(ctx, currentSession.UserID)
+}
+
+// RevokeAll signs the user out everywhere. Besides removing the stored
+// sessions it records the revocation time, so tokens issued before it are
+// rejected even if a session with the same ID is ever found again.
+func (s *sessionService) RevokeAll(ctx context.Context, userID uuid.UUID) error {
+	if err := s.sessionRepository.SetRevokedBefore(ctx, userID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to revoke tokens for user ID %s: %w", userID, err)
+	}
+
+	if err := s.sessionRepository.DeleteAll(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete sessions for user ID %s: %w", userID, err)
+	}
+
+	return nil
+}
+
+// RevokeOthers signs the user out of every session but the current one,
+// used after credential changes made from that session.
+func (s *sessionService) RevokeOthers(ctx context.Context) error {
+	currentSession, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || currentSession == nil {
+		return domain.ErrUserNotFoundInContext
+	}
+
+	sessions, err := s.sessionRepository.GetAllByUserID(ctx, currentSession.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get sessions for user ID %s: %w", currentSession.UserID, err)
+	}
+
+	for _, session := range sessions {
+		if session.ID == currentSession.ID {
+			continue
 		}
-		return config.Env.PublicKey, nil
-	})
 
+		if err := s.sessionRepository.Delete(ctx, session.UserID, session.ID); err != nil {
+			return fmt.Errorf("failed to delete session ID %s for user ID %s: %w", session.ID, session.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+// SyncUser refreshes the user data cached in the sessions, so tokens issued
+// on the next refresh carry the current names and email.
+func (s *sessionService) SyncUser(ctx context.Context, user domain.User) error {
+	sessions, err := s.sessionRepository.GetAllByUserID(ctx, user.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse token: %w", err)
+		return fmt.Errorf("failed to get sessions for user ID %s: %w", user.ID, err)
 	}
 
-	if !token.Valid {
-		return nil, domain.ErrTokenInvalid
+	for _, session := range sessions {
+		session.FirstName = user.FirstName
+		session.LastName = user.LastName
+		session.Email = user.Email
+
+		if err := s.sessionRepository.Update(ctx, *session); err != nil {
+			return fmt.Errorf("failed to update session ID %s: %w", session.ID, err)
+		}
 	}
 
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, domain.ErrTokenInvalid
+	return nil
+}
+
+func (s *sessionService) MarkTwoFactorVerified(ctx context.Context) error {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return domain.ErrUserNotFoundInContext
 	}
 
-	sessionJSON, err := jsoniter.Marshal(claims)
+	session.TwoFactorVerified = true
+	if err := s.sessionRepository.Update(ctx, *session); err != nil {
+		return fmt.Errorf("failed to update session ID %s: %w", session.ID, err)
+	}
+
+	return nil
+}
+
+func (s *sessionService) GetJWKS() *domain.JSONWebKeySet {
+	jwks := &domain.JSONWebKeySet{
+		Keys: []domain.JSONWebKey{},
+	}
+
+	for _, signingKey := range config.Env.KeyRing.Keys {
+		byteSize := (signingKey.PublicKey.Curve.Params().BitSize + 7) / 8
+		jwks.Keys = append(jwks.Keys, domain.JSONWebKey{
+			KeyType:   "EC",
+			Curve:     signingKey.PublicKey.Curve.Params().Name,
+			X:         base64.RawURLEncoding.EncodeToString(signingKey.PublicKey.X.FillBytes(make([]byte, byteSize))),
+			Y:         base64.RawURLEncoding.EncodeToString(signingKey.PublicKey.Y.FillBytes(make([]byte, byteSize))),
+			KeyID:     signingKey.KID,
+			Use:       "sig",
+			Algorithm: jwt.SigningMethodES256.Alg(),
+		})
+	}
+
+	return jwks
+}
+
+func (s *sessionService) issueTokens(ctx context.Context, session domain.Session) (*domain.SessionTokens, error) {
+	issuedAt := time.Now().UTC()
+	accessToken, err := s.createToken(session, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := secure.GenerateToken(refreshTokenSize)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal claims into JSON: %w", err)
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	storedToken := domain.RefreshToken{
+		UserID:    session.UserID,
+		SessionID: session.ID,
+		CreatedAt: issuedAt,
+	}
+
+	if err := s.sessionRepository.CreateRefreshToken(ctx, secure.HashToken(refreshToken), storedToken); err != nil {
+		return nil, fmt.Errorf("failed to store refresh token of session ID %s: %w", session.ID, err)
 	}
 
-	var session domain.Session
-	if err := jsoniter.Unmarshal(sessionJSON, &session); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal session from JSON: %w", err)
+	return &domain.SessionTokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.accessTokenExpiration().Seconds()),
+	}, nil
+}
+
+func (s *sessionService) accessTokenExpiration() time.Duration {
+	return time.Duration(config.Env.AccessTokenExp) * time.Minute
+}
+
+func (s *sessionService) createToken(session domain.Session, issuedAt time.Time) (string, error) {
+	tokenString, err := secure.SignToken(sessionClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.NewString(),
+			Subject:   session.UserID.String(),
+			Issuer:    config.Env.TokenIssuer,
+			Audience:  config.Env.TokenAudience,
+			IssuedAt:  issuedAt.Unix(),
+			ExpiresAt: issuedAt.Add(s.accessTokenExpiration()).Unix(),
+		},
+		UserID:    session.UserID,
+		SessionID: session.ID,
+		FirstName: session.FirstName,
+		LastName:  session.LastName,
+		Email:     session.Email,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token for user ID %s: %w", session.UserID, err)
+	}
+
+	return tokenString, nil
+}
+
+func (s *sessionService) extractClaimsFromToken(tokenString string) (*sessionClaims, error) {
+	var claims sessionClaims
+	if err := secure.ParseToken(tokenString, &claims); err != nil {
+		return nil, err
+	}
+
+	if claims.SessionID == uuid.Nil {
+		return nil, domain.ErrTokenInvalid
+	}
+
+	if !claims.VerifyIssuer(config.Env.TokenIssuer, true) || !claims.VerifyAudience(config.Env.TokenAudience, true) {
+		return nil, domain.ErrTokenInvalid
 	}
 
-	return &session, nil
+	return &claims, nil
 }
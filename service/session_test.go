@@ -6,22 +6,38 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"testing"
+	"time"
 
 	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/config/model"
 	"github.com/GSVillas/movie-pass-api/domain"
 	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/GSVillas/movie-pass-api/secure"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestSessionService_Create_WhenSuccessful_ShouldReturnToken(t *testing.T) {
-	var err error
-	config.Env.PrivateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+func setupSessionEnvironment(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
 		t.Fatalf("Failed to generate private key: %v", err)
 	}
 
+	config.Env.KeyRing = model.KeyRing{
+		ActiveKID: "test",
+		Keys: []*model.SigningKey{
+			{KID: "test", PrivateKey: privateKey, PublicKey: &privateKey.PublicKey},
+		},
+	}
+	config.Env.AccessTokenExp = 15
+	config.Env.TokenIssuer = "movie-pass-api"
+	config.Env.TokenAudience = "movie-pass"
+}
+
+func TestSessionService_Create_WhenSuccessful_ShouldReturnTokens(t *testing.T) {
+	setupSessionEnvironment(t)
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -38,8 +54,285 @@ func TestSessionService_Create_WhenSuccessful_ShouldReturnToken(t *testing.T) {
 	}
 
 	sessionRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	sessionRepositoryMock.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	tokens, err := sessionService.Create(context.Background(), *user, domain.SessionMetadata{DeviceName: "iPhone", IPAddress: "127.0.0.1"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tokens.AccessToken)
+	assert.NotEmpty(t, tokens.RefreshToken)
+}
+
+func TestSessionService_GetSession_WhenSessionExists_ShouldReturnSession(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	session := &domain.Session{
+		ID:         uuid.New(),
+		UserID:     uuid.New(),
+		CreatedAt:  time.Now().UTC(),
+		LastSeenAt: time.Now().UTC(),
+	}
+
+	token, err := sessionService.createToken(*session, time.Now().UTC())
+	assert.NoError(t, err)
+
+	sessionRepositoryMock.EXPECT().GetRevokedBefore(gomock.Any(), session.UserID).Return(nil, nil)
+	sessionRepositoryMock.EXPECT().GetSession(gomock.Any(), session.UserID, session.ID).Return(session, nil)
+
+	response, err := sessionService.GetSession(context.Background(), token)
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID, response.ID)
+}
+
+func TestSessionService_GetSession_WhenSessionWasRevoked_ShouldReturnErrSessionNotFound(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	session := &domain.Session{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	token, err := sessionService.createToken(*session, time.Now().UTC())
+	assert.NoError(t, err)
+
+	sessionRepositoryMock.EXPECT().GetRevokedBefore(gomock.Any(), session.UserID).Return(nil, nil)
+	sessionRepositoryMock.EXPECT().GetSession(gomock.Any(), session.UserID, session.ID).Return(nil, nil)
+
+	response, err := sessionService.GetSession(context.Background(), token)
+	assert.ErrorIs(t, err, domain.ErrSessionNotFound)
+	assert.Nil(t, response)
+}
+
+func TestSessionService_GetSession_WhenTokenIssuedBeforeRevocation_ShouldReturnErrSessionRevoked(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	session := &domain.Session{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+	}
+
+	token, err := sessionService.createToken(*session, time.Now().UTC())
+	assert.NoError(t, err)
+
+	revokedBefore := time.Now().UTC().Add(time.Minute)
+	sessionRepositoryMock.EXPECT().GetRevokedBefore(gomock.Any(), session.UserID).Return(&revokedBefore, nil)
+
+	response, err := sessionService.GetSession(context.Background(), token)
+	assert.ErrorIs(t, err, domain.ErrSessionRevoked)
+	assert.Nil(t, response)
+}
+
+func TestSessionService_DeleteAll_WhenSuccessful_ShouldRevokeAndDeleteSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+
+	sessionRepositoryMock.EXPECT().SetRevokedBefore(gomock.Any(), session.UserID, gomock.Any()).Return(nil)
+	sessionRepositoryMock.EXPECT().DeleteAll(gomock.Any(), session.UserID).Return(nil)
+
+	err := sessionService.DeleteAll(ctx)
+	assert.NoError(t, err)
+}
+
+func TestSessionService_GetSession_WhenTokenIsExpired_ShouldReturnErrTokenInvalid(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+	token, err := sessionService.createToken(*session, time.Now().UTC().Add(-time.Hour))
+	assert.NoError(t, err)
+
+	response, err := sessionService.GetSession(context.Background(), token)
+	assert.ErrorIs(t, err, domain.ErrTokenInvalid)
+	assert.Nil(t, response)
+}
+
+func TestSessionService_Refresh_WhenTokenIsValid_ShouldRotateTokens(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	storedToken := &domain.RefreshToken{UserID: session.UserID, SessionID: session.ID}
+
+	sessionRepositoryMock.EXPECT().GetRefreshToken(gomock.Any(), secure.HashToken("refresh-token")).Return(storedToken, nil)
+	sessionRepositoryMock.EXPECT().ConsumeRefreshToken(gomock.Any(), secure.HashToken("refresh-token")).Return(true, nil)
+	sessionRepositoryMock.EXPECT().GetSession(gomock.Any(), session.UserID, session.ID).Return(session, nil)
+	sessionRepositoryMock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	sessionRepositoryMock.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	tokens, err := sessionService.Refresh(context.Background(), "refresh-token")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tokens.AccessToken)
+	assert.NotEqual(t, "refresh-token", tokens.RefreshToken)
+}
+
+func TestSessionService_Refresh_WhenTokenIsReused_ShouldRevokeSessionAndReturnErrRefreshTokenReused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	storedToken := &domain.RefreshToken{UserID: uuid.New(), SessionID: uuid.New()}
+
+	sessionRepositoryMock.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(storedToken, nil)
+	sessionRepositoryMock.EXPECT().ConsumeRefreshToken(gomock.Any(), gomock.Any()).Return(false, nil)
+	sessionRepositoryMock.EXPECT().Delete(gomock.Any(), storedToken.UserID, storedToken.SessionID).Return(nil)
+
+	tokens, err := sessionService.Refresh(context.Background(), "refresh-token")
+	assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	assert.Nil(t, tokens)
+}
+
+func TestSessionService_GetSession_WhenSignedWithRetiredKey_ShouldReturnSession(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	session := &domain.Session{
+		ID:         uuid.New(),
+		UserID:     uuid.New(),
+		CreatedAt:  time.Now().UTC(),
+		LastSeenAt: time.Now().UTC(),
+	}
+
+	token, err := sessionService.createToken(*session, time.Now().UTC())
+	assert.NoError(t, err)
+
+	newPrivateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	retiredKey := config.Env.KeyRing.Get("test")
+	retiredKey.PrivateKey = nil
+	config.Env.KeyRing = model.KeyRing{
+		ActiveKID: "new",
+		Keys: []*model.SigningKey{
+			{KID: "new", PrivateKey: newPrivateKey, PublicKey: &newPrivateKey.PublicKey},
+			retiredKey,
+		},
+	}
+
+	sessionRepositoryMock.EXPECT().GetRevokedBefore(gomock.Any(), session.UserID).Return(nil, nil)
+	sessionRepositoryMock.EXPECT().GetSession(gomock.Any(), session.UserID, session.ID).Return(session, nil)
+
+	response, err := sessionService.GetSession(context.Background(), token)
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID, response.ID)
+}
+
+func TestSessionService_GetSession_WhenKeyIDIsUnknown_ShouldReturnErrTokenInvalid(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	session := &domain.Session{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+	}
+
+	token, err := sessionService.createToken(*session, time.Now().UTC())
+	assert.NoError(t, err)
+
+	config.Env.KeyRing.Keys[0].KID = "removed"
+
+	response, err := sessionService.GetSession(context.Background(), token)
+	assert.ErrorIs(t, err, domain.ErrTokenInvalid)
+	assert.Nil(t, response)
+}
+
+func TestSessionService_GetJWKS_ShouldReturnPublicKeys(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	sessionService := &sessionService{}
+
+	jwks := sessionService.GetJWKS()
+	assert.Len(t, jwks.Keys, 1)
+	assert.Equal(t, "test", jwks.Keys[0].KeyID)
+	assert.Equal(t, "EC", jwks.Keys[0].KeyType)
+	assert.Equal(t, "P-256", jwks.Keys[0].Curve)
+	assert.Equal(t, "ES256", jwks.Keys[0].Algorithm)
+	assert.Len(t, jwks.Keys[0].X, 43)
+	assert.Len(t, jwks.Keys[0].Y, 43)
+}
+
+func TestSessionService_RevokeOthers_WhenSuccessful_ShouldKeepCurrentSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepositoryMock := mock.NewMockSessionRepository(ctrl)
+	sessionService := &sessionService{
+		sessionRepository: sessionRepositoryMock,
+	}
+
+	userID := uuid.New()
+	currentSession := &domain.Session{ID: uuid.New(), UserID: userID}
+	otherSession := &domain.Session{ID: uuid.New(), UserID: userID}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, currentSession)
+
+	sessionRepositoryMock.EXPECT().GetAllByUserID(gomock.Any(), userID).Return([]*domain.Session{currentSession, otherSession}, nil)
+	sessionRepositoryMock.EXPECT().Delete(gomock.Any(), userID, otherSession.ID).Return(nil)
+
+	err := sessionService.RevokeOthers(ctx)
 
-	token, err := sessionService.Create(context.Background(), *user)
 	assert.NoError(t, err)
-	assert.NotEmpty(t, token)
 }
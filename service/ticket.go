@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+// adultAge is the rating that cannot be waived by a guardian.
+const adultAge = 18
+
+type ticketService struct {
+	i                    *do.Injector
+	ticketRepository     domain.TicketRepository
+	seatRepository       domain.SeatRepository
+	userRepository       domain.UserRepository
+	dependentRepository  domain.DependentRepository
+	authorizationService domain.AuthorizationService
+}
+
+func NewTicketService(i *do.Injector) (domain.TicketService, error) {
+	ticketRepository, err := do.Invoke[domain.TicketRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize TicketRepository: %w", err)
+	}
+
+	seatRepository, err := do.Invoke[domain.SeatRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize SeatRepository: %w", err)
+	}
+
+	userRepository, err := do.Invoke[domain.UserRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize UserRepository: %w", err)
+	}
+
+	dependentRepository, err := do.Invoke[domain.DependentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize DependentRepository: %w", err)
+	}
+
+	authorizationService, err := do.Invoke[domain.AuthorizationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuthorizationService: %w", err)
+	}
+
+	return &ticketService{
+		i:                    i,
+		ticketRepository:     ticketRepository,
+		seatRepository:       seatRepository,
+		userRepository:       userRepository,
+		dependentRepository:  dependentRepository,
+		authorizationService: authorizationService,
+	}, nil
+}
+
+func (t *ticketService) Create(ctx context.Context, cinemaSessionID uuid.UUID, payload domain.TicketPayload) (*domain.TicketResponse, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	cinemaSession, err := t.ticketRepository.GetCinemaSessionByID(ctx, cinemaSessionID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve cinema session by ID %s: %w", cinemaSessionID, err)
+	}
+
+	if cinemaSession == nil {
+		return nil, domain.ErrCinemaSessionNotFound
+	}
+
+	now := time.Now().UTC()
+	if !cinemaSession.StartTime.After(now) {
+		return nil, domain.ErrCinemaSessionStarted
+	}
+
+	seat, err := t.seatRepository.GetByID(ctx, payload.SeatID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve seat by ID %s: %w", payload.SeatID, err)
+	}
+
+	if seat == nil || seat.CinemaRoomID != cinemaSession.CinemaRoomID {
+		return nil, domain.ErrSeatNotFound
+	}
+
+	user, err := t.userRepository.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve user by ID %s: %w", session.UserID, err)
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	reservation := domain.SeatReservation{
+		ID:              uuid.New(),
+		CinemaSessionID: cinemaSession.ID,
+		SeatID:          seat.ID,
+		UserID:          user.ID,
+		User:            *user,
+		CreatedAt:       now,
+	}
+
+	if payload.DependentID != nil {
+		dependent, err := t.dependentRepository.GetByID(ctx, *payload.DependentID)
+		if err != nil {
+			return nil, fmt.Errorf("error to retrieve dependent by ID %s: %w", *payload.DependentID, err)
+		}
+
+		if dependent == nil || dependent.UserID != user.ID {
+			return nil, domain.ErrDependentNotFound
+		}
+
+		reservation.DependentID = &dependent.ID
+		reservation.Dependent = dependent
+	}
+
+	_, holderBirthDate := reservation.Holder()
+	reservation.MinimumAge = cinemaSession.Movie.IndicativeRating.MinimumAge()
+	reservation.RequiresGuardian, err = checkAgeRating(holderBirthDate, reservation.MinimumAge, cinemaSession, payload.AccompaniedByGuardian)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.ticketRepository.Create(ctx, reservation); err != nil {
+		if errors.Is(err, domain.ErrSeatUnavailable) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("error to reserve seat ID %s for user ID %s: %w", seat.ID, user.ID, err)
+	}
+
+	reservation.CinemaSession = *cinemaSession
+	reservation.Seat = *seat
+
+	return reservation.ToTicketResponse(), nil
+}
+
+func (t *ticketService) GetAllByUser(ctx context.Context) ([]*domain.TicketResponse, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	reservations, err := t.ticketRepository.GetAllByUserID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to fetch tickets for user ID %s: %w", session.UserID, err)
+	}
+
+	response := make([]*domain.TicketResponse, 0, len(reservations))
+	for _, reservation := range reservations {
+		response = append(response, reservation.ToTicketResponse())
+	}
+
+	return response, nil
+}
+
+func (t *ticketService) GetForCheckIn(ctx context.Context, cinemaID, ticketID uuid.UUID) (*domain.TicketCheckInResponse, error) {
+	reservation, err := t.getCinemaTicket(ctx, cinemaID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation.ToTicketCheckInResponse(), nil
+}
+
+func (t *ticketService) CheckIn(ctx context.Context, cinemaID, ticketID uuid.UUID) (*domain.TicketCheckInResponse, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	reservation, err := t.getCinemaTicket(ctx, cinemaID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkedInAt := time.Now().UTC()
+	checkedIn, err := t.ticketRepository.CheckIn(ctx, reservation.ID, session.UserID, checkedInAt)
+	if err != nil {
+		return nil, fmt.Errorf("error to check in ticket ID %s: %w", reservation.ID, err)
+	}
+
+	if !checkedIn {
+		return nil, domain.ErrTicketAlreadyCheckedIn
+	}
+
+	reservation.CheckedInAt = &checkedInAt
+	reservation.CheckedInBy = &session.UserID
+
+	return reservation.ToTicketCheckInResponse(), nil
+}
+
+func (t *ticketService) getCinemaTicket(ctx context.Context, cinemaID, ticketID uuid.UUID) (*domain.SeatReservation, error) {
+	if err := t.authorizationService.Authorize(ctx, cinemaID, domain.PermissionTicketCheckIn); err != nil {
+		return nil, err
+	}
+
+	reservation, err := t.ticketRepository.GetByID(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve ticket by ID %s: %w", ticketID, err)
+	}
+
+	if reservation == nil || reservation.CinemaSession.CinemaRoom.CinemaID != cinemaID {
+		return nil, domain.ErrTicketNotFound
+	}
+
+	return reservation, nil
+}
+
+// checkAgeRating compares the holder's age on the session date with the
+// movie rating. It reports whether the ticket is only valid with a guardian.
+func checkAgeRating(birthDate *time.Time, minimumAge int, cinemaSession *domain.CinemaSession, accompaniedByGuardian bool) (bool, error) {
+	if minimumAge == 0 {
+		return false, nil
+	}
+
+	if birthDate == nil {
+		return false, domain.ErrBirthDateRequired
+	}
+
+	if domain.AgeOn(*birthDate, cinemaSession.StartTime) >= minimumAge {
+		return false, nil
+	}
+
+	if minimumAge >= adultAge || !cinemaSession.CinemaRoom.Cinema.AllowAccompaniedMinors {
+		return false, domain.ErrAgeRatingNotMet
+	}
+
+	if !accompaniedByGuardian {
+		return false, domain.ErrGuardianRequired
+	}
+
+	return true, nil
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type ticketTestEnvironment struct {
+	service                  *ticketService
+	ticketRepositoryMock     *mock.MockTicketRepository
+	seatRepositoryMock       *mock.MockSeatRepository
+	userRepositoryMock       *mock.MockUserRepository
+	dependentRepositoryMock  *mock.MockDependentRepository
+	authorizationServiceMock *mock.MockAuthorizationService
+}
+
+func setupTicketEnvironment(ctrl *gomock.Controller) *ticketTestEnvironment {
+	env := &ticketTestEnvironment{
+		ticketRepositoryMock:     mock.NewMockTicketRepository(ctrl),
+		seatRepositoryMock:       mock.NewMockSeatRepository(ctrl),
+		userRepositoryMock:       mock.NewMockUserRepository(ctrl),
+		dependentRepositoryMock:  mock.NewMockDependentRepository(ctrl),
+		authorizationServiceMock: mock.NewMockAuthorizationService(ctrl),
+	}
+
+	env.service = &ticketService{
+		ticketRepository:     env.ticketRepositoryMock,
+		seatRepository:       env.seatRepositoryMock,
+		userRepository:       env.userRepositoryMock,
+		dependentRepository:  env.dependentRepositoryMock,
+		authorizationService: env.authorizationServiceMock,
+	}
+
+	return env
+}
+
+func newRatedCinemaSession(rating string, allowAccompaniedMinors bool) *domain.CinemaSession {
+	roomID := uuid.New()
+	cinemaID := uuid.New()
+
+	return &domain.CinemaSession{
+		ID:           uuid.New(),
+		CinemaRoomID: roomID,
+		CinemaRoom: domain.CinemaRoom{
+			ID:       roomID,
+			CinemaID: cinemaID,
+			Cinema:   domain.Cinema{ID: cinemaID, AllowAccompaniedMinors: allowAccompaniedMinors},
+		},
+		Movie: domain.Movie{
+			Title:            "Movie",
+			IndicativeRating: domain.IndicativeRating{Description: rating},
+		},
+		StartTime: time.Now().UTC().AddDate(0, 0, 7),
+	}
+}
+
+func (e *ticketTestEnvironment) expectPurchase(cinemaSession *domain.CinemaSession, user *domain.User) (context.Context, uuid.UUID) {
+	seat := &domain.Seat{ID: uuid.New(), CinemaRoomID: cinemaSession.CinemaRoomID, SeatIdentifier: "A1"}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	e.ticketRepositoryMock.EXPECT().GetCinemaSessionByID(gomock.Any(), cinemaSession.ID).Return(cinemaSession, nil)
+	e.seatRepositoryMock.EXPECT().GetByID(gomock.Any(), seat.ID).Return(seat, nil)
+	e.userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+	return ctx, seat.ID
+}
+
+func birthDateForAge(sessionStart time.Time, age int) *time.Time {
+	birthDate := sessionStart.AddDate(-age, 0, 0)
+	return &birthDate
+}
+
+func TestTicketService_Create_WhenHolderMeetsRating_ShouldReserveSeat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A14", false)
+	user := &domain.User{ID: uuid.New(), BirthDate: birthDateForAge(cinemaSession.StartTime, 14)}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	env.ticketRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, reservation domain.SeatReservation) error {
+			assert.Equal(t, 14, reservation.MinimumAge)
+			assert.False(t, reservation.RequiresGuardian)
+			assert.Equal(t, user.ID, reservation.UserID)
+			return nil
+		})
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "A14", response.IndicativeRating)
+	assert.Equal(t, "A1", response.SeatIdentifier)
+}
+
+func TestTicketService_Create_WhenHolderIsUnderAgeAndCinemaDisallowsMinors_ShouldReturnErrAgeRatingNotMet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A14", false)
+	user := &domain.User{ID: uuid.New(), BirthDate: birthDateForAge(cinemaSession.StartTime.AddDate(0, 0, 1), 14)}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID, AccompaniedByGuardian: true})
+
+	assert.ErrorIs(t, err, domain.ErrAgeRatingNotMet)
+	assert.Nil(t, response)
+}
+
+func TestTicketService_Create_WhenHolderIsUnderAgeAndAccompanied_ShouldFlagGuardian(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A12", true)
+	user := &domain.User{ID: uuid.New(), BirthDate: birthDateForAge(cinemaSession.StartTime, 9)}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	env.ticketRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID, AccompaniedByGuardian: true})
+
+	assert.NoError(t, err)
+	assert.True(t, response.RequiresGuardian)
+}
+
+func TestTicketService_Create_WhenHolderIsUnderAgeWithoutGuardian_ShouldReturnErrGuardianRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A12", true)
+	user := &domain.User{ID: uuid.New(), BirthDate: birthDateForAge(cinemaSession.StartTime, 9)}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID})
+
+	assert.ErrorIs(t, err, domain.ErrGuardianRequired)
+	assert.Nil(t, response)
+}
+
+func TestTicketService_Create_WhenRatingIsA18AndHolderIsAccompanied_ShouldReturnErrAgeRatingNotMet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A18", true)
+	user := &domain.User{ID: uuid.New(), BirthDate: birthDateForAge(cinemaSession.StartTime, 17)}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID, AccompaniedByGuardian: true})
+
+	assert.ErrorIs(t, err, domain.ErrAgeRatingNotMet)
+	assert.Nil(t, response)
+}
+
+func TestTicketService_Create_WhenBirthDateIsMissing_ShouldReturnErrBirthDateRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A10", false)
+	user := &domain.User{ID: uuid.New()}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID})
+
+	assert.ErrorIs(t, err, domain.ErrBirthDateRequired)
+	assert.Nil(t, response)
+}
+
+func TestTicketService_Create_WhenTicketIsForDependent_ShouldUseDependentAge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A10", false)
+	user := &domain.User{ID: uuid.New(), FirstName: "Maria", LastName: "Souza", BirthDate: birthDateForAge(cinemaSession.StartTime, 40)}
+	dependent := &domain.Dependent{ID: uuid.New(), UserID: user.ID, FirstName: "Pedro", LastName: "Souza", BirthDate: *birthDateForAge(cinemaSession.StartTime, 8)}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	env.dependentRepositoryMock.EXPECT().GetByID(gomock.Any(), dependent.ID).Return(dependent, nil)
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID, DependentID: &dependent.ID})
+
+	assert.ErrorIs(t, err, domain.ErrAgeRatingNotMet)
+	assert.Nil(t, response)
+}
+
+func TestTicketService_Create_WhenDependentMeetsRating_ShouldIssueTicketToGuardian(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A10", false)
+	user := &domain.User{ID: uuid.New(), FirstName: "Maria", LastName: "Souza"}
+	dependent := &domain.Dependent{ID: uuid.New(), UserID: user.ID, FirstName: "Pedro", LastName: "Souza", BirthDate: *birthDateForAge(cinemaSession.StartTime, 11)}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	env.dependentRepositoryMock.EXPECT().GetByID(gomock.Any(), dependent.ID).Return(dependent, nil)
+	env.ticketRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, reservation domain.SeatReservation) error {
+			assert.Equal(t, user.ID, reservation.UserID)
+			assert.Equal(t, &dependent.ID, reservation.DependentID)
+			return nil
+		})
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID, DependentID: &dependent.ID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Pedro Souza", response.HolderName)
+	assert.Equal(t, &dependent.ID, response.DependentID)
+}
+
+func TestTicketService_Create_WhenDependentBelongsToAnotherUser_ShouldReturnErrDependentNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("AL", false)
+	user := &domain.User{ID: uuid.New()}
+	dependent := &domain.Dependent{ID: uuid.New(), UserID: uuid.New()}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	env.dependentRepositoryMock.EXPECT().GetByID(gomock.Any(), dependent.ID).Return(dependent, nil)
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID, DependentID: &dependent.ID})
+
+	assert.ErrorIs(t, err, domain.ErrDependentNotFound)
+	assert.Nil(t, response)
+}
+
+func TestTicketService_Create_WhenSeatIsTaken_ShouldReturnErrSeatUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("AL", false)
+	user := &domain.User{ID: uuid.New()}
+	ctx, seatID := env.expectPurchase(cinemaSession, user)
+
+	env.ticketRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(domain.ErrSeatUnavailable)
+
+	response, err := env.service.Create(ctx, cinemaSession.ID, domain.TicketPayload{SeatID: seatID})
+
+	assert.ErrorIs(t, err, domain.ErrSeatUnavailable)
+	assert.Nil(t, response)
+}
+
+func TestTicketService_CheckIn_WhenTicketIsValid_ShouldShowAgeRequirement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("A12", true)
+	staffSession := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, staffSession)
+	reservation := &domain.SeatReservation{
+		ID:               uuid.New(),
+		CinemaSession:    *cinemaSession,
+		MinimumAge:       12,
+		RequiresGuardian: true,
+		User:             domain.User{FirstName: "Ana", LastName: "Silva", BirthDate: birthDateForAge(cinemaSession.StartTime, 9)},
+	}
+	cinemaID := cinemaSession.CinemaRoom.CinemaID
+
+	env.authorizationServiceMock.EXPECT().Authorize(gomock.Any(), cinemaID, domain.PermissionTicketCheckIn).Return(nil)
+	env.ticketRepositoryMock.EXPECT().GetByID(gomock.Any(), reservation.ID).Return(reservation, nil)
+	env.ticketRepositoryMock.EXPECT().CheckIn(gomock.Any(), reservation.ID, staffSession.UserID, gomock.Any()).Return(true, nil)
+
+	response, err := env.service.CheckIn(ctx, cinemaID, reservation.ID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response.CheckedInAt)
+	assert.Equal(t, "Ana Silva", response.HolderName)
+	assert.Equal(t, 9, *response.HolderAge)
+	assert.Contains(t, response.AgeRequirement, "guardian")
+}
+
+func TestTicketService_CheckIn_WhenTicketWasUsed_ShouldReturnErrTicketAlreadyCheckedIn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("AL", false)
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: uuid.New()})
+	reservation := &domain.SeatReservation{ID: uuid.New(), CinemaSession: *cinemaSession}
+	cinemaID := cinemaSession.CinemaRoom.CinemaID
+
+	env.authorizationServiceMock.EXPECT().Authorize(gomock.Any(), cinemaID, domain.PermissionTicketCheckIn).Return(nil)
+	env.ticketRepositoryMock.EXPECT().GetByID(gomock.Any(), reservation.ID).Return(reservation, nil)
+	env.ticketRepositoryMock.EXPECT().CheckIn(gomock.Any(), reservation.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+
+	response, err := env.service.CheckIn(ctx, cinemaID, reservation.ID)
+
+	assert.ErrorIs(t, err, domain.ErrTicketAlreadyCheckedIn)
+	assert.Nil(t, response)
+}
+
+func TestTicketService_GetForCheckIn_WhenTicketBelongsToAnotherCinema_ShouldReturnErrTicketNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	env := setupTicketEnvironment(ctrl)
+
+	cinemaSession := newRatedCinemaSession("AL", false)
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: uuid.New()})
+	reservation := &domain.SeatReservation{ID: uuid.New(), CinemaSession: *cinemaSession}
+	otherCinemaID := uuid.New()
+
+	env.authorizationServiceMock.EXPECT().Authorize(gomock.Any(), otherCinemaID, domain.PermissionTicketCheckIn).Return(nil)
+	env.ticketRepositoryMock.EXPECT().GetByID(gomock.Any(), reservation.ID).Return(reservation, nil)
+
+	response, err := env.service.GetForCheckIn(ctx, otherCinemaID, reservation.ID)
+
+	assert.ErrorIs(t, err, domain.ErrTicketNotFound)
+	assert.Nil(t, response)
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+const (
+	totpIssuer               = "Movie Pass"
+	twoFactorSignInPurpose   = "two_factor_sign_in"
+	twoFactorSignInTokenExp  = 5 * time.Minute
+	twoFactorRateLimit       = 5
+	twoFactorRateLimitWindow = 5 * time.Minute
+	recoveryCodesCount       = 10
+	recoveryCodeSize         = 10
+)
+
+type twoFactorService struct {
+	i                   *do.Injector
+	userRepository      domain.UserRepository
+	twoFactorRepository domain.TwoFactorRepository
+	sessionService      domain.SessionService
+	rateLimiter         domain.RateLimiter
+}
+
+type twoFactorClaims struct {
+	jwt.StandardClaims
+	Purpose string `json:"purpose"`
+}
+
+func NewTwoFactorService(i *do.Injector) (domain.TwoFactorService, error) {
+	userRepository, err := do.Invoke[domain.UserRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize UserRepository: %w", err)
+	}
+
+	twoFactorRepository, err := do.Invoke[domain.TwoFactorRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize TwoFactorRepository: %w", err)
+	}
+
+	sessionService, err := do.Invoke[domain.SessionService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize SessionService: %w", err)
+	}
+
+	rateLimiter, err := do.Invoke[domain.RateLimiter](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize RateLimiter: %w", err)
+	}
+
+	return &twoFactorService{
+		i:                   i,
+		userRepository:      userRepository,
+		twoFactorRepository: twoFactorRepository,
+		sessionService:      sessionService,
+		rateLimiter:         rateLimiter,
+	}, nil
+}
+
+func (t *twoFactorService) Enroll(ctx context.Context) (*domain.TwoFactorEnrollmentResponse, error) {
+	user, err := t.getSessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TwoFactorEnabledAt != nil {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := secure.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error to generate TOTP secret for user ID %s: %w", user.ID, err)
+	}
+
+	encryptedSecret, err := secure.Encrypt(secret)
+	if err != nil {
+		return nil, fmt.Errorf("error to encrypt TOTP secret for user ID %s: %w", user.ID, err)
+	}
+
+	if err := t.userRepository.UpdateTwoFactor(ctx, user.ID, encryptedSecret, nil); err != nil {
+		return nil, fmt.Errorf("error to store TOTP secret for user ID %s: %w", user.ID, err)
+	}
+
+	return &domain.TwoFactorEnrollmentResponse{
+		Secret:     secret,
+		OTPAuthURI: secure.TOTPURI(totpIssuer, user.Email, secret),
+	}, nil
+}
+
+func (t *twoFactorService) Confirm(ctx context.Context, payload domain.TwoFactorCodePayload) (*domain.RecoveryCodesResponse, error) {
+	user, err := t.getSessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TwoFactorEnabledAt != nil {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	if user.TwoFactorSecret == "" {
+		return nil, domain.ErrTwoFactorNotEnrolled
+	}
+
+	if err := t.verifyCode(ctx, *user, payload.Code); err != nil {
+		return nil, err
+	}
+
+	enabledAt := time.Now().UTC()
+	if err := t.userRepository.UpdateTwoFactor(ctx, user.ID, user.TwoFactorSecret, &enabledAt); err != nil {
+		return nil, fmt.Errorf("error to enable two-factor for user ID %s: %w", user.ID, err)
+	}
+
+	response, err := t.replaceRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.sessionService.MarkTwoFactorVerified(ctx); err != nil {
+		return nil, fmt.Errorf("error to mark session as two-factor verified for user ID %s: %w", user.ID, err)
+	}
+
+	return response, nil
+}
+
+func (t *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, payload domain.TwoFactorCodePayload) (*domain.RecoveryCodesResponse, error) {
+	user, err := t.getSessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TwoFactorEnabledAt == nil {
+		return nil, domain.ErrTwoFactorNotEnabled
+	}
+
+	if err := t.verifyCode(ctx, *user, payload.Code); err != nil {
+		return nil, err
+	}
+
+	return t.replaceRecoveryCodes(ctx, user.ID)
+}
+
+func (t *twoFactorService) Disable(ctx context.Context, payload domain.TwoFactorCodePayload) error {
+	user, err := t.getSessionUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if user.TwoFactorEnabledAt == nil {
+		return domain.ErrTwoFactorNotEnabled
+	}
+
+	if err := t.verifyCode(ctx, *user, payload.Code); err != nil {
+		return err
+	}
+
+	if err := t.userRepository.UpdateTwoFactor(ctx, user.ID, "", nil); err != nil {
+		return fmt.Errorf("error to disable two-factor for user ID %s: %w", user.ID, err)
+	}
+
+	if err := t.twoFactorRepository.DeleteRecoveryCodes(ctx, user.ID); err != nil {
+		return fmt.Errorf("error to delete recovery codes for user ID %s: %w", user.ID, err)
+	}
+
+	if err := t.sessionService.RevokeAll(ctx, user.ID); err != nil {
+		return fmt.Errorf("error to revoke sessions for user ID %s: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+func (t *twoFactorService) CreateSignInToken(user domain.User) (string, error) {
+	now := time.Now().UTC()
+	token, err := secure.SignToken(twoFactorClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.NewString(),
+			Subject:   user.ID.String(),
+			Issuer:    config.Env.TokenIssuer,
+			Audience:  config.Env.TokenAudience,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(twoFactorSignInTokenExp).Unix(),
+		},
+		Purpose: twoFactorSignInPurpose,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error to sign two-factor token for user ID %s: %w", user.ID, err)
+	}
+
+	return token, nil
+}
+
+func (t *twoFactorService) VerifySignIn(ctx context.Context, payload domain.TwoFactorSignInPayload) (*domain.SignInResponse, error) {
+	var claims twoFactorClaims
+	if err := secure.ParseToken(payload.Token, &claims); err != nil {
+		if errors.Is(err, domain.ErrTokenInvalid) {
+			return nil, domain.ErrTwoFactorTokenInvalid
+		}
+		return nil, fmt.Errorf("error to parse two-factor token: %w", err)
+	}
+
+	if claims.Purpose != twoFactorSignInPurpose || !claims.VerifyIssuer(config.Env.TokenIssuer, true) || !claims.VerifyAudience(config.Env.TokenAudience, true) {
+		return nil, domain.ErrTwoFactorTokenInvalid
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, domain.ErrTwoFactorTokenInvalid
+	}
+
+	user, err := t.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve user by ID %s: %w", userID, err)
+	}
+
+	if user == nil || user.TwoFactorEnabledAt == nil {
+		return nil, domain.ErrTwoFactorTokenInvalid
+	}
+
+	if payload.Code != "" {
+		if err := t.verifyCode(ctx, *user, payload.Code); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := t.verifyRecoveryCode(ctx, user.ID, payload.RecoveryCode); err != nil {
+			return nil, err
+		}
+	}
+
+	tokens, err := t.sessionService.Create(ctx, *user, payload.ToSessionMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("error to create session for user ID %s: %w", user.ID, err)
+	}
+
+	return &domain.SignInResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
+	}, nil
+}
+
+func (t *twoFactorService) getSessionUser(ctx context.Context) (*domain.User, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	user, err := t.userRepository.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve user by ID %s: %w", session.UserID, err)
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+// verifyCode rejects codes already used in the same time step so an
+// intercepted code cannot be replayed within its validity window.
+func (t *twoFactorService) verifyCode(ctx context.Context, user domain.User, code string) error {
+	if err := t.rateLimiter.Allow(ctx, fmt.Sprintf("two_factor_%s", user.ID), twoFactorRateLimit, twoFactorRateLimitWindow); err != nil {
+		return err
+	}
+
+	secret, err := secure.Decrypt(user.TwoFactorSecret)
+	if err != nil {
+		return fmt.Errorf("error to decrypt TOTP secret for user ID %s: %w", user.ID, err)
+	}
+
+	step, ok := secure.ValidateTOTPCode(secret, code, time.Now().UTC())
+	if !ok {
+		return domain.ErrTwoFactorCodeInvalid
+	}
+
+	firstUse, err := t.twoFactorRepository.MarkCodeStepUsed(ctx, user.ID, step)
+	if err != nil {
+		return fmt.Errorf("error to mark TOTP step as used for user ID %s: %w", user.ID, err)
+	}
+
+	if !firstUse {
+		return domain.ErrTwoFactorCodeInvalid
+	}
+
+	return nil
+}
+
+func (t *twoFactorService) verifyRecoveryCode(ctx context.Context, userID uuid.UUID, recoveryCode string) error {
+	if err := t.rateLimiter.Allow(ctx, fmt.Sprintf("two_factor_%s", userID), twoFactorRateLimit, twoFactorRateLimitWindow); err != nil {
+		return err
+	}
+
+	used, err := t.twoFactorRepository.UseRecoveryCode(ctx, userID, secure.HashToken(normalizeRecoveryCode(recoveryCode)))
+	if err != nil {
+		return fmt.Errorf("error to use recovery code for user ID %s: %w", userID, err)
+	}
+
+	if !used {
+		return domain.ErrTwoFactorCodeInvalid
+	}
+
+	return nil
+}
+
+func (t *twoFactorService) replaceRecoveryCodes(ctx context.Context, userID uuid.UUID) (*domain.RecoveryCodesResponse, error) {
+	now := time.Now().UTC()
+	codes := make([]string, 0, recoveryCodesCount)
+	recoveryCodes := make([]domain.UserRecoveryCode, 0, recoveryCodesCount)
+
+	for range recoveryCodesCount {
+		code, err := secure.GenerateRecoveryCode(recoveryCodeSize)
+		if err != nil {
+			return nil, fmt.Errorf("error to generate recovery code for user ID %s: %w", userID, err)
+		}
+
+		codes = append(codes, code)
+		recoveryCodes = append(recoveryCodes, domain.UserRecoveryCode{
+			ID:        uuid.New(),
+			UserID:    userID,
+			CodeHash:  secure.HashToken(normalizeRecoveryCode(code)),
+			CreatedAt: now,
+		})
+	}
+
+	if err := t.twoFactorRepository.ReplaceRecoveryCodes(ctx, userID, recoveryCodes); err != nil {
+		return nil, fmt.Errorf("error to store recovery codes for user ID %s: %w", userID, err)
+	}
+
+	return &domain.RecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
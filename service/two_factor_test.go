@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/GSVillas/movie-pass-api/config"
+	"github.com/GSVillas/movie-pass-api/domain"
+	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTwoFactorEnvironment(t *testing.T) (*domain.User, string) {
+	setupSessionEnvironment(t)
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("Failed to generate encryption key: %v", err)
+	}
+	config.Env.EncryptionKey = base64.StdEncoding.EncodeToString(key)
+
+	secret, err := secure.GenerateTOTPSecret()
+	assert.NoError(t, err)
+
+	encryptedSecret, err := secure.Encrypt(secret)
+	assert.NoError(t, err)
+
+	enabledAt := time.Now().UTC()
+	user := &domain.User{
+		ID:                 uuid.New(),
+		Email:              "test@example.com",
+		TwoFactorSecret:    encryptedSecret,
+		TwoFactorEnabledAt: &enabledAt,
+	}
+
+	return user, secret
+}
+
+func TestTwoFactorService_VerifySignIn_WhenCodeIsValid_ShouldCreateSession(t *testing.T) {
+	user, secret := setupTwoFactorEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	twoFactorRepositoryMock := mock.NewMockTwoFactorRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	twoFactorService := &twoFactorService{
+		userRepository:      userRepositoryMock,
+		twoFactorRepository: twoFactorRepositoryMock,
+		sessionService:      sessionServiceMock,
+		rateLimiter:         rateLimiterMock,
+	}
+
+	token, err := twoFactorService.CreateSignInToken(*user)
+	assert.NoError(t, err)
+
+	code, err := secure.GenerateTOTPCode(secret, time.Now().UTC())
+	assert.NoError(t, err)
+
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	twoFactorRepositoryMock.EXPECT().MarkCodeStepUsed(gomock.Any(), user.ID, gomock.Any()).Return(true, nil)
+	sessionServiceMock.EXPECT().Create(gomock.Any(), *user, gomock.Any()).Return(&domain.SessionTokens{AccessToken: "access", RefreshToken: "refresh", ExpiresIn: 900}, nil)
+
+	response, err := twoFactorService.VerifySignIn(context.Background(), domain.TwoFactorSignInPayload{Token: token, Code: code})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access", response.Token)
+}
+
+func TestTwoFactorService_VerifySignIn_WhenCodeWasAlreadyUsed_ShouldReturnErrTwoFactorCodeInvalid(t *testing.T) {
+	user, secret := setupTwoFactorEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	twoFactorRepositoryMock := mock.NewMockTwoFactorRepository(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	twoFactorService := &twoFactorService{
+		userRepository:      userRepositoryMock,
+		twoFactorRepository: twoFactorRepositoryMock,
+		rateLimiter:         rateLimiterMock,
+	}
+
+	token, err := twoFactorService.CreateSignInToken(*user)
+	assert.NoError(t, err)
+
+	code, err := secure.GenerateTOTPCode(secret, time.Now().UTC())
+	assert.NoError(t, err)
+
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	twoFactorRepositoryMock.EXPECT().MarkCodeStepUsed(gomock.Any(), user.ID, gomock.Any()).Return(false, nil)
+
+	response, err := twoFactorService.VerifySignIn(context.Background(), domain.TwoFactorSignInPayload{Token: token, Code: code})
+
+	assert.ErrorIs(t, err, domain.ErrTwoFactorCodeInvalid)
+	assert.Nil(t, response)
+}
+
+func TestTwoFactorService_VerifySignIn_WhenRecoveryCodeIsValid_ShouldCreateSession(t *testing.T) {
+	user, _ := setupTwoFactorEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	twoFactorRepositoryMock := mock.NewMockTwoFactorRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	twoFactorService := &twoFactorService{
+		userRepository:      userRepositoryMock,
+		twoFactorRepository: twoFactorRepositoryMock,
+		sessionService:      sessionServiceMock,
+		rateLimiter:         rateLimiterMock,
+	}
+
+	token, err := twoFactorService.CreateSignInToken(*user)
+	assert.NoError(t, err)
+
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	twoFactorRepositoryMock.EXPECT().UseRecoveryCode(gomock.Any(), user.ID, secure.HashToken("abcde12345")).Return(true, nil)
+	sessionServiceMock.EXPECT().Create(gomock.Any(), *user, gomock.Any()).Return(&domain.SessionTokens{AccessToken: "access"}, nil)
+
+	_, err = twoFactorService.VerifySignIn(context.Background(), domain.TwoFactorSignInPayload{Token: token, RecoveryCode: "ABCDE-12345"})
+
+	assert.NoError(t, err)
+}
+
+func TestTwoFactorService_VerifySignIn_WhenTokenIsAccessToken_ShouldReturnErrTwoFactorTokenInvalid(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	twoFactorService := &twoFactorService{}
+
+	sessionService := &sessionService{}
+	token, err := sessionService.createToken(domain.Session{ID: uuid.New(), UserID: uuid.New()}, time.Now().UTC())
+	assert.NoError(t, err)
+
+	response, err := twoFactorService.VerifySignIn(context.Background(), domain.TwoFactorSignInPayload{Token: token, Code: "123456"})
+
+	assert.ErrorIs(t, err, domain.ErrTwoFactorTokenInvalid)
+	assert.Nil(t, response)
+}
+
+func TestTwoFactorService_Confirm_WhenCodeIsValid_ShouldEnableAndReturnRecoveryCodes(t *testing.T) {
+	user, secret := setupTwoFactorEnvironment(t)
+	user.TwoFactorEnabledAt = nil
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	twoFactorRepositoryMock := mock.NewMockTwoFactorRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	twoFactorService := &twoFactorService{
+		userRepository:      userRepositoryMock,
+		twoFactorRepository: twoFactorRepositoryMock,
+		sessionService:      sessionServiceMock,
+		rateLimiter:         rateLimiterMock,
+	}
+
+	session := &domain.Session{ID: uuid.New(), UserID: user.ID}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+
+	code, err := secure.GenerateTOTPCode(secret, time.Now().UTC())
+	assert.NoError(t, err)
+
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	twoFactorRepositoryMock.EXPECT().MarkCodeStepUsed(gomock.Any(), user.ID, gomock.Any()).Return(true, nil)
+	userRepositoryMock.EXPECT().UpdateTwoFactor(gomock.Any(), user.ID, user.TwoFactorSecret, gomock.Not(gomock.Nil())).Return(nil)
+	twoFactorRepositoryMock.EXPECT().ReplaceRecoveryCodes(gomock.Any(), user.ID, gomock.Len(recoveryCodesCount)).Return(nil)
+	sessionServiceMock.EXPECT().MarkTwoFactorVerified(gomock.Any()).Return(nil)
+
+	response, err := twoFactorService.Confirm(ctx, domain.TwoFactorCodePayload{Code: code})
+
+	assert.NoError(t, err)
+	assert.Len(t, response.RecoveryCodes, recoveryCodesCount)
+}
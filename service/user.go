@@ -2,17 +2,62 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/config"
 	"github.com/GSVillas/movie-pass-api/domain"
 	"github.com/GSVillas/movie-pass-api/secure"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 	"github.com/samber/do"
 )
 
+const (
+	passwordResetTokenSize            = 32
+	emailVerificationPurpose          = "email_verification"
+	emailChangePurpose                = "email_change"
+	verifyEmailRateLimit              = 10
+	verifyEmailRateLimitWindow        = 15 * time.Minute
+	resendVerificationRateLimit       = 3
+	resendVerificationRateLimitWindow = time.Hour
+	passwordCheckRateLimit            = 5
+	passwordCheckRateLimitWindow      = 15 * time.Minute
+	changeEmailRateLimit              = 3
+	changeEmailRateLimitWindow        = time.Hour
+	forgotPasswordEmailRateLimit      = 3
+	forgotPasswordIPRateLimit         = 10
+	forgotPasswordRateLimitWindow     = time.Hour
+	signInFailureWindow               = time.Hour
+	signInDelayThreshold              = 3
+	signInBaseDelay                   = time.Second
+)
+
 type userService struct {
-	i              *do.Injector
-	userRepository domain.UserRepository
-	sessionService domain.SessionService
+	i                      *do.Injector
+	userRepository         domain.UserRepository
+	sessionService         domain.SessionService
+	mailSender             client.MailSender
+	rateLimiter            domain.RateLimiter
+	loginAttemptRepository domain.LoginAttemptRepository
+	twoFactorService       domain.TwoFactorService
+	auditService           domain.AuditService
+}
+
+type emailVerificationClaims struct {
+	jwt.StandardClaims
+	Email   string `json:"email"`
+	Purpose string `json:"purpose"`
+}
+
+type emailChangeClaims struct {
+	jwt.StandardClaims
+	CurrentEmail string `json:"currentEmail"`
+	NewEmail     string `json:"newEmail"`
+	Purpose      string `json:"purpose"`
 }
 
 func NewUserService(i *do.Injector) (domain.UserService, error) {
@@ -26,10 +71,40 @@ func NewUserService(i *do.Injector) (domain.UserService, error) {
 		return nil, fmt.Errorf("error to initialize SessionService: %w", err)
 	}
 
+	mailSender, err := do.Invoke[client.MailSender](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize MailSender: %w", err)
+	}
+
+	rateLimiter, err := do.Invoke[domain.RateLimiter](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize RateLimiter: %w", err)
+	}
+
+	loginAttemptRepository, err := do.Invoke[domain.LoginAttemptRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize LoginAttemptRepository: %w", err)
+	}
+
+	twoFactorService, err := do.Invoke[domain.TwoFactorService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize TwoFactorService: %w", err)
+	}
+
+	auditService, err := do.Invoke[domain.AuditService](i)
+	if err != nil {
+		return nil, fmt.Errorf("error to initialize AuditService: %w", err)
+	}
+
 	return &userService{
-		i:              i,
-		userRepository: userRepository,
-		sessionService: sessionService,
+		i:                      i,
+		userRepository:         userRepository,
+		sessionService:         sessionService,
+		mailSender:             mailSender,
+		rateLimiter:            rateLimiter,
+		loginAttemptRepository: loginAttemptRepository,
+		twoFactorService:       twoFactorService,
+		auditService:           auditService,
 	}, nil
 }
 
@@ -54,29 +129,626 @@ func (u *userService) Create(ctx context.Context, payload domain.UserPayload) er
 		return fmt.Errorf("error to create user with email %s: %w", payload.Email, err)
 	}
 
+	if err := u.sendVerificationEmail(ctx, *user); err != nil {
+		slog.Warn("error to send verification email, user can request a new one",
+			slog.String("service", "user"),
+			slog.String("func", "create"),
+			slog.String("error", err.Error()),
+		)
+	}
+
 	return nil
 }
 
 func (u *userService) SignIn(ctx context.Context, payload domain.SignInPayload) (*domain.SignInResponse, error) {
+	emailKey := signInEmailKey(payload.Email)
+	keys := []string{emailKey}
+	if payload.IPAddress != "" {
+		keys = append(keys, fmt.Sprintf("ip_%s", payload.IPAddress))
+	}
+
+	if err := u.checkSignInLock(ctx, keys); err != nil {
+		return nil, err
+	}
+
 	user, err := u.userRepository.GetByEmail(ctx, payload.Email)
 	if err != nil {
 		return nil, fmt.Errorf("error to retrieve user by email %s: %w", payload.Email, err)
 	}
 
 	if user == nil {
-		return nil, domain.ErrUserNotFound
+		secure.CheckDummyPassword(payload.Password)
+		return nil, u.registerSignInFailure(ctx, nil, keys)
 	}
 
 	if err := secure.CheckPassword(user.PasswordHash, payload.Password); err != nil {
-		return nil, domain.ErrInvalidPassword
+		return nil, u.registerSignInFailure(ctx, user, keys)
+	}
+
+	if secure.PasswordNeedsRehash(user.PasswordHash) {
+		u.rehashPassword(ctx, *user, payload.Password)
+	}
+
+	if err := u.loginAttemptRepository.ResetFailures(ctx, emailKey); err != nil {
+		return nil, fmt.Errorf("error to reset sign in failures for user ID %s: %w", user.ID, err)
 	}
 
-	token, err := u.sessionService.Create(ctx, *user)
+	if user.TwoFactorEnabledAt != nil {
+		twoFactorToken, err := u.twoFactorService.CreateSignInToken(*user)
+		if err != nil {
+			return nil, err
+		}
+
+		return &domain.SignInResponse{
+			TwoFactorRequired: true,
+			TwoFactorToken:    twoFactorToken,
+		}, nil
+	}
+
+	tokens, err := u.sessionService.Create(ctx, *user, payload.ToSessionMetadata())
 	if err != nil {
 		return nil, fmt.Errorf("error to create session for user ID %s: %w", user.ID, err)
 	}
 
 	return &domain.SignInResponse{
-		Token: token,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
 	}, nil
 }
+
+// ForgotPassword is limited per email and per IP whether or not the account
+// exists, so the limit does not reveal registered emails.
+func (u *userService) ForgotPassword(ctx context.Context, payload domain.ForgotPasswordPayload, ipAddress string) error {
+	if err := u.rateLimiter.Allow(ctx, fmt.Sprintf("forgot_password_email_%s", payload.Email), forgotPasswordEmailRateLimit, forgotPasswordRateLimitWindow); err != nil {
+		return err
+	}
+
+	if err := u.rateLimiter.Allow(ctx, fmt.Sprintf("forgot_password_ip_%s", ipAddress), forgotPasswordIPRateLimit, forgotPasswordRateLimitWindow); err != nil {
+		return err
+	}
+
+	user, err := u.userRepository.GetByEmail(ctx, payload.Email)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by email %s: %w", payload.Email, err)
+	}
+
+	if user == nil {
+		return nil
+	}
+
+	token, err := secure.GenerateToken(passwordResetTokenSize)
+	if err != nil {
+		return fmt.Errorf("error to generate password reset token for user ID %s: %w", user.ID, err)
+	}
+
+	if err := u.userRepository.CreatePasswordResetToken(ctx, secure.HashToken(token), user.ID); err != nil {
+		return fmt.Errorf("error to store password reset token for user ID %s: %w", user.ID, err)
+	}
+
+	message := client.MailMessage{
+		To:      user.Email,
+		Subject: "Reset your Movie Pass password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWe received a request to reset your password. Use the link below within %d minutes to choose a new one:\n\n%s/reset-password?token=%s\n\nIf you did not request this, you can ignore this email.\n",
+			user.FirstName, config.Env.PasswordResetExp, config.Env.FrontURL, token,
+		),
+	}
+
+	if err := u.mailSender.Send(ctx, message); err != nil {
+		return fmt.Errorf("error to send password reset email to user ID %s: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+func (u *userService) ResetPassword(ctx context.Context, payload domain.ResetPasswordPayload) error {
+	userID, err := u.userRepository.ConsumePasswordResetToken(ctx, secure.HashToken(payload.Token))
+	if err != nil {
+		return fmt.Errorf("error to consume password reset token: %w", err)
+	}
+
+	if userID == nil {
+		return domain.ErrPasswordResetInvalid
+	}
+
+	user, err := u.userRepository.GetByID(ctx, *userID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by ID %s: %w", userID, err)
+	}
+
+	if user == nil {
+		return domain.ErrPasswordResetInvalid
+	}
+
+	passwordHash, err := secure.HashPassword(payload.Password)
+	if err != nil {
+		return fmt.Errorf("error to hash password for user ID %s: %w", user.ID, err)
+	}
+
+	if err := u.userRepository.UpdatePassword(ctx, user.ID, string(passwordHash)); err != nil {
+		return fmt.Errorf("error to update password for user ID %s: %w", user.ID, err)
+	}
+
+	if err := u.sessionService.RevokeAll(ctx, user.ID); err != nil {
+		return fmt.Errorf("error to revoke sessions for user ID %s: %w", user.ID, err)
+	}
+
+	// The owner proved access to the email, so a lockout caused by someone
+	// guessing the old password no longer applies.
+	if err := u.loginAttemptRepository.ResetFailures(ctx, signInEmailKey(user.Email)); err != nil {
+		return fmt.Errorf("error to reset sign in failures for user ID %s: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+func (u *userService) VerifyEmail(ctx context.Context, payload domain.VerifyEmailPayload, ipAddress string) error {
+	if err := u.rateLimiter.Allow(ctx, fmt.Sprintf("verify_email_%s", ipAddress), verifyEmailRateLimit, verifyEmailRateLimitWindow); err != nil {
+		return err
+	}
+
+	var claims emailVerificationClaims
+	if err := secure.ParseToken(payload.Token, &claims); err != nil {
+		if errors.Is(err, domain.ErrTokenInvalid) {
+			return domain.ErrVerificationInvalid
+		}
+		return fmt.Errorf("error to parse email verification token: %w", err)
+	}
+
+	if claims.Purpose != emailVerificationPurpose || !claims.VerifyIssuer(config.Env.TokenIssuer, true) || !claims.VerifyAudience(config.Env.TokenAudience, true) {
+		return domain.ErrVerificationInvalid
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return domain.ErrVerificationInvalid
+	}
+
+	user, err := u.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by ID %s: %w", userID, err)
+	}
+
+	if user == nil || user.Email != claims.Email {
+		return domain.ErrVerificationInvalid
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	if err := u.userRepository.MarkEmailVerified(ctx, user.ID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error to mark email as verified for user ID %s: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+func (u *userService) ResendVerification(ctx context.Context) error {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return domain.ErrUserNotFoundInContext
+	}
+
+	if err := u.rateLimiter.Allow(ctx, fmt.Sprintf("resend_verification_%s", session.UserID), resendVerificationRateLimit, resendVerificationRateLimitWindow); err != nil {
+		return err
+	}
+
+	user, err := u.userRepository.GetByID(ctx, session.UserID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by ID %s: %w", session.UserID, err)
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	return u.sendVerificationEmail(ctx, *user)
+}
+
+func (u *userService) IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error) {
+	user, err := u.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("error to retrieve user by ID %s: %w", userID, err)
+	}
+
+	if user == nil {
+		return false, domain.ErrUserNotFound
+	}
+
+	return user.EmailVerifiedAt != nil, nil
+}
+
+func (u *userService) GetMe(ctx context.Context) (*domain.UserResponse, error) {
+	user, err := u.getSessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.ToUserResponse(), nil
+}
+
+// UpdateMe changes the profile of the session user. The birth date drives the
+// age checks of ticket sales, so it can only be set while it is still empty;
+// sending the current one again is accepted.
+func (u *userService) UpdateMe(ctx context.Context, payload domain.UpdateProfilePayload) (*domain.UserResponse, error) {
+	user, err := u.getSessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	before := user.ToUserResponse()
+	birthDateSet := false
+	if payload.BirthDate != nil && (user.BirthDate == nil || !sameDate(*user.BirthDate, *payload.BirthDate)) {
+		if user.BirthDate != nil {
+			return nil, domain.ErrBirthDateLocked
+		}
+
+		birthDateSet = true
+	}
+
+	if payload.FirstName != nil {
+		user.FirstName = *payload.FirstName
+	}
+
+	if payload.LastName != nil {
+		user.LastName = *payload.LastName
+	}
+
+	if birthDateSet {
+		user.BirthDate = payload.BirthDate
+	}
+
+	if err := u.userRepository.UpdateProfile(ctx, user.ID, user.FirstName, user.LastName, user.BirthDate); err != nil {
+		return nil, fmt.Errorf("error to update profile for user ID %s: %w", user.ID, err)
+	}
+
+	if err := u.sessionService.SyncUser(ctx, *user); err != nil {
+		return nil, fmt.Errorf("error to sync sessions for user ID %s: %w", user.ID, err)
+	}
+
+	response := user.ToUserResponse()
+	if birthDateSet {
+		u.auditService.Record(ctx, domain.AuditEntry{
+			Action:     domain.AuditActionUserBirthDateSet,
+			TargetType: domain.AuditTargetUser,
+			TargetID:   user.ID,
+			Before:     before,
+			After:      response,
+		})
+	}
+
+	return response, nil
+}
+
+func (u *userService) ChangePassword(ctx context.Context, payload domain.ChangePasswordPayload) error {
+	user, err := u.getSessionUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := u.checkCurrentPassword(ctx, *user, payload.CurrentPassword); err != nil {
+		return err
+	}
+
+	passwordHash, err := secure.HashPassword(payload.NewPassword)
+	if err != nil {
+		return fmt.Errorf("error to hash password for user ID %s: %w", user.ID, err)
+	}
+
+	if err := u.userRepository.UpdatePassword(ctx, user.ID, string(passwordHash)); err != nil {
+		return fmt.Errorf("error to update password for user ID %s: %w", user.ID, err)
+	}
+
+	if err := u.sessionService.RevokeOthers(ctx); err != nil {
+		return fmt.Errorf("error to revoke other sessions for user ID %s: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+func (u *userService) ChangeEmail(ctx context.Context, payload domain.ChangeEmailPayload) error {
+	user, err := u.getSessionUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := u.rateLimiter.Allow(ctx, fmt.Sprintf("change_email_%s", user.ID), changeEmailRateLimit, changeEmailRateLimitWindow); err != nil {
+		return err
+	}
+
+	if err := u.checkCurrentPassword(ctx, *user, payload.Password); err != nil {
+		return err
+	}
+
+	if payload.NewEmail == user.Email {
+		return domain.ErrEmailUnchanged
+	}
+
+	existingUser, err := u.userRepository.GetByEmail(ctx, payload.NewEmail)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by email %s: %w", payload.NewEmail, err)
+	}
+
+	if existingUser != nil {
+		return domain.ErrEmailAlreadyRegister
+	}
+
+	return u.sendEmailChangeEmail(ctx, *user, payload.NewEmail)
+}
+
+// ConfirmEmailChange applies the new address once its owner opens the link.
+// The token is bound to the address it was requested from, so a link becomes
+// useless once the email changes again.
+func (u *userService) ConfirmEmailChange(ctx context.Context, payload domain.ConfirmEmailChangePayload) error {
+	var claims emailChangeClaims
+	if err := secure.ParseToken(payload.Token, &claims); err != nil {
+		if errors.Is(err, domain.ErrTokenInvalid) {
+			return domain.ErrEmailChangeInvalid
+		}
+		return fmt.Errorf("error to parse email change token: %w", err)
+	}
+
+	if claims.Purpose != emailChangePurpose || !claims.VerifyIssuer(config.Env.TokenIssuer, true) || !claims.VerifyAudience(config.Env.TokenAudience, true) {
+		return domain.ErrEmailChangeInvalid
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return domain.ErrEmailChangeInvalid
+	}
+
+	user, err := u.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by ID %s: %w", userID, err)
+	}
+
+	if user == nil || user.Email != claims.CurrentEmail {
+		return domain.ErrEmailChangeInvalid
+	}
+
+	existingUser, err := u.userRepository.GetByEmail(ctx, claims.NewEmail)
+	if err != nil {
+		return fmt.Errorf("error to retrieve user by email %s: %w", claims.NewEmail, err)
+	}
+
+	if existingUser != nil {
+		return domain.ErrEmailAlreadyRegister
+	}
+
+	if err := u.userRepository.UpdateEmail(ctx, user.ID, claims.NewEmail, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error to update email for user ID %s: %w", user.ID, err)
+	}
+
+	if err := u.sessionService.RevokeAll(ctx, user.ID); err != nil {
+		return fmt.Errorf("error to revoke sessions for user ID %s: %w", user.ID, err)
+	}
+
+	u.sendEmailChangedNotice(ctx, *user, claims.NewEmail)
+
+	return nil
+}
+
+func (u *userService) getSessionUser(ctx context.Context) (*domain.User, error) {
+	session, ok := ctx.Value(domain.SessionKey).(*domain.Session)
+	if !ok || session == nil {
+		return nil, domain.ErrUserNotFoundInContext
+	}
+
+	user, err := u.userRepository.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error to retrieve user by ID %s: %w", session.UserID, err)
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (u *userService) checkCurrentPassword(ctx context.Context, user domain.User, password string) error {
+	if err := u.rateLimiter.Allow(ctx, fmt.Sprintf("password_check_%s", user.ID), passwordCheckRateLimit, passwordCheckRateLimitWindow); err != nil {
+		return err
+	}
+
+	if err := secure.CheckPassword(user.PasswordHash, password); err != nil {
+		return domain.ErrInvalidPassword
+	}
+
+	return nil
+}
+
+// rehashPassword upgrades legacy hashes after a successful sign in. Failures
+// are only logged because the password itself was valid.
+func (u *userService) rehashPassword(ctx context.Context, user domain.User, password string) {
+	passwordHash, err := secure.HashPassword(password)
+	if err != nil {
+		slog.Error("Failed to rehash password", slog.String("userId", user.ID.String()), slog.String("error", err.Error()))
+		return
+	}
+
+	if err := u.userRepository.UpdatePassword(ctx, user.ID, string(passwordHash)); err != nil {
+		slog.Error("Failed to store rehashed password", slog.String("userId", user.ID.String()), slog.String("error", err.Error()))
+	}
+}
+
+func signInEmailKey(email string) string {
+	return fmt.Sprintf("email_%s", email)
+}
+
+// sameDate compares calendar dates only, as birth dates are stored without a
+// time of day.
+func sameDate(a, b time.Time) bool {
+	aYear, aMonth, aDay := a.Date()
+	bYear, bMonth, bDay := b.Date()
+	return aYear == bYear && aMonth == bMonth && aDay == bDay
+}
+
+func (u *userService) checkSignInLock(ctx context.Context, keys []string) error {
+	var retryAfter time.Duration
+	for _, key := range keys {
+		ttl, err := u.loginAttemptRepository.GetLockTTL(ctx, key)
+		if err != nil {
+			return fmt.Errorf("error to retrieve sign in lock for %s: %w", key, err)
+		}
+
+		if ttl > retryAfter {
+			retryAfter = ttl
+		}
+	}
+
+	if retryAfter > 0 {
+		return &domain.RateLimitError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// registerSignInFailure counts the failure per email and per IP. From
+// signInDelayThreshold failures on the email, each attempt doubles a short
+// lock; at SignInMaxFailures the email is locked for SignInLockoutDuration.
+func (u *userService) registerSignInFailure(ctx context.Context, user *domain.User, keys []string) error {
+	lockoutDuration := time.Duration(config.Env.SignInLockoutDuration) * time.Minute
+
+	for index, key := range keys {
+		failures, err := u.loginAttemptRepository.RegisterFailure(ctx, key, signInFailureWindow)
+		if err != nil {
+			return fmt.Errorf("error to register sign in failure for %s: %w", key, err)
+		}
+
+		lockDuration := time.Duration(0)
+		isEmailKey := index == 0
+		switch {
+		case !isEmailKey && failures >= int64(config.Env.SignInMaxFailuresPerIP):
+			lockDuration = lockoutDuration
+		case isEmailKey && failures >= int64(config.Env.SignInMaxFailures):
+			lockDuration = lockoutDuration
+			if failures == int64(config.Env.SignInMaxFailures) && user != nil && config.Env.SignInLockoutNotify {
+				u.sendLockoutEmail(ctx, *user, lockoutDuration)
+			}
+		case isEmailKey && failures >= signInDelayThreshold:
+			lockDuration = min(signInBaseDelay<<(failures-signInDelayThreshold), lockoutDuration)
+		}
+
+		if lockDuration > 0 {
+			if err := u.loginAttemptRepository.Lock(ctx, key, lockDuration); err != nil {
+				return fmt.Errorf("error to lock sign in for %s: %w", key, err)
+			}
+		}
+	}
+
+	return domain.ErrInvalidCredentials
+}
+
+func (u *userService) sendLockoutEmail(ctx context.Context, user domain.User, lockoutDuration time.Duration) {
+	message := client.MailMessage{
+		To:      user.Email,
+		Subject: "Your Movie Pass account was temporarily locked",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWe noticed several failed sign in attempts on your account, so we blocked new attempts for %d minutes.\n\nIf it was not you, we recommend resetting your password at %s/forgot-password.\n",
+			user.FirstName, int(lockoutDuration.Minutes()), config.Env.FrontURL,
+		),
+	}
+
+	if err := u.mailSender.Send(ctx, message); err != nil {
+		slog.Warn("error to send lockout email",
+			slog.String("service", "user"),
+			slog.String("func", "sendLockoutEmail"),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func (u *userService) sendVerificationEmail(ctx context.Context, user domain.User) error {
+	now := time.Now().UTC()
+	token, err := secure.SignToken(emailVerificationClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.NewString(),
+			Subject:   user.ID.String(),
+			Issuer:    config.Env.TokenIssuer,
+			Audience:  config.Env.TokenAudience,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(time.Duration(config.Env.EmailVerificationExp) * time.Hour).Unix(),
+		},
+		Email:   user.Email,
+		Purpose: emailVerificationPurpose,
+	})
+	if err != nil {
+		return fmt.Errorf("error to sign email verification token for user ID %s: %w", user.ID, err)
+	}
+
+	message := client.MailMessage{
+		To:      user.Email,
+		Subject: "Confirm your Movie Pass email",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address by opening the link below within %d hours:\n\n%s/verify-email?token=%s\n\nYou will not be able to buy tickets until your email is confirmed.\n",
+			user.FirstName, config.Env.EmailVerificationExp, config.Env.FrontURL, token,
+		),
+	}
+
+	if err := u.mailSender.Send(ctx, message); err != nil {
+		return fmt.Errorf("error to send verification email to user ID %s: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+func (u *userService) sendEmailChangeEmail(ctx context.Context, user domain.User, newEmail string) error {
+	now := time.Now().UTC()
+	token, err := secure.SignToken(emailChangeClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.NewString(),
+			Subject:   user.ID.String(),
+			Issuer:    config.Env.TokenIssuer,
+			Audience:  config.Env.TokenAudience,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(time.Duration(config.Env.EmailVerificationExp) * time.Hour).Unix(),
+		},
+		CurrentEmail: user.Email,
+		NewEmail:     newEmail,
+		Purpose:      emailChangePurpose,
+	})
+	if err != nil {
+		return fmt.Errorf("error to sign email change token for user ID %s: %w", user.ID, err)
+	}
+
+	message := client.MailMessage{
+		To:      newEmail,
+		Subject: "Confirm your new Movie Pass email",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm this is the new email address of your Movie Pass account by opening the link below within %d hours:\n\n%s/confirm-email-change?token=%s\n\nIf you did not request this change, you can ignore this email.\n",
+			user.FirstName, config.Env.EmailVerificationExp, config.Env.FrontURL, token,
+		),
+	}
+
+	if err := u.mailSender.Send(ctx, message); err != nil {
+		return fmt.Errorf("error to send email change confirmation to user ID %s: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+func (u *userService) sendEmailChangedNotice(ctx context.Context, user domain.User, newEmail string) {
+	message := client.MailMessage{
+		To:      user.Email,
+		Subject: "Your Movie Pass email was changed",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nThe email address of your Movie Pass account was changed to %s and you were signed out of all devices.\n\nIf it was not you, contact our support immediately.\n",
+			user.FirstName, newEmail,
+		),
+	}
+
+	if err := u.mailSender.Send(ctx, message); err != nil {
+		slog.Warn("error to send email changed notice",
+			slog.String("service", "user"),
+			slog.String("func", "sendEmailChangedNotice"),
+			slog.String("error", err.Error()),
+		)
+	}
+}
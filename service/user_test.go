@@ -3,15 +3,27 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/GSVillas/movie-pass-api/client"
+	"github.com/GSVillas/movie-pass-api/config"
 	"github.com/GSVillas/movie-pass-api/domain"
 	"github.com/GSVillas/movie-pass-api/mock"
+	"github.com/GSVillas/movie-pass-api/secure"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
+func setupSignInEnvironment() {
+	config.Env.SignInMaxFailures = 10
+	config.Env.SignInMaxFailuresPerIP = 50
+	config.Env.SignInLockoutDuration = 15
+	config.Env.SignInLockoutNotify = true
+}
+
 func TestUserService_Create_WhenUserAlreadyExistsByEmail_ShouldReturnErrEmailAlreadyRegister(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -66,13 +78,17 @@ func TestUserService_Create_WhenGetUserByEmailFails_ShouldReturnErrGetUserByEmai
 }
 
 func TestUserService_Create_WhenSuccess_ShouldReturnNil(t *testing.T) {
+	setupSessionEnvironment(t)
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
 
 	userService := &userService{
 		userRepository: userRepositoryMock,
+		mailSender:     mailSenderMock,
 	}
 
 	payload := &domain.UserPayload{
@@ -87,37 +103,55 @@ func TestUserService_Create_WhenSuccess_ShouldReturnNil(t *testing.T) {
 
 	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(nil, nil)
 
-	userRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	userRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, user domain.User) error {
+			assert.Nil(t, user.EmailVerifiedAt)
+			return nil
+		},
+	)
+	mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
 
 	err := userService.Create(context.Background(), *payload)
 
 	assert.NoError(t, err)
 }
 
-func TestUserService_Create_WhenHashingPasswordFails_ShouldReturnError(t *testing.T) {
+func TestUserService_Create_WhenPasswordIsLongerThan72Bytes_ShouldHashWholePassword(t *testing.T) {
+	setupSessionEnvironment(t)
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
 
 	userService := &userService{
 		userRepository: userRepositoryMock,
+		mailSender:     mailSenderMock,
 	}
 
+	password := strings.Repeat("pneumoultramicroscopiosilicovulcanoconiotico", 5)
 	payload := &domain.UserPayload{
 		FirstName:       "Test",
 		LastName:        "Doe",
 		Email:           "test@example.com",
 		ConfirmEmail:    "test@example.com",
-		Password:        "pneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocoliotico",
-		ConfirmPassword: "pneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocolioticopneumoultramicroscopiosilicovulcanocoliotico",
+		Password:        password,
+		ConfirmPassword: password,
 	}
 
 	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(nil, nil)
+	userRepositoryMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, user domain.User) error {
+			assert.NoError(t, secure.CheckPassword(user.PasswordHash, password))
+			assert.ErrorIs(t, secure.CheckPassword(user.PasswordHash, password[:72]), secure.ErrPasswordMismatch)
+			return nil
+		})
+	mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
 
 	err := userService.Create(context.Background(), *payload)
 
-	assert.NotNil(t, err)
+	assert.NoError(t, err)
 }
 
 func TestUserService_Create_WhenCreateUserFails_ShouldReturnError(t *testing.T) {
@@ -151,14 +185,18 @@ func TestUserService_Create_WhenCreateUserFails_ShouldReturnError(t *testing.T)
 }
 
 func TestUserService_SignIn_WhenGetUserByEmailFails_ShouldReturnNiilAndErrGetUserByEmail(t *testing.T) {
+	setupSignInEnvironment()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	userRepositoryMock := mock.NewMockUserRepository(ctrl)
 	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
 	userService := &userService{
-		userRepository: userRepositoryMock,
-		sessionService: sessionServiceMock,
+		userRepository:         userRepositoryMock,
+		sessionService:         sessionServiceMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
 	}
 
 	payload := &domain.SignInPayload{
@@ -167,6 +205,7 @@ func TestUserService_SignIn_WhenGetUserByEmailFails_ShouldReturnNiilAndErrGetUse
 	}
 
 	repError := errors.New("Table 'User' not found")
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(time.Duration(0), nil)
 	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(nil, repError)
 
 	response, err := userService.SignIn(context.Background(), *payload)
@@ -175,16 +214,20 @@ func TestUserService_SignIn_WhenGetUserByEmailFails_ShouldReturnNiilAndErrGetUse
 	assert.Nil(t, response)
 }
 
-func TestUserService_SignIn_WhenUserNotFound_ShouldReturnNilAndErrUserNotFound(t *testing.T) {
+func TestUserService_SignIn_WhenUserNotFound_ShouldReturnNilAndErrInvalidCredentials(t *testing.T) {
+	setupSignInEnvironment()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	userRepositoryMock := mock.NewMockUserRepository(ctrl)
 	sessionService := mock.NewMockSessionService(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
 
 	userService := &userService{
-		userRepository: userRepositoryMock,
-		sessionService: sessionService,
+		userRepository:         userRepositoryMock,
+		sessionService:         sessionService,
+		loginAttemptRepository: loginAttemptRepositoryMock,
 	}
 
 	payload := &domain.SignInPayload{
@@ -192,24 +235,31 @@ func TestUserService_SignIn_WhenUserNotFound_ShouldReturnNilAndErrUserNotFound(t
 		Password: "Str0ngP@ssw0rd!",
 	}
 
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(time.Duration(0), nil)
 	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(nil, nil)
 
+	loginAttemptRepositoryMock.EXPECT().RegisterFailure(gomock.Any(), "email_test@example.com", gomock.Any()).Return(int64(1), nil)
+
 	response, err := userService.SignIn(context.Background(), *payload)
 
-	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
 	assert.Nil(t, response)
 }
 
 func TestUserService_SignIn_WhenSuccess_ShouldReturnSignInResponseAndNil(t *testing.T) {
+	setupSignInEnvironment()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	userRepositoryMock := mock.NewMockUserRepository(ctrl)
 	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
 
 	userService := &userService{
-		userRepository: userRepositoryMock,
-		sessionService: sessionServiceMock,
+		userRepository:         userRepositoryMock,
+		sessionService:         sessionServiceMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
 	}
 
 	payload := &domain.SignInPayload{
@@ -222,26 +272,76 @@ func TestUserService_SignIn_WhenSuccess_ShouldReturnSignInResponseAndNil(t *test
 		PasswordHash: "$2a$10$gUWyf9ESKUNGxIAByzPCdOP9UMLLhC039R5jGNivSPhQJFNl4P0OC",
 	}
 
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(time.Duration(0), nil)
 	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(user, nil)
-	sessionServiceMock.EXPECT().Create(gomock.Any(), *user).Return("validtoken", nil)
+	loginAttemptRepositoryMock.EXPECT().ResetFailures(gomock.Any(), "email_test@example.com").Return(nil)
+	userRepositoryMock.EXPECT().UpdatePassword(gomock.Any(), user.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, passwordHash string) error {
+			assert.True(t, strings.HasPrefix(passwordHash, "$argon2id$"))
+			assert.NoError(t, secure.CheckPassword(passwordHash, payload.Password))
+			return nil
+		})
+	sessionServiceMock.EXPECT().Create(gomock.Any(), *user, gomock.Any()).Return(&domain.SessionTokens{AccessToken: "validtoken", RefreshToken: "refreshtoken", ExpiresIn: 900}, nil)
 
 	response, err := userService.SignIn(context.Background(), *payload)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "validtoken", response.Token)
+	assert.Equal(t, "refreshtoken", response.RefreshToken)
+}
+
+func TestUserService_SignIn_WhenHashUsesCurrentParameters_ShouldNotRehashPassword(t *testing.T) {
+	setupSignInEnvironment()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
+
+	userService := &userService{
+		userRepository:         userRepositoryMock,
+		sessionService:         sessionServiceMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
+	}
+
+	payload := &domain.SignInPayload{
+		Email:    "test@example.com",
+		Password: "Str0ngP@ssw0rd!",
+	}
+
+	passwordHash, err := secure.HashPassword(payload.Password)
+	assert.NoError(t, err)
+
+	user := &domain.User{ID: uuid.New(), Email: payload.Email, PasswordHash: string(passwordHash)}
+
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(time.Duration(0), nil)
+	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(user, nil)
+	loginAttemptRepositoryMock.EXPECT().ResetFailures(gomock.Any(), "email_test@example.com").Return(nil)
+	sessionServiceMock.EXPECT().Create(gomock.Any(), *user, gomock.Any()).Return(&domain.SessionTokens{AccessToken: "validtoken"}, nil)
+
+	response, err := userService.SignIn(context.Background(), *payload)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "validtoken", response.Token)
 }
 
 func TestUserService_SignIn_WhenCreateSessionFails_ShouldReturnErrCreateSession(t *testing.T) {
+	setupSignInEnvironment()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	userRepositoryMock := mock.NewMockUserRepository(ctrl)
 	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
 
 	userService := &userService{
-		userRepository: userRepositoryMock,
-		sessionService: sessionServiceMock,
+		userRepository:         userRepositoryMock,
+		sessionService:         sessionServiceMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
 	}
 
 	payload := &domain.SignInPayload{
@@ -254,9 +354,12 @@ func TestUserService_SignIn_WhenCreateSessionFails_ShouldReturnErrCreateSession(
 		PasswordHash: "$2a$10$gUWyf9ESKUNGxIAByzPCdOP9UMLLhC039R5jGNivSPhQJFNl4P0OC",
 	}
 
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(time.Duration(0), nil)
 	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(user, nil)
 
-	sessionServiceMock.EXPECT().Create(gomock.Any(), *user).Return("", errors.New("session error"))
+	loginAttemptRepositoryMock.EXPECT().ResetFailures(gomock.Any(), "email_test@example.com").Return(nil)
+	userRepositoryMock.EXPECT().UpdatePassword(gomock.Any(), user.ID, gomock.Any()).Return(nil)
+	sessionServiceMock.EXPECT().Create(gomock.Any(), *user, gomock.Any()).Return(nil, errors.New("session error"))
 
 	response, err := userService.SignIn(context.Background(), *payload)
 
@@ -264,16 +367,20 @@ func TestUserService_SignIn_WhenCreateSessionFails_ShouldReturnErrCreateSession(
 	assert.Nil(t, response)
 }
 
-func TestUserService_SignIn_WhenPasswordIsInvalid_ShouldReturnErrInvalidPassword(t *testing.T) {
+func TestUserService_SignIn_WhenPasswordIsInvalid_ShouldReturnErrInvalidCredentials(t *testing.T) {
+	setupSignInEnvironment()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	userRepositoryMock := mock.NewMockUserRepository(ctrl)
 	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
 
 	userService := &userService{
-		userRepository: userRepositoryMock,
-		sessionService: sessionServiceMock,
+		userRepository:         userRepositoryMock,
+		sessionService:         sessionServiceMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
 	}
 
 	payload := &domain.SignInPayload{
@@ -286,9 +393,647 @@ func TestUserService_SignIn_WhenPasswordIsInvalid_ShouldReturnErrInvalidPassword
 		PasswordHash: "wrong_password",
 	}
 
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(time.Duration(0), nil)
 	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(user, nil)
 
+	loginAttemptRepositoryMock.EXPECT().RegisterFailure(gomock.Any(), "email_test@example.com", gomock.Any()).Return(int64(1), nil)
+
 	_, err := userService.SignIn(context.Background(), *payload)
 
+	assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+}
+
+func TestUserService_ForgotPassword_WhenUserNotFound_ShouldReturnNilWithoutSendingMail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		mailSender:     mailSenderMock,
+		rateLimiter:    rateLimiterMock,
+	}
+
+	payload := domain.ForgotPasswordPayload{
+		Email: "test@example.com",
+	}
+
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(nil, nil)
+
+	err := userService.ForgotPassword(context.Background(), payload, "127.0.0.1")
+
+	assert.NoError(t, err)
+}
+
+func TestUserService_ForgotPassword_WhenUserExists_ShouldStoreHashedTokenAndSendMail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		mailSender:     mailSenderMock,
+		rateLimiter:    rateLimiterMock,
+	}
+
+	user := &domain.User{
+		ID:        uuid.New(),
+		FirstName: "Test",
+		Email:     "test@example.com",
+	}
+
+	var tokenHash string
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(user, nil)
+	userRepositoryMock.EXPECT().CreatePasswordResetToken(gomock.Any(), gomock.Any(), user.ID).DoAndReturn(
+		func(_ context.Context, hash string, _ uuid.UUID) error {
+			tokenHash = hash
+			return nil
+		},
+	)
+	mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message client.MailMessage) error {
+			assert.Equal(t, user.Email, message.To)
+			token := message.Body[strings.Index(message.Body, "token=")+len("token="):]
+			token = strings.Fields(token)[0]
+			assert.Equal(t, tokenHash, secure.HashToken(token))
+			return nil
+		},
+	)
+
+	err := userService.ForgotPassword(context.Background(), domain.ForgotPasswordPayload{Email: user.Email}, "127.0.0.1")
+
+	assert.NoError(t, err)
+}
+
+func TestUserService_ForgotPassword_WhenIPIsRateLimited_ShouldReturnRateLimitErrorWithoutSendingMail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		mailSender:     mailSenderMock,
+		rateLimiter:    rateLimiterMock,
+	}
+
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), "forgot_password_email_test@example.com", int64(forgotPasswordEmailRateLimit), forgotPasswordRateLimitWindow).Return(nil)
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), "forgot_password_ip_127.0.0.1", int64(forgotPasswordIPRateLimit), forgotPasswordRateLimitWindow).Return(&domain.RateLimitError{RetryAfter: time.Minute})
+
+	err := userService.ForgotPassword(context.Background(), domain.ForgotPasswordPayload{Email: "test@example.com"}, "127.0.0.1")
+
+	var rateLimitError *domain.RateLimitError
+	assert.ErrorAs(t, err, &rateLimitError)
+}
+
+func TestUserService_ResetPassword_WhenTokenIsInvalid_ShouldReturnErrPasswordResetInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+	}
+
+	payload := domain.ResetPasswordPayload{
+		Token:           "invalid-token",
+		Password:        "N3wStr0ngP@ssw0rd!",
+		ConfirmPassword: "N3wStr0ngP@ssw0rd!",
+	}
+
+	userRepositoryMock.EXPECT().ConsumePasswordResetToken(gomock.Any(), secure.HashToken(payload.Token)).Return(nil, nil)
+
+	err := userService.ResetPassword(context.Background(), payload)
+
+	assert.ErrorIs(t, err, domain.ErrPasswordResetInvalid)
+}
+
+func TestUserService_ResetPassword_WhenTokenIsValid_ShouldUpdatePasswordRevokeSessionsAndClearLockout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
+
+	userService := &userService{
+		userRepository:         userRepositoryMock,
+		sessionService:         sessionServiceMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
+	}
+
+	user := &domain.User{
+		ID:    uuid.New(),
+		Email: "test@example.com",
+	}
+
+	payload := domain.ResetPasswordPayload{
+		Token:           "valid-token",
+		Password:        "N3wStr0ngP@ssw0rd!",
+		ConfirmPassword: "N3wStr0ngP@ssw0rd!",
+	}
+
+	userRepositoryMock.EXPECT().ConsumePasswordResetToken(gomock.Any(), secure.HashToken(payload.Token)).Return(&user.ID, nil)
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	userRepositoryMock.EXPECT().UpdatePassword(gomock.Any(), user.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, passwordHash string) error {
+			assert.NoError(t, secure.CheckPassword(passwordHash, payload.Password))
+			return nil
+		},
+	)
+	sessionServiceMock.EXPECT().RevokeAll(gomock.Any(), user.ID).Return(nil)
+	loginAttemptRepositoryMock.EXPECT().ResetFailures(gomock.Any(), "email_test@example.com").Return(nil)
+
+	err := userService.ResetPassword(context.Background(), payload)
+
+	assert.NoError(t, err)
+}
+
+func TestUserService_VerifyEmail_WhenTokenIsValid_ShouldMarkEmailVerified(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		mailSender:     mailSenderMock,
+		rateLimiter:    rateLimiterMock,
+	}
+
+	user := &domain.User{
+		ID:    uuid.New(),
+		Email: "test@example.com",
+	}
+
+	var token string
+	mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message client.MailMessage) error {
+			token = strings.Fields(message.Body[strings.Index(message.Body, "token=")+len("token="):])[0]
+			return nil
+		},
+	)
+	assert.NoError(t, userService.sendVerificationEmail(context.Background(), *user))
+
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), "verify_email_127.0.0.1", gomock.Any(), gomock.Any()).Return(nil)
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	userRepositoryMock.EXPECT().MarkEmailVerified(gomock.Any(), user.ID, gomock.Any()).Return(nil)
+
+	err := userService.VerifyEmail(context.Background(), domain.VerifyEmailPayload{Token: token}, "127.0.0.1")
+
+	assert.NoError(t, err)
+}
+
+func TestUserService_VerifyEmail_WhenTokenIsAccessToken_ShouldReturnErrVerificationInvalid(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		rateLimiter: rateLimiterMock,
+	}
+
+	sessionService := &sessionService{}
+	token, err := sessionService.createToken(domain.Session{ID: uuid.New(), UserID: uuid.New()}, time.Now().UTC())
+	assert.NoError(t, err)
+
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err = userService.VerifyEmail(context.Background(), domain.VerifyEmailPayload{Token: token}, "127.0.0.1")
+
+	assert.ErrorIs(t, err, domain.ErrVerificationInvalid)
+}
+
+func TestUserService_ResendVerification_WhenRateLimited_ShouldReturnRateLimitError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		rateLimiter: rateLimiterMock,
+	}
+
+	session := &domain.Session{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+	}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, session)
+
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.RateLimitError{RetryAfter: time.Minute})
+
+	err := userService.ResendVerification(ctx)
+
+	var rateLimitError *domain.RateLimitError
+	assert.ErrorAs(t, err, &rateLimitError)
+	assert.Equal(t, time.Minute, rateLimitError.RetryAfter)
+}
+
+func TestUserService_SignIn_WhenAccountIsLocked_ShouldReturnRateLimitErrorWithoutCheckingPassword(t *testing.T) {
+	setupSignInEnvironment()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
+
+	userService := &userService{
+		userRepository:         userRepositoryMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
+	}
+
+	payload := domain.SignInPayload{
+		Email:     "test@example.com",
+		Password:  "Str0ngP@ssw0rd!",
+		IPAddress: "127.0.0.1",
+	}
+
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(10*time.Minute, nil)
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "ip_127.0.0.1").Return(time.Duration(0), nil)
+
+	response, err := userService.SignIn(context.Background(), payload)
+
+	var rateLimitError *domain.RateLimitError
+	assert.ErrorAs(t, err, &rateLimitError)
+	assert.Equal(t, 10*time.Minute, rateLimitError.RetryAfter)
+	assert.Nil(t, response)
+}
+
+func TestUserService_SignIn_WhenFailuresReachDelayThreshold_ShouldApplyProgressiveDelay(t *testing.T) {
+	setupSignInEnvironment()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
+
+	userService := &userService{
+		userRepository:         userRepositoryMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
+	}
+
+	payload := domain.SignInPayload{
+		Email:    "test@example.com",
+		Password: "WrongP@ssw0rd",
+	}
+
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(time.Duration(0), nil)
+	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(nil, nil)
+	loginAttemptRepositoryMock.EXPECT().RegisterFailure(gomock.Any(), "email_test@example.com", gomock.Any()).Return(int64(5), nil)
+	loginAttemptRepositoryMock.EXPECT().Lock(gomock.Any(), "email_test@example.com", 4*time.Second).Return(nil)
+
+	_, err := userService.SignIn(context.Background(), payload)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+}
+
+func TestUserService_SignIn_WhenFailuresReachMaximum_ShouldLockAccountAndNotifyUser(t *testing.T) {
+	setupSignInEnvironment()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
+
+	userService := &userService{
+		userRepository:         userRepositoryMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
+		mailSender:             mailSenderMock,
+	}
+
+	payload := domain.SignInPayload{
+		Email:     "test@example.com",
+		Password:  "WrongP@ssw0rd",
+		IPAddress: "127.0.0.1",
+	}
+
+	user := &domain.User{
+		ID:           uuid.New(),
+		Email:        payload.Email,
+		PasswordHash: "$2a$10$gUWyf9ESKUNGxIAByzPCdOP9UMLLhC039R5jGNivSPhQJFNl4P0OC",
+	}
+
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), gomock.Any()).Return(time.Duration(0), nil).Times(2)
+	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(user, nil)
+	loginAttemptRepositoryMock.EXPECT().RegisterFailure(gomock.Any(), "email_test@example.com", gomock.Any()).Return(int64(10), nil)
+	loginAttemptRepositoryMock.EXPECT().RegisterFailure(gomock.Any(), "ip_127.0.0.1", gomock.Any()).Return(int64(10), nil)
+	loginAttemptRepositoryMock.EXPECT().Lock(gomock.Any(), "email_test@example.com", 15*time.Minute).Return(nil)
+	mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message client.MailMessage) error {
+			assert.Equal(t, user.Email, message.To)
+			return nil
+		},
+	)
+
+	_, err := userService.SignIn(context.Background(), payload)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+}
+
+func TestUserService_SignIn_WhenTwoFactorIsEnabled_ShouldReturnTwoFactorToken(t *testing.T) {
+	setupSignInEnvironment()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	loginAttemptRepositoryMock := mock.NewMockLoginAttemptRepository(ctrl)
+	twoFactorServiceMock := mock.NewMockTwoFactorService(ctrl)
+
+	userService := &userService{
+		userRepository:         userRepositoryMock,
+		loginAttemptRepository: loginAttemptRepositoryMock,
+		twoFactorService:       twoFactorServiceMock,
+	}
+
+	payload := domain.SignInPayload{
+		Email:    "test@example.com",
+		Password: "Str0ngP@ssw0rd!",
+	}
+
+	enabledAt := time.Now().UTC()
+	user := &domain.User{
+		ID:                 uuid.New(),
+		Email:              payload.Email,
+		PasswordHash:       "$2a$10$gUWyf9ESKUNGxIAByzPCdOP9UMLLhC039R5jGNivSPhQJFNl4P0OC",
+		TwoFactorEnabledAt: &enabledAt,
+	}
+
+	loginAttemptRepositoryMock.EXPECT().GetLockTTL(gomock.Any(), "email_test@example.com").Return(time.Duration(0), nil)
+	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), payload.Email).Return(user, nil)
+	loginAttemptRepositoryMock.EXPECT().ResetFailures(gomock.Any(), "email_test@example.com").Return(nil)
+	userRepositoryMock.EXPECT().UpdatePassword(gomock.Any(), user.ID, gomock.Any()).Return(nil)
+	twoFactorServiceMock.EXPECT().CreateSignInToken(*user).Return("partial-token", nil)
+
+	response, err := userService.SignIn(context.Background(), payload)
+
+	assert.NoError(t, err)
+	assert.True(t, response.TwoFactorRequired)
+	assert.Equal(t, "partial-token", response.TwoFactorToken)
+	assert.Empty(t, response.Token)
+}
+
+func TestUserService_UpdateMe_WhenOnlyFirstNameIsSent_ShouldKeepOtherFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		sessionService: sessionServiceMock,
+	}
+
+	birthDate := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
+	user := &domain.User{
+		ID:        uuid.New(),
+		FirstName: "old",
+		LastName:  "name",
+		Email:     "test@example.com",
+		BirthDate: &birthDate,
+	}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	firstName := "new"
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	userRepositoryMock.EXPECT().UpdateProfile(gomock.Any(), user.ID, "new", "name", &birthDate).Return(nil)
+	sessionServiceMock.EXPECT().SyncUser(gomock.Any(), gomock.Any()).Return(nil)
+
+	response, err := userService.UpdateMe(ctx, domain.UpdateProfilePayload{FirstName: &firstName})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new", response.FirstName)
+	assert.Equal(t, "name", response.LastName)
+	assert.Equal(t, &birthDate, response.BirthDate)
+}
+
+func TestUserService_UpdateMe_WhenBirthDateIsAlreadySet_ShouldReturnErrBirthDateLocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+	}
+
+	birthDate := time.Date(2012, time.January, 1, 0, 0, 0, 0, time.UTC)
+	user := &domain.User{ID: uuid.New(), FirstName: "old", LastName: "name", BirthDate: &birthDate}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	newBirthDate := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+	response, err := userService.UpdateMe(ctx, domain.UpdateProfilePayload{BirthDate: &newBirthDate})
+
+	assert.ErrorIs(t, err, domain.ErrBirthDateLocked)
+	assert.Nil(t, response)
+}
+
+func TestUserService_UpdateMe_WhenBirthDateIsEmpty_ShouldSetAndAuditIt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	auditServiceMock := mock.NewMockAuditService(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		sessionService: sessionServiceMock,
+		auditService:   auditServiceMock,
+	}
+
+	user := &domain.User{ID: uuid.New(), FirstName: "old", LastName: "name"}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	birthDate := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	userRepositoryMock.EXPECT().UpdateProfile(gomock.Any(), user.ID, "old", "name", &birthDate).Return(nil)
+	sessionServiceMock.EXPECT().SyncUser(gomock.Any(), gomock.Any()).Return(nil)
+	auditServiceMock.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, entry domain.AuditEntry) {
+		assert.Equal(t, domain.AuditActionUserBirthDateSet, entry.Action)
+		assert.Equal(t, user.ID, entry.TargetID)
+	})
+
+	response, err := userService.UpdateMe(ctx, domain.UpdateProfilePayload{BirthDate: &birthDate})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &birthDate, response.BirthDate)
+}
+
+func TestUserService_ChangePassword_WhenCurrentPasswordIsWrong_ShouldReturnErrInvalidPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		rateLimiter:    rateLimiterMock,
+	}
+
+	passwordHash, err := secure.HashPassword("Str0ngP@ssw0rd!")
+	assert.NoError(t, err)
+
+	user := &domain.User{ID: uuid.New(), PasswordHash: string(passwordHash)}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), "password_check_"+user.ID.String(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err = userService.ChangePassword(ctx, domain.ChangePasswordPayload{
+		CurrentPassword:    "WrongP@ssw0rd!",
+		NewPassword:        "N3wStr0ngP@ssw0rd!",
+		ConfirmNewPassword: "N3wStr0ngP@ssw0rd!",
+	})
+
 	assert.ErrorIs(t, err, domain.ErrInvalidPassword)
 }
+
+func TestUserService_ChangePassword_WhenCurrentPasswordIsValid_ShouldUpdatePasswordAndRevokeOtherSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		sessionService: sessionServiceMock,
+		rateLimiter:    rateLimiterMock,
+	}
+
+	passwordHash, err := secure.HashPassword("Str0ngP@ssw0rd!")
+	assert.NoError(t, err)
+
+	user := &domain.User{ID: uuid.New(), PasswordHash: string(passwordHash)}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	userRepositoryMock.EXPECT().UpdatePassword(gomock.Any(), user.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, passwordHash string) error {
+			assert.NoError(t, secure.CheckPassword(passwordHash, "N3wStr0ngP@ssw0rd!"))
+			return nil
+		},
+	)
+	sessionServiceMock.EXPECT().RevokeOthers(gomock.Any()).Return(nil)
+
+	err = userService.ChangePassword(ctx, domain.ChangePasswordPayload{
+		CurrentPassword:    "Str0ngP@ssw0rd!",
+		NewPassword:        "N3wStr0ngP@ssw0rd!",
+		ConfirmNewPassword: "N3wStr0ngP@ssw0rd!",
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestUserService_ConfirmEmailChange_WhenTokenIsValid_ShouldUpdateEmailAndRevokeSessions(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	sessionServiceMock := mock.NewMockSessionService(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
+	rateLimiterMock := mock.NewMockRateLimiter(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		sessionService: sessionServiceMock,
+		mailSender:     mailSenderMock,
+		rateLimiter:    rateLimiterMock,
+	}
+
+	passwordHash, err := secure.HashPassword("Str0ngP@ssw0rd!")
+	assert.NoError(t, err)
+
+	user := &domain.User{ID: uuid.New(), Email: "old@example.com", PasswordHash: string(passwordHash)}
+	ctx := context.WithValue(context.Background(), domain.SessionKey, &domain.Session{ID: uuid.New(), UserID: user.ID})
+
+	var token string
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil).Times(2)
+	rateLimiterMock.EXPECT().Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	userRepositoryMock.EXPECT().GetByEmail(gomock.Any(), "new@example.com").Return(nil, nil).Times(2)
+	mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message client.MailMessage) error {
+			assert.Equal(t, "new@example.com", message.To)
+			token = strings.Fields(message.Body[strings.Index(message.Body, "token=")+len("token="):])[0]
+			return nil
+		},
+	)
+
+	assert.NoError(t, userService.ChangeEmail(ctx, domain.ChangeEmailPayload{NewEmail: "new@example.com", Password: "Str0ngP@ssw0rd!"}))
+
+	userRepositoryMock.EXPECT().UpdateEmail(gomock.Any(), user.ID, "new@example.com", gomock.Any()).Return(nil)
+	sessionServiceMock.EXPECT().RevokeAll(gomock.Any(), user.ID).Return(nil)
+	mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message client.MailMessage) error {
+			assert.Equal(t, "old@example.com", message.To)
+			return nil
+		},
+	)
+
+	err = userService.ConfirmEmailChange(context.Background(), domain.ConfirmEmailChangePayload{Token: token})
+
+	assert.NoError(t, err)
+}
+
+func TestUserService_ConfirmEmailChange_WhenEmailChangedSinceRequest_ShouldReturnErrEmailChangeInvalid(t *testing.T) {
+	setupSessionEnvironment(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepositoryMock := mock.NewMockUserRepository(ctrl)
+	mailSenderMock := mock.NewMockMailSender(ctrl)
+
+	userService := &userService{
+		userRepository: userRepositoryMock,
+		mailSender:     mailSenderMock,
+	}
+
+	user := &domain.User{ID: uuid.New(), Email: "old@example.com"}
+
+	var token string
+	mailSenderMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message client.MailMessage) error {
+			token = strings.Fields(message.Body[strings.Index(message.Body, "token=")+len("token="):])[0]
+			return nil
+		},
+	)
+	assert.NoError(t, userService.sendEmailChangeEmail(context.Background(), *user, "new@example.com"))
+
+	userRepositoryMock.EXPECT().GetByID(gomock.Any(), user.ID).Return(&domain.User{ID: user.ID, Email: "other@example.com"}, nil)
+
+	err := userService.ConfirmEmailChange(context.Background(), domain.ConfirmEmailChangePayload{Token: token})
+
+	assert.ErrorIs(t, err, domain.ErrEmailChangeInvalid)
+}
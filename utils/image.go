@@ -0,0 +1,201 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+const exifOrientationTag = 0x0112
+
+// JPEGOrientation reads the EXIF orientation (1 to 8) of a JPEG. Images
+// without EXIF data or with a value it cannot read are reported as 1.
+func JPEGOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	offset := 2
+	for offset+4 <= len(data) {
+		if data[offset] != 0xFF {
+			return 1
+		}
+
+		marker := data[offset+1]
+		// Start of scan: the metadata segments are over.
+		if marker == 0xDA {
+			return 1
+		}
+
+		length := int(binary.BigEndian.Uint16(data[offset+2 : offset+4]))
+		if length < 2 || offset+2+length > len(data) {
+			return 1
+		}
+
+		segment := data[offset+4 : offset+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+
+		offset += 2 + length
+	}
+
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 1
+	}
+
+	entries := int(order.Uint16(tiff[ifd : ifd+2]))
+	for index := 0; index < entries; index++ {
+		entry := ifd + 2 + index*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+
+		if order.Uint16(tiff[entry:entry+2]) != exifOrientationTag {
+			continue
+		}
+
+		orientation := int(order.Uint16(tiff[entry+8 : entry+10]))
+		if orientation < 1 || orientation > 8 {
+			return 1
+		}
+
+		return orientation
+	}
+
+	return 1
+}
+
+// NormalizeImage draws img upright, following the EXIF orientation, on an
+// opaque white canvas so transparent PNGs flatten cleanly into JPEG.
+func NormalizeImage(img image.Image, orientation int) *image.RGBA {
+	bounds := img.Bounds()
+	source := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(source, source.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(source, source.Bounds(), img, bounds.Min, draw.Over)
+
+	if orientation <= 1 || orientation > 8 {
+		return source
+	}
+
+	width, height := source.Bounds().Dx(), source.Bounds().Dy()
+	// Orientations 5 to 8 are rotated by 90 degrees and swap the sides.
+	if orientation >= 5 {
+		width, height = height, width
+	}
+
+	oriented := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < source.Bounds().Dy(); y++ {
+		for x := 0; x < source.Bounds().Dx(); x++ {
+			targetX, targetY := orientedPoint(orientation, x, y, source.Bounds().Dx(), source.Bounds().Dy())
+			sourceOffset := source.PixOffset(x, y)
+			targetOffset := oriented.PixOffset(targetX, targetY)
+			copy(oriented.Pix[targetOffset:targetOffset+4], source.Pix[sourceOffset:sourceOffset+4])
+		}
+	}
+
+	return oriented
+}
+
+// orientedPoint maps a pixel of the stored image to where it is displayed.
+func orientedPoint(orientation, x, y, width, height int) (int, int) {
+	switch orientation {
+	case 2:
+		return width - 1 - x, y
+	case 3:
+		return width - 1 - x, height - 1 - y
+	case 4:
+		return x, height - 1 - y
+	case 5:
+		return y, x
+	case 6:
+		return height - 1 - y, x
+	case 7:
+		return height - 1 - y, width - 1 - x
+	case 8:
+		return y, width - 1 - x
+	default:
+		return x, y
+	}
+}
+
+// ResizeToFill crops the center of img to the aspect ratio of width x height
+// and scales it down to that size. Smaller images are cropped but never
+// upscaled.
+func ResizeToFill(img *image.RGBA, width, height int) *image.RGBA {
+	bounds := img.Bounds()
+	cropWidth, cropHeight := bounds.Dx(), bounds.Dy()
+	if cropWidth*height > cropHeight*width {
+		cropWidth = max(1, cropHeight*width/height)
+	} else {
+		cropHeight = max(1, cropWidth*height/width)
+	}
+
+	crop := image.Rect(0, 0, cropWidth, cropHeight).Add(image.Point{
+		X: bounds.Min.X + (bounds.Dx()-cropWidth)/2,
+		Y: bounds.Min.Y + (bounds.Dy()-cropHeight)/2,
+	})
+
+	if cropWidth < width {
+		width, height = cropWidth, cropHeight
+	}
+
+	return downscale(img, crop, width, height)
+}
+
+// downscale averages the source pixels covered by each target pixel, which
+// keeps thin lines and text readable when shrinking a lot.
+func downscale(img *image.RGBA, source image.Rectangle, width, height int) *image.RGBA {
+	target := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		top := source.Min.Y + y*source.Dy()/height
+		bottom := max(top+1, source.Min.Y+(y+1)*source.Dy()/height)
+
+		for x := 0; x < width; x++ {
+			left := source.Min.X + x*source.Dx()/width
+			right := max(left+1, source.Min.X+(x+1)*source.Dx()/width)
+
+			var red, green, blue, alpha, count int
+			for sourceY := top; sourceY < bottom; sourceY++ {
+				offset := img.PixOffset(left, sourceY)
+				for sourceX := left; sourceX < right; sourceX++ {
+					red += int(img.Pix[offset])
+					green += int(img.Pix[offset+1])
+					blue += int(img.Pix[offset+2])
+					alpha += int(img.Pix[offset+3])
+					count++
+					offset += 4
+				}
+			}
+
+			offset := target.PixOffset(x, y)
+			target.Pix[offset] = uint8(red / count)
+			target.Pix[offset+1] = uint8(green / count)
+			target.Pix[offset+2] = uint8(blue / count)
+			target.Pix[offset+3] = uint8(alpha / count)
+		}
+	}
+
+	return target
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeSearchText lowercases the text, strips accents ("Ação" becomes
+// "acao") and collapses everything that is not a letter or digit into single
+// spaces, so stored text and user queries compare the same way.
+func NormalizeSearchText(value string) string {
+	stripAccents := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	unaccented, _, err := transform.String(stripAccents, value)
+	if err != nil {
+		unaccented = value
+	}
+
+	var builder strings.Builder
+	lastWasSpace := true
+	for _, r := range strings.ToLower(unaccented) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+			lastWasSpace = false
+			continue
+		}
+
+		if !lastWasSpace {
+			builder.WriteRune(' ')
+			lastWasSpace = true
+		}
+	}
+
+	return strings.TrimSpace(builder.String())
+}
+
+// SearchTrigrams returns the distinct trigrams of each word padded like
+// pg_trgm does ("  word "), which keeps short words and word starts weighted.
+func SearchTrigrams(value string) []string {
+	seen := make(map[string]bool)
+	var trigrams []string
+	for _, word := range strings.Fields(value) {
+		padded := []rune("  " + word + " ")
+		for index := 0; index+3 <= len(padded); index++ {
+			trigram := string(padded[index : index+3])
+			if !seen[trigram] {
+				seen[trigram] = true
+				trigrams = append(trigrams, trigram)
+			}
+		}
+	}
+
+	return trigrams
+}
+
+// TrigramSimilarity is the share of trigrams both values have in common, from
+// 0 (nothing in common) to 1 (same trigrams).
+func TrigramSimilarity(a, b string) float64 {
+	left := SearchTrigrams(a)
+	right := SearchTrigrams(b)
+	if len(left) == 0 || len(right) == 0 {
+		return 0
+	}
+
+	rightSet := make(map[string]bool, len(right))
+	for _, trigram := range right {
+		rightSet[trigram] = true
+	}
+
+	shared := 0
+	for _, trigram := range left {
+		if rightSet[trigram] {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(left)+len(right)-shared)
+}
+
+// LevenshteinDistance counts the single rune insertions, deletions and
+// substitutions needed to turn a into b.
+func LevenshteinDistance(a, b string) int {
+	left := []rune(a)
+	right := []rune(b)
+
+	previous := make([]int, len(right)+1)
+	current := make([]int, len(right)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(left); i++ {
+		current[0] = i
+		for j := 1; j <= len(right); j++ {
+			cost := 1
+			if left[i-1] == right[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(right)]
+}
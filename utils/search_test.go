@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSearchText_ShouldLowercaseStripAccentsAndCollapseSeparators(t *testing.T) {
+	assert.Equal(t, "acao e reacao 2", NormalizeSearchText("  Ação -- e REAÇÃO: 2! "))
+	assert.Equal(t, "", NormalizeSearchText(" ?! "))
+}
+
+func TestSearchTrigrams_ShouldPadWordsAndSkipDuplicates(t *testing.T) {
+	assert.Equal(t, []string{"  c", " ca", "cat", "at "}, SearchTrigrams("cat"))
+	assert.Equal(t, []string{"  a", " aa", "aa "}, SearchTrigrams("aa aa"))
+	assert.Empty(t, SearchTrigrams(""))
+}
+
+func TestTrigramSimilarity_ShouldReturnShareOfCommonTrigrams(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected float64
+	}{
+		{a: "cat", b: "cat", expected: 1},
+		{a: "cat", b: "dog", expected: 0},
+		{a: "cat", b: "cats", expected: 0.5},
+		{a: "cats", b: "cat", expected: 0.5},
+		{a: "", b: "cat", expected: 0},
+		{a: "cat", b: "", expected: 0},
+	}
+
+	for _, c := range cases {
+		assert.InDelta(t, c.expected, TrigramSimilarity(c.a, c.b), 1e-9, "%q and %q", c.a, c.b)
+	}
+}
+
+func TestLevenshteinDistance_ShouldCountRuneEdits(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "", b: "abc", expected: 3},
+		{a: "abc", b: "", expected: 3},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "flaw", b: "lawn", expected: 2},
+		{a: "matrix", b: "matrix", expected: 0},
+		{a: "ação", b: "acao", expected: 2},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, LevenshteinDistance(c.a, c.b), "%q and %q", c.a, c.b)
+		assert.Equal(t, c.expected, LevenshteinDistance(c.b, c.a), "%q and %q", c.b, c.a)
+	}
+}
@@ -20,3 +20,12 @@ func ConvertImageToBytes(image *multipart.FileHeader) ([]byte, error) {
 
 	return imageBytes, nil
 }
+
+func TruncateString(value string, maxLength int) string {
+	runes := []rune(value)
+	if len(runes) <= maxLength {
+		return value
+	}
+
+	return string(runes[:maxLength])
+}